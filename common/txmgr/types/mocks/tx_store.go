@@ -110,6 +110,39 @@ func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) CountU
 	return r0, r1
 }
 
+// CountUnstartedTransactionsByPriority provides a mock function with given fields: fromAddress, chainID, qopts
+func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) CountUnstartedTransactionsByPriority(fromAddress ADDR, chainID CHAIN_ID, qopts ...pg.QOpt) (map[txmgrtypes.TxPriority]uint32, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, fromAddress, chainID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 map[txmgrtypes.TxPriority]uint32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(ADDR, CHAIN_ID, ...pg.QOpt) (map[txmgrtypes.TxPriority]uint32, error)); ok {
+		return rf(fromAddress, chainID, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(ADDR, CHAIN_ID, ...pg.QOpt) map[txmgrtypes.TxPriority]uint32); ok {
+		r0 = rf(fromAddress, chainID, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[txmgrtypes.TxPriority]uint32)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(ADDR, CHAIN_ID, ...pg.QOpt) error); ok {
+		r1 = rf(fromAddress, chainID, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateEthTransaction provides a mock function with given fields: newTx, chainID, qopts
 func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) CreateEthTransaction(newTx txmgrtypes.NewTx[ADDR, TX_HASH], chainID CHAIN_ID, qopts ...pg.QOpt) (txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD], error) {
 	_va := make([]interface{}, len(qopts))
@@ -254,6 +287,37 @@ func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) EthTxA
 	return r0, r1, r2
 }
 
+// EvictLowerPriorityUnstartedTransaction provides a mock function with given fields: fromAddress, priority, chainID, qopts
+func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) EvictLowerPriorityUnstartedTransaction(fromAddress ADDR, priority txmgrtypes.TxPriority, chainID CHAIN_ID, qopts ...pg.QOpt) (int64, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, fromAddress, priority, chainID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(ADDR, txmgrtypes.TxPriority, CHAIN_ID, ...pg.QOpt) (int64, error)); ok {
+		return rf(fromAddress, priority, chainID, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(ADDR, txmgrtypes.TxPriority, CHAIN_ID, ...pg.QOpt) int64); ok {
+		r0 = rf(fromAddress, priority, chainID, qopts...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(ADDR, txmgrtypes.TxPriority, CHAIN_ID, ...pg.QOpt) error); ok {
+		r1 = rf(fromAddress, priority, chainID, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindEthReceiptsPendingConfirmation provides a mock function with given fields: ctx, blockNum, chainID
 func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) FindEthReceiptsPendingConfirmation(ctx context.Context, blockNum int64, chainID CHAIN_ID) ([]txmgrtypes.ReceiptPlus[R], error) {
 	ret := _m.Called(ctx, blockNum, chainID)
@@ -893,6 +957,27 @@ func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) ReapTx
 	return r0
 }
 
+// SaveCancellationInProgressAttempt provides a mock function with given fields: etx, attempt, qopts
+func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) SaveCancellationInProgressAttempt(etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD], attempt *txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD], qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, etx, attempt)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD], *txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD], ...pg.QOpt) error); ok {
+		r0 = rf(etx, attempt, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SaveConfirmedMissingReceiptAttempt provides a mock function with given fields: ctx, timeout, attempt, broadcastAt
 func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) SaveConfirmedMissingReceiptAttempt(ctx context.Context, timeout time.Duration, attempt *txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD], broadcastAt time.Time) error {
 	ret := _m.Called(ctx, timeout, attempt, broadcastAt)
@@ -1089,6 +1174,27 @@ func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) Update
 	return r0
 }
 
+// UpdateEthTxUnstartedToCancelled provides a mock function with given fields: etx, qopts
+func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) UpdateEthTxUnstartedToCancelled(etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD], qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, etx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD], ...pg.QOpt) error); ok {
+		r0 = rf(etx, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateEthTxUnstartedToInProgress provides a mock function with given fields: etx, attempt, qopts
 func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) UpdateEthTxUnstartedToInProgress(etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD], attempt *txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD], qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -15,6 +15,20 @@ type TxStrategy struct {
 	mock.Mock
 }
 
+// Priority provides a mock function with given fields:
+func (_m *TxStrategy) Priority() types.TxPriority {
+	ret := _m.Called()
+
+	var r0 types.TxPriority
+	if rf, ok := ret.Get(0).(func() types.TxPriority); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(types.TxPriority)
+	}
+
+	return r0
+}
+
 // PruneQueue provides a mock function with given fields: pruneService, qopt
 func (_m *TxStrategy) PruneQueue(pruneService types.UnstartedTxQueuePruner, qopt pg.QOpt) (int64, error) {
 	ret := _m.Called(pruneService, qopt)
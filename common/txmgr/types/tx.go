@@ -24,12 +24,65 @@ import (
 type TxStrategy interface {
 	// Subject will be saved txes.subject if not null
 	Subject() uuid.NullUUID
+	// Priority will be saved to txes.priority and determines the order in
+	// which unstarted txes from the same address are broadcast
+	Priority() TxPriority
 	// PruneQueue is called after tx insertion
 	// It accepts the service responsible for deleting
 	// unstarted txs and deletion options
 	PruneQueue(pruneService UnstartedTxQueuePruner, qopt pg.QOpt) (n int64, err error)
 }
 
+// TxPriority is the priority class of a transaction. Unstarted txes are
+// broadcast highest priority first, and when the queue for an address is full
+// lower priority txes may be evicted to make room for higher priority ones.
+type TxPriority int16
+
+const (
+	TxPriorityLow    = TxPriority(-1)
+	TxPriorityNormal = TxPriority(0)
+	TxPriorityHigh   = TxPriority(1)
+)
+
+func (p TxPriority) String() string {
+	switch p {
+	case TxPriorityLow:
+		return "low"
+	case TxPriorityNormal:
+		return "normal"
+	case TxPriorityHigh:
+		return "high"
+	default:
+		return fmt.Sprintf("TxPriority(%d)", int16(p))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p TxPriority) MarshalText() ([]byte, error) {
+	switch p {
+	case TxPriorityLow, TxPriorityNormal, TxPriorityHigh:
+		return []byte(p.String()), nil
+	default:
+		return nil, errors.Errorf("invalid transaction priority: %d", int16(p))
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. An empty value is
+// treated as normal priority.
+func (p *TxPriority) UnmarshalText(b []byte) error {
+	switch strings.ToLower(string(b)) {
+	case "low":
+		*p = TxPriorityLow
+	case "", "normal":
+		*p = TxPriorityNormal
+	case "high":
+		*p = TxPriorityHigh
+	default:
+		return errors.Errorf("invalid transaction priority %q, must be one of: low, normal, high", string(b))
+	}
+	return nil
+}
+
 type TxAttemptState string
 
 type TxState string
@@ -189,9 +242,10 @@ type Tx[
 	// Marshalled TxMeta
 	// Used for additional context around transactions which you want to log
 	// at send time.
-	Meta    *datatypes.JSON
-	Subject uuid.NullUUID
-	ChainID CHAIN_ID
+	Meta     *datatypes.JSON
+	Subject  uuid.NullUUID
+	Priority TxPriority
+	ChainID  CHAIN_ID
 
 	PipelineTaskRunID uuid.NullUUID
 	MinConfirmations  clnull.Uint32
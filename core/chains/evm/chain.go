@@ -40,6 +40,7 @@ type Chain interface {
 	HeadBroadcaster() httypes.HeadBroadcaster
 	TxManager() txmgr.EvmTxManager
 	HeadTracker() httypes.HeadTracker
+	ReorgDetector() httypes.ReorgDetector
 	Logger() logger.Logger
 	BalanceMonitor() monitor.BalanceMonitor
 	LogPoller() logpoller.LogPoller
@@ -59,6 +60,7 @@ type chain struct {
 	logger          logger.Logger
 	headBroadcaster httypes.HeadBroadcaster
 	headTracker     httypes.HeadTracker
+	reorgDetector   httypes.ReorgDetector
 	logBroadcaster  log.Broadcaster
 	logPoller       logpoller.LogPoller
 	balanceMonitor  monitor.BalanceMonitor
@@ -105,14 +107,19 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 	headBroadcaster := headtracker.NewHeadBroadcaster(l)
 	headSaver := headtracker.NullSaver
 	var headTracker httypes.HeadTracker
+	reorgDetector := headtracker.NullReorgDetector
 	if !cfg.EVMRPCEnabled() {
 		headTracker = headtracker.NullTracker
-	} else if opts.GenHeadTracker == nil {
-		orm := headtracker.NewORM(db, l, cfg, *chainID)
-		headSaver = headtracker.NewHeadSaver(l, orm, cfg)
-		headTracker = headtracker.NewHeadTracker(l, client, cfg, headBroadcaster, headSaver, opts.MailMon)
 	} else {
-		headTracker = opts.GenHeadTracker(chainID, headBroadcaster)
+		orm := headtracker.NewORM(db, l, cfg, *chainID)
+		if opts.GenHeadTracker == nil {
+			headSaver = headtracker.NewHeadSaver(l, orm, cfg)
+			headTracker = headtracker.NewHeadTracker(l, client, cfg, headBroadcaster, headSaver, opts.MailMon)
+		} else {
+			headTracker = opts.GenHeadTracker(chainID, headBroadcaster)
+		}
+		reorgDetector = headtracker.NewReorgDetector(l, orm, *chainID)
+		headBroadcaster.Subscribe(reorgDetector)
 	}
 
 	logPoller := logpoller.LogPollerDisabled
@@ -169,6 +176,7 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 		logger:          l,
 		headBroadcaster: headBroadcaster,
 		headTracker:     headTracker,
+		reorgDetector:   reorgDetector,
 		logBroadcaster:  logBroadcaster,
 		logPoller:       logPoller,
 		balanceMonitor:  balanceMonitor,
@@ -274,11 +282,12 @@ func (c *chain) LogPoller() logpoller.LogPoller           { return c.logPoller }
 func (c *chain) HeadBroadcaster() httypes.HeadBroadcaster { return c.headBroadcaster }
 func (c *chain) TxManager() txmgr.EvmTxManager            { return c.txm }
 func (c *chain) HeadTracker() httypes.HeadTracker         { return c.headTracker }
+func (c *chain) ReorgDetector() httypes.ReorgDetector     { return c.reorgDetector }
 func (c *chain) Logger() logger.Logger                    { return c.logger }
 func (c *chain) BalanceMonitor() monitor.BalanceMonitor   { return c.balanceMonitor }
 func (c *chain) GasEstimator() gas.EvmFeeEstimator        { return c.gasEstimator }
 
-func newEthClientFromChain(cfg evmclient.NodeConfig, lggr logger.Logger, chainID *big.Int, chainType config.ChainType, nodes []*v2.Node) (evmclient.Client, error) {
+func newEthClientFromChain(cfg evmconfig.ChainScopedConfig, lggr logger.Logger, chainID *big.Int, chainType config.ChainType, nodes []*v2.Node) (evmclient.Client, error) {
 	var primaries []evmclient.Node
 	var sendonlys []evmclient.SendOnlyNode
 	for i, node := range nodes {
@@ -301,7 +310,12 @@ func newPrimary(cfg evmclient.NodeConfig, lggr logger.Logger, n *v2.Node, id int
 		return nil, errors.New("cannot cast send-only node to primary")
 	}
 
-	return evmclient.NewNode(cfg, lggr, (url.URL)(*n.WSURL), (*url.URL)(n.HTTPURL), *n.Name, id, chainID), nil
+	order := v2.DefaultNodeOrder
+	if n.Order != nil {
+		order = *n.Order
+	}
+
+	return evmclient.NewNode(cfg, lggr, (url.URL)(*n.WSURL), (*url.URL)(n.HTTPURL), *n.Name, id, chainID, order), nil
 }
 
 func EnsureChains(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig, ids []utils.Big) error {
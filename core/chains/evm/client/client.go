@@ -359,10 +359,25 @@ func (client *client) HeadByHash(ctx context.Context, hash common.Hash) (head *e
 	return
 }
 
+// ToBlockNumArg returns the block number parameter of RPC calls. The block
+// tags are represented by the negative numbers of rpc.BlockNumber, e.g.
+// rpc.FinalizedBlockNumber, and nil represents the latest block.
 func ToBlockNumArg(number *big.Int) string {
 	if number == nil {
 		return "latest"
 	}
+	if number.Sign() < 0 && number.IsInt64() {
+		switch rpc.BlockNumber(number.Int64()) {
+		case rpc.PendingBlockNumber:
+			return "pending"
+		case rpc.LatestBlockNumber:
+			return "latest"
+		case rpc.FinalizedBlockNumber:
+			return "finalized"
+		case rpc.SafeBlockNumber:
+			return "safe"
+		}
+	}
 	return hexutil.EncodeBig(number)
 }
 
@@ -344,6 +344,17 @@ func TestEthClient_HeaderByNumber(t *testing.T) {
 	}
 }
 
+func TestToBlockNumArg(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "latest", evmclient.ToBlockNumArg(nil))
+	assert.Equal(t, "0x2a", evmclient.ToBlockNumArg(big.NewInt(42)))
+	assert.Equal(t, "pending", evmclient.ToBlockNumArg(big.NewInt(rpc.PendingBlockNumber.Int64())))
+	assert.Equal(t, "latest", evmclient.ToBlockNumArg(big.NewInt(rpc.LatestBlockNumber.Int64())))
+	assert.Equal(t, "finalized", evmclient.ToBlockNumArg(big.NewInt(rpc.FinalizedBlockNumber.Int64())))
+	assert.Equal(t, "safe", evmclient.ToBlockNumArg(big.NewInt(rpc.SafeBlockNumber.Int64())))
+}
+
 func TestEthClient_SendTransaction_NoSecondaryURL(t *testing.T) {
 	t.Parallel()
 
@@ -135,3 +135,7 @@ func (e *erroringNode) DeclareInSync()               {}
 func (e *erroringNode) DeclareUnreachable()          {}
 func (e *erroringNode) Name() string                 { return "" }
 func (e *erroringNode) NodeStates() map[int32]string { return nil }
+
+func (e *erroringNode) Order() int32 { return 0 }
+
+func (e *erroringNode) RPCStats() NodeRPCStats { return NodeRPCStats{} }
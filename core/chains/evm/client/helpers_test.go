@@ -19,6 +19,11 @@ type TestNodeConfig struct {
 	PollInterval         time.Duration
 	SelectionMode        string
 	SyncThreshold        uint32
+	HeadLagTolerance     uint32
+	QuorumEnabled        bool
+	QuorumMethods        []string
+	QuorumNodes          uint32
+	QuorumThreshold      uint32
 }
 
 func (tc TestNodeConfig) NodeNoNewHeadsThreshold() time.Duration { return tc.NoNewHeadsThreshold }
@@ -26,8 +31,13 @@ func (tc TestNodeConfig) NodePollFailureThreshold() uint32       { return tc.Pol
 func (tc TestNodeConfig) NodePollInterval() time.Duration        { return tc.PollInterval }
 func (tc TestNodeConfig) NodeSelectionMode() string              { return tc.SelectionMode }
 func (tc TestNodeConfig) NodeSyncThreshold() uint32              { return tc.SyncThreshold }
+func (tc TestNodeConfig) NodeHeadLagTolerance() uint32           { return tc.HeadLagTolerance }
+func (tc TestNodeConfig) NodeQuorumEnabled() bool                { return tc.QuorumEnabled }
+func (tc TestNodeConfig) NodeQuorumMethods() []string            { return tc.QuorumMethods }
+func (tc TestNodeConfig) NodeQuorumNodes() uint32                { return tc.QuorumNodes }
+func (tc TestNodeConfig) NodeQuorumThreshold() uint32            { return tc.QuorumThreshold }
 
-func NewClientWithTestNode(t *testing.T, cfg NodeConfig, rpcUrl string, rpcHTTPURL *url.URL, sendonlyRPCURLs []url.URL, id int32, chainID *big.Int) (*client, error) {
+func NewClientWithTestNode(t *testing.T, cfg TestNodeConfig, rpcUrl string, rpcHTTPURL *url.URL, sendonlyRPCURLs []url.URL, id int32, chainID *big.Int) (*client, error) {
 	parsed, err := url.ParseRequestURI(rpcUrl)
 	if err != nil {
 		return nil, err
@@ -38,7 +48,7 @@ func NewClientWithTestNode(t *testing.T, cfg NodeConfig, rpcUrl string, rpcHTTPU
 	}
 
 	lggr := logger.TestLogger(t)
-	n := NewNode(cfg, lggr, *parsed, rpcHTTPURL, "eth-primary-0", id, chainID)
+	n := NewNode(cfg, lggr, *parsed, rpcHTTPURL, "eth-primary-0", id, chainID, 1)
 	n.(*node).setLatestReceived(0, utils.NewBigI(0))
 	primaries := []Node{n}
 
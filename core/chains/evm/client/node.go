@@ -92,6 +92,10 @@ type Node interface {
 	// Name is a unique identifier for this node.
 	Name() string
 	ChainID() *big.Int
+	// Order is the operator assigned priority of this node, lower is preferred.
+	Order() int32
+	// RPCStats returns the latency and error rate of the most recent RPC calls.
+	RPCStats() NodeRPCStats
 
 	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
 	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
@@ -133,6 +137,7 @@ type node struct {
 	rpcLog  logger.Logger
 	name    string
 	id      int32
+	order   int32
 	chainID *big.Int
 	cfg     NodeConfig
 
@@ -145,6 +150,9 @@ type node struct {
 	stateLatestBlockNumber     int64
 	stateLatestTotalDifficulty *utils.Big
 
+	// rpcStats tracks the latency and error rate of recent RPC calls
+	rpcStats rpcStatsWindow
+
 	// Need to track subscriptions because closing the RPC does not (always?)
 	// close the underlying subscription
 	subs []ethereum.Subscription
@@ -177,10 +185,11 @@ type NodeConfig interface {
 }
 
 // NewNode returns a new *node as Node
-func NewNode(nodeCfg NodeConfig, lggr logger.Logger, wsuri url.URL, httpuri *url.URL, name string, id int32, chainID *big.Int) Node {
+func NewNode(nodeCfg NodeConfig, lggr logger.Logger, wsuri url.URL, httpuri *url.URL, name string, id int32, chainID *big.Int, nodeOrder int32) Node {
 	n := new(node)
 	n.name = name
 	n.id = id
+	n.order = nodeOrder
 	n.chainID = chainID
 	n.cfg = nodeCfg
 	n.ws.uri = wsuri
@@ -996,6 +1005,7 @@ func (n *node) logResult(
 	results ...interface{},
 ) {
 	lggr = lggr.With("duration", callDuration, "rpcDomain", rpcDomain, "callName", callName)
+	n.rpcStats.record(callDuration, err)
 	promEVMPoolRPCNodeCalls.WithLabelValues(n.chainID.String(), n.name).Inc()
 	if err == nil {
 		promEVMPoolRPCNodeCallsSuccess.WithLabelValues(n.chainID.String(), n.name).Inc()
@@ -1102,6 +1112,14 @@ func (n *node) String() string {
 	return s
 }
 
+func (n *node) Order() int32 {
+	return n.order
+}
+
+func (n *node) RPCStats() NodeRPCStats {
+	return n.rpcStats.stats()
+}
+
 func (n *node) Name() string {
 	return n.name
 }
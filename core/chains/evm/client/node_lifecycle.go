@@ -238,7 +238,7 @@ func (n *node) syncStatus(num int64, td *utils.Big) (outOfSync bool, liveNodes i
 	ln, highest, greatest := n.nLiveNodes()
 	mode := n.cfg.NodeSelectionMode()
 	switch mode {
-	case NodeSelectionMode_HighestHead, NodeSelectionMode_RoundRobin:
+	case NodeSelectionMode_HighestHead, NodeSelectionMode_RoundRobin, NodeSelectionMode_LatencyWeighted:
 		return num < highest-int64(threshold), ln
 	case NodeSelectionMode_TotalDifficulty:
 		return td.Cmp(greatest.Sub(threshold)) < 0, ln
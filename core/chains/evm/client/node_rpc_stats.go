@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// rpcStatsWindowSize is the number of most recent RPC calls that are taken
+// into account when computing NodeRPCStats
+const rpcStatsWindowSize = 100
+
+// NodeRPCStats summarises the latency and error rate of a node's most recent
+// RPC calls
+type NodeRPCStats struct {
+	// AvgLatency is the mean duration of the calls in the window
+	AvgLatency time.Duration
+	// ErrorRate is the fraction of calls in the window that failed, from 0 to 1
+	ErrorRate float64
+	// Samples is the number of calls in the window
+	Samples int
+}
+
+type rpcSample struct {
+	duration time.Duration
+	failed   bool
+}
+
+// rpcStatsWindow is a fixed size ring buffer of RPC call samples
+type rpcStatsWindow struct {
+	mu      sync.RWMutex
+	samples [rpcStatsWindowSize]rpcSample
+	next    int
+	count   int
+}
+
+func (w *rpcStatsWindow) record(duration time.Duration, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.samples[w.next] = rpcSample{duration: duration, failed: isNodeFailure(err)}
+	w.next = (w.next + 1) % rpcStatsWindowSize
+	if w.count < rpcStatsWindowSize {
+		w.count++
+	}
+}
+
+func (w *rpcStatsWindow) stats() (s NodeRPCStats) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.count == 0 {
+		return
+	}
+	var total time.Duration
+	var failed int
+	for i := 0; i < w.count; i++ {
+		total += w.samples[i].duration
+		if w.samples[i].failed {
+			failed++
+		}
+	}
+	s.Samples = w.count
+	s.AvgLatency = total / time.Duration(w.count)
+	s.ErrorRate = float64(failed) / float64(w.count)
+	return
+}
+
+// isNodeFailure reports whether err indicates a problem with the node itself.
+// JSON-RPC errors (e.g. reverts or nonce errors) are valid responses, and
+// cancellations are initiated by the caller, so neither counts against the
+// node.
+func isNodeFailure(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var rpcErr rpc.Error
+	return !errors.As(err, &rpcErr)
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type testRPCError struct{}
+
+func (testRPCError) Error() string  { return "execution reverted" }
+func (testRPCError) ErrorCode() int { return 3 }
+
+var _ rpc.Error = testRPCError{}
+
+func TestRPCStatsWindow(t *testing.T) {
+	t.Parallel()
+
+	var w rpcStatsWindow
+	assert.Equal(t, NodeRPCStats{}, w.stats())
+
+	w.record(100*time.Millisecond, nil)
+	w.record(300*time.Millisecond, errors.New("connection reset"))
+	w.record(200*time.Millisecond, errors.Wrap(testRPCError{}, "call failed"))
+	w.record(200*time.Millisecond, context.Canceled)
+	assert.Equal(t, NodeRPCStats{AvgLatency: 200 * time.Millisecond, ErrorRate: 0.25, Samples: 4}, w.stats())
+
+	t.Run("only keeps the most recent samples", func(t *testing.T) {
+		for i := 0; i < rpcStatsWindowSize; i++ {
+			w.record(10*time.Millisecond, nil)
+		}
+		assert.Equal(t, NodeRPCStats{AvgLatency: 10 * time.Millisecond, Samples: rpcStatsWindowSize}, w.stats())
+	})
+}
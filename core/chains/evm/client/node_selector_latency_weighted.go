@@ -0,0 +1,121 @@
+package client
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// maxScoredErrorRate caps the error rate used for scoring, so that a node
+	// which fails every call still gets a finite score
+	maxScoredErrorRate = 0.99
+	// minScoredSamples is the number of recent calls a node needs before its
+	// latency is trusted
+	minScoredSamples = 10
+	// switchScoreRatio is how much lower than the score of the previously
+	// selected node another node's score must be for the selector to switch
+	switchScoreRatio = 0.8
+)
+
+type latencyWeightedNodeSelector struct {
+	nodes            []Node
+	headLagTolerance int64
+
+	mu       sync.Mutex
+	selected Node
+}
+
+// NewLatencyWeightedNodeSelector returns a NodeSelector which prefers nodes by
+// operator assigned Order, and then by the latency and error rate of their
+// recent RPC calls. Nodes which are more than headLagTolerance blocks behind
+// the highest head are only selected if no other node is available. The
+// previously selected node is kept unless another node scores clearly better,
+// so that the selection does not flap between nodes with similar latency.
+func NewLatencyWeightedNodeSelector(nodes []Node, headLagTolerance uint32) NodeSelector {
+	return &latencyWeightedNodeSelector{nodes: nodes, headLagTolerance: int64(headLagTolerance)}
+}
+
+func (s *latencyWeightedNodeSelector) Select() Node {
+	type candidate struct {
+		node     Node
+		blockNum int64
+	}
+
+	var candidates []candidate
+	// NodeNoNewHeadsThreshold may not be enabled, in this case all nodes have latestReceivedBlockNumber == -1
+	var highestHeadNumber int64 = math.MinInt64
+	for _, n := range s.nodes {
+		state, latestReceivedBlockNumber, _ := n.StateAndLatest()
+		if state != NodeStateAlive {
+			continue
+		}
+		candidates = append(candidates, candidate{n, latestReceivedBlockNumber})
+		if latestReceivedBlockNumber > highestHeadNumber {
+			highestHeadNumber = latestReceivedBlockNumber
+		}
+	}
+
+	type choice struct {
+		node    Node
+		lagging bool
+		order   int32
+		score   float64
+	}
+	// better reports whether a is preferred over b, ignoring the score if
+	// withScore is false
+	better := func(a, b choice, withScore bool) bool {
+		if a.lagging != b.lagging {
+			return !a.lagging
+		}
+		if a.order != b.order {
+			return a.order < b.order
+		}
+		return withScore && a.score < b.score
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var best, prev *choice
+	for _, c := range candidates {
+		ch := choice{
+			node:    c.node,
+			lagging: c.blockNum < highestHeadNumber-s.headLagTolerance,
+			order:   c.node.Order(),
+			score:   latencyScore(c.node.RPCStats()),
+		}
+		if c.node == s.selected {
+			prev = &ch
+		}
+		// ties go to the earliest node
+		if best == nil || better(ch, *best, true) {
+			best = &ch
+		}
+	}
+	if best == nil {
+		s.selected = nil
+		return nil
+	}
+	if prev != nil && !better(*best, *prev, false) && !(best.score < prev.score*switchScoreRatio) {
+		return prev.node
+	}
+	s.selected = best.node
+	return best.node
+}
+
+// latencyScore returns the average latency inflated by the error rate, such
+// that lower is better. Nodes with too few recent calls score +Inf, so that
+// they are only selected when no measured node is available; they collect
+// samples from the liveness polls meanwhile.
+func latencyScore(stats NodeRPCStats) float64 {
+	if stats.Samples < minScoredSamples {
+		return math.Inf(1)
+	}
+	errorRate := math.Min(stats.ErrorRate, maxScoredErrorRate)
+	return float64(stats.AvgLatency) / float64(time.Millisecond) / (1 - errorRate)
+}
+
+func (s *latencyWeightedNodeSelector) Name() string {
+	return NodeSelectionMode_LatencyWeighted
+}
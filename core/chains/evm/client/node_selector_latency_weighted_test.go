@@ -0,0 +1,157 @@
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newLatencyWeightedNode(t *testing.T, state evmclient.NodeState, blockNum int64, order int32, stats evmclient.NodeRPCStats) *evmmocks.Node {
+	node := evmmocks.NewNode(t)
+	node.On("StateAndLatest").Return(state, blockNum, nil)
+	node.On("Order").Return(order).Maybe()
+	node.On("RPCStats").Return(stats).Maybe()
+	return node
+}
+
+func TestLatencyWeightedNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	fast := evmclient.NodeRPCStats{AvgLatency: 50 * time.Millisecond, Samples: 100}
+	slow := evmclient.NodeRPCStats{AvgLatency: 200 * time.Millisecond, Samples: 100}
+
+	t.Run("prefers the fastest node", func(t *testing.T) {
+		nodes := []evmclient.Node{
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, 10, 1, slow),
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, 10, 1, fast),
+			newLatencyWeightedNode(t, evmclient.NodeStateOutOfSync, 10, 1, evmclient.NodeRPCStats{}),
+		}
+		selector := evmclient.NewLatencyWeightedNodeSelector(nodes, 2)
+		assert.Same(t, nodes[1], selector.Select())
+		assert.Equal(t, evmclient.NodeSelectionMode_LatencyWeighted, selector.Name())
+	})
+
+	t.Run("penalises errors", func(t *testing.T) {
+		flaky := evmclient.NodeRPCStats{AvgLatency: 50 * time.Millisecond, ErrorRate: 0.8, Samples: 100}
+		nodes := []evmclient.Node{
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, 10, 1, flaky),
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, 10, 1, slow),
+		}
+		selector := evmclient.NewLatencyWeightedNodeSelector(nodes, 2)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("prefers lower order over latency", func(t *testing.T) {
+		nodes := []evmclient.Node{
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, 10, 2, fast),
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, 10, 1, slow),
+		}
+		selector := evmclient.NewLatencyWeightedNodeSelector(nodes, 2)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("skips nodes lagging beyond the tolerance", func(t *testing.T) {
+		nodes := []evmclient.Node{
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, 7, 1, fast),
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, 8, 2, slow),
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, 10, 2, slow),
+		}
+		selector := evmclient.NewLatencyWeightedNodeSelector(nodes, 2)
+		assert.Same(t, nodes[1], selector.Select())
+
+		selector = evmclient.NewLatencyWeightedNodeSelector(nodes, 3)
+		assert.Same(t, nodes[0], selector.Select())
+	})
+
+	t.Run("prefers nodes with enough samples", func(t *testing.T) {
+		few := evmclient.NodeRPCStats{AvgLatency: 10 * time.Millisecond, Samples: 3}
+		nodes := []evmclient.Node{
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, 10, 1, evmclient.NodeRPCStats{}),
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, 10, 1, few),
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, 10, 1, slow),
+		}
+		selector := evmclient.NewLatencyWeightedNodeSelector(nodes, 2)
+		assert.Same(t, nodes[2], selector.Select())
+	})
+
+	t.Run("selects nodes without samples if no other is available", func(t *testing.T) {
+		nodes := []evmclient.Node{
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, 10, 1, evmclient.NodeRPCStats{}),
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, 10, 1, evmclient.NodeRPCStats{}),
+		}
+		selector := evmclient.NewLatencyWeightedNodeSelector(nodes, 2)
+		assert.Same(t, nodes[0], selector.Select())
+	})
+
+	t.Run("nodes never update latest block number", func(t *testing.T) {
+		nodes := []evmclient.Node{
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, -1, 1, slow),
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, -1, 1, fast),
+		}
+		selector := evmclient.NewLatencyWeightedNodeSelector(nodes, 2)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("ties go to the earliest node", func(t *testing.T) {
+		nodes := []evmclient.Node{
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, 10, 1, fast),
+			newLatencyWeightedNode(t, evmclient.NodeStateAlive, 10, 1, fast),
+		}
+		selector := evmclient.NewLatencyWeightedNodeSelector(nodes, 2)
+		assert.Same(t, nodes[0], selector.Select())
+	})
+}
+
+func TestLatencyWeightedNodeSelector_Reselect(t *testing.T) {
+	t.Parallel()
+
+	// only the active node collects samples between ticks
+	statsA := evmclient.NodeRPCStats{AvgLatency: 100 * time.Millisecond, Samples: 100}
+	var statsB evmclient.NodeRPCStats
+	newNode := func(stats *evmclient.NodeRPCStats) *evmmocks.Node {
+		node := evmmocks.NewNode(t)
+		node.On("StateAndLatest").Return(evmclient.NodeStateAlive, int64(10), nil)
+		node.On("Order").Return(int32(1))
+		node.On("RPCStats").Return(func() evmclient.NodeRPCStats { return *stats })
+		return node
+	}
+	nodes := []evmclient.Node{newNode(&statsA), newNode(&statsB)}
+	selector := evmclient.NewLatencyWeightedNodeSelector(nodes, 2)
+
+	for tick := 0; tick < 5; tick++ {
+		assert.Same(t, nodes[0], selector.Select(), "tick %d", tick)
+	}
+
+	// the other node collected a few samples from polling, not enough to be trusted
+	statsB = evmclient.NodeRPCStats{AvgLatency: 10 * time.Millisecond, Samples: 5}
+	assert.Same(t, nodes[0], selector.Select())
+
+	// slightly faster is not enough to switch
+	statsB = evmclient.NodeRPCStats{AvgLatency: 90 * time.Millisecond, Samples: 50}
+	for tick := 0; tick < 5; tick++ {
+		assert.Same(t, nodes[0], selector.Select(), "tick %d", tick)
+	}
+
+	// clearly faster switches, and the selection then sticks
+	statsB = evmclient.NodeRPCStats{AvgLatency: 50 * time.Millisecond, Samples: 50}
+	for tick := 0; tick < 5; tick++ {
+		assert.Same(t, nodes[1], selector.Select(), "tick %d", tick)
+	}
+	statsA = evmclient.NodeRPCStats{AvgLatency: 45 * time.Millisecond, Samples: 100}
+	assert.Same(t, nodes[1], selector.Select())
+}
+
+func TestLatencyWeightedNodeSelector_None(t *testing.T) {
+	t.Parallel()
+
+	nodes := []evmclient.Node{
+		newLatencyWeightedNode(t, evmclient.NodeStateOutOfSync, 10, 1, evmclient.NodeRPCStats{}),
+		newLatencyWeightedNode(t, evmclient.NodeStateUnreachable, 10, 1, evmclient.NodeRPCStats{}),
+	}
+	selector := evmclient.NewLatencyWeightedNodeSelector(nodes, 2)
+	assert.Nil(t, selector.Select())
+}
@@ -34,6 +34,7 @@ const (
 	NodeSelectionMode_HighestHead     = "HighestHead"
 	NodeSelectionMode_RoundRobin      = "RoundRobin"
 	NodeSelectionMode_TotalDifficulty = "TotalDifficulty"
+	NodeSelectionMode_LatencyWeighted = "LatencyWeighted"
 )
 
 // NodeSelector represents a strategy to select the next node from the pool.
@@ -49,6 +50,11 @@ type NodeSelector interface {
 type PoolConfig interface {
 	NodeSelectionMode() string
 	NodeNoNewHeadsThreshold() time.Duration
+	NodeHeadLagTolerance() uint32
+	NodeQuorumEnabled() bool
+	NodeQuorumMethods() []string
+	NodeQuorumNodes() uint32
+	NodeQuorumThreshold() uint32
 }
 
 // Pool represents an abstraction over one or more primary nodes
@@ -66,6 +72,8 @@ type Pool struct {
 	activeMu   sync.RWMutex
 	activeNode Node
 
+	quorumMethods map[string]struct{}
+
 	chStop utils.StopChan
 	wg     sync.WaitGroup
 }
@@ -83,6 +91,8 @@ func NewPool(logger logger.Logger, cfg PoolConfig, nodes []Node, sendonlys []Sen
 			return NewRoundRobinSelector(nodes)
 		case NodeSelectionMode_TotalDifficulty:
 			return NewTotalDifficultyNodeSelector(nodes)
+		case NodeSelectionMode_LatencyWeighted:
+			return NewLatencyWeightedNodeSelector(nodes, cfg.NodeHeadLagTolerance())
 		default:
 			panic(fmt.Sprintf("unsupported NodeSelectionMode: %s", cfg.NodeSelectionMode()))
 		}
@@ -103,6 +113,15 @@ func NewPool(logger logger.Logger, cfg PoolConfig, nodes []Node, sendonlys []Sen
 
 	p.logger.Debugf("The pool is configured to use NodeSelectionMode: %s", cfg.NodeSelectionMode())
 
+	if cfg.NodeQuorumEnabled() {
+		p.quorumMethods = make(map[string]struct{})
+		for _, m := range cfg.NodeQuorumMethods() {
+			p.quorumMethods[m] = struct{}{}
+		}
+		p.logger.Infow(fmt.Sprintf("The pool is configured to use quorum reads: %d/%d nodes must agree", cfg.NodeQuorumThreshold(), cfg.NodeQuorumNodes()),
+			"methods", cfg.NodeQuorumMethods())
+	}
+
 	return p
 }
 
@@ -181,6 +200,11 @@ func (p *Pool) runLoop() {
 		select {
 		case <-monitor.C:
 			p.report()
+			if p.nodeSelector.Name() == NodeSelectionMode_LatencyWeighted {
+				// latency and error rates change over time, so periodically
+				// switch to the currently preferred node
+				p.reselectNode()
+			}
 		case <-p.chStop:
 			return
 		}
@@ -275,6 +299,21 @@ func (p *Pool) selectNode() (node Node) {
 	return p.activeNode
 }
 
+// reselectNode replaces the active node with the one currently preferred by
+// the node selector, if any
+func (p *Pool) reselectNode() {
+	node := p.nodeSelector.Select()
+	if node == nil {
+		return
+	}
+	p.activeMu.Lock()
+	defer p.activeMu.Unlock()
+	if p.activeNode != node {
+		p.logger.Debugw("Switching active RPC node", "from", p.activeNode, "to", node.String())
+		p.activeNode = node
+	}
+}
+
 func (p *Pool) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
 	return p.selectNode().CallContext(ctx, result, method, args...)
 }
@@ -377,6 +416,11 @@ func (p *Pool) NonceAt(ctx context.Context, account common.Address, blockNumber
 }
 
 func (p *Pool) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if p.isQuorumMethod("TransactionReceipt") {
+		return quorumRead(ctx, p, "TransactionReceipt", func(ctx context.Context, n Node, _ *big.Int) (*types.Receipt, error) {
+			return n.TransactionReceipt(ctx, txHash)
+		}, receiptQuorumKey)
+	}
 	return p.selectNode().TransactionReceipt(ctx, txHash)
 }
 
@@ -385,6 +429,11 @@ func (p *Pool) TransactionByHash(ctx context.Context, txHash common.Hash) (*type
 }
 
 func (p *Pool) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	if p.isQuorumMethod("BlockByNumber") {
+		return quorumRead(ctx, p, "BlockByNumber", func(ctx context.Context, n Node, latest *big.Int) (*types.Block, error) {
+			return n.BlockByNumber(ctx, pinBlockNumber(number, latest))
+		}, blockQuorumKey)
+	}
 	return p.selectNode().BlockByNumber(ctx, number)
 }
 
@@ -397,6 +446,11 @@ func (p *Pool) BlockNumber(ctx context.Context) (uint64, error) {
 }
 
 func (p *Pool) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	if p.isQuorumMethod("BalanceAt") {
+		return quorumRead(ctx, p, "BalanceAt", func(ctx context.Context, n Node, latest *big.Int) (*big.Int, error) {
+			return n.BalanceAt(ctx, account, pinBlockNumber(blockNumber, latest))
+		}, bigIntQuorumKey)
+	}
 	return p.selectNode().BalanceAt(ctx, account, blockNumber)
 }
 
@@ -417,6 +471,11 @@ func (p *Pool) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
 }
 
 func (p *Pool) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if p.isQuorumMethod("CallContract") {
+		return quorumRead(ctx, p, "CallContract", func(ctx context.Context, n Node, latest *big.Int) ([]byte, error) {
+			return n.CallContract(ctx, msg, pinBlockNumber(blockNumber, latest))
+		}, bytesQuorumKey)
+	}
 	return p.selectNode().CallContract(ctx, msg, blockNumber)
 }
 
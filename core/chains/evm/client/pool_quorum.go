@@ -0,0 +1,186 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// PromEVMPoolQuorumReads reports the outcome of quorum reads
+	PromEVMPoolQuorumReads = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_quorum_reads",
+		Help: "The number of quorum reads for the given chain and method, by result (unanimous, mismatch or failed)",
+	}, []string{"evmChainID", "method", "result"})
+	// PromEVMPoolQuorumNodeDisagreements reports how often a node disagreed with the quorum
+	PromEVMPoolQuorumNodeDisagreements = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_quorum_node_disagreements",
+		Help: "The number of quorum reads in which the given node returned a response that did not match the quorum",
+	}, []string{"evmChainID", "method", "nodeName"})
+)
+
+const (
+	quorumResultUnanimous = "unanimous"
+	quorumResultMismatch  = "mismatch"
+	quorumResultFailed    = "failed"
+)
+
+// ErrQuorumNotReached is returned when not enough nodes agree on the response to a quorum read
+var ErrQuorumNotReached = errors.New("quorum not reached")
+
+func (p *Pool) isQuorumMethod(method string) bool {
+	_, ok := p.quorumMethods[method]
+	return ok
+}
+
+// quorumNodes returns up to NodeQuorumNodes live nodes, starting with the
+// active node, along with the lowest latest block number among them (nil if
+// unknown).
+func (p *Pool) quorumNodes() (nodes []Node, latest *big.Int) {
+	max := int(p.config.NodeQuorumNodes())
+	var minLatest int64
+	add := func(n Node) {
+		state, num, _ := n.StateAndLatest()
+		if state != NodeStateAlive || len(nodes) >= max {
+			return
+		}
+		for _, existing := range nodes {
+			if existing == n {
+				return
+			}
+		}
+		nodes = append(nodes, n)
+		if num > 0 && (minLatest == 0 || num < minLatest) {
+			minLatest = num
+		}
+	}
+	add(p.selectNode())
+	for _, n := range p.nodes {
+		add(n)
+	}
+	if minLatest > 0 {
+		latest = big.NewInt(minLatest)
+	}
+	return
+}
+
+// pinBlockNumber returns blockNumber, or latest if blockNumber is nil, so that
+// nodes at slightly different heights answer for the same block.
+func pinBlockNumber(blockNumber, latest *big.Int) *big.Int {
+	if blockNumber != nil {
+		return blockNumber
+	}
+	return latest
+}
+
+type quorumResponse[T any] struct {
+	node Node
+	key  string
+	res  T
+	err  error
+}
+
+// quorumRead fans out call to NodeQuorumNodes live nodes and returns the
+// response that at least NodeQuorumThreshold of them agree on, as determined
+// by key. Errors are compared by message, so that e.g. a revert or a missing
+// receipt can also reach quorum. Nodes that disagree with the quorum are
+// logged and counted.
+func quorumRead[T any](ctx context.Context, p *Pool, method string, call func(ctx context.Context, n Node, latest *big.Int) (T, error), key func(T) string) (res T, err error) {
+	threshold := int(p.config.NodeQuorumThreshold())
+	nodes, latest := p.quorumNodes()
+	chainID := p.chainID.String()
+	if len(nodes) < threshold {
+		PromEVMPoolQuorumReads.WithLabelValues(chainID, method, quorumResultFailed).Inc()
+		return res, errors.Wrapf(ErrQuorumNotReached, "%s: only %d live nodes available, need %d", method, len(nodes), threshold)
+	}
+
+	responses := make([]quorumResponse[T], len(nodes))
+	var wg sync.WaitGroup
+	wg.Add(len(nodes))
+	for i, n := range nodes {
+		go func(i int, n Node) {
+			defer wg.Done()
+			r, err := call(ctx, n, latest)
+			responses[i] = quorumResponse[T]{node: n, res: r, err: err}
+			if err != nil {
+				responses[i].key = quorumErrorKey(err)
+			} else {
+				responses[i].key = key(r)
+			}
+		}(i, n)
+	}
+	wg.Wait()
+
+	counts := make(map[string]int)
+	var winner *quorumResponse[T]
+	for i := range responses {
+		r := &responses[i]
+		counts[r.key]++
+		// ties go to the earliest response, i.e. the active node
+		if winner == nil || counts[r.key] > counts[winner.key] {
+			winner = r
+		}
+	}
+
+	reached := counts[winner.key] >= threshold
+	for _, r := range responses {
+		if r.key == winner.key {
+			continue
+		}
+		PromEVMPoolQuorumNodeDisagreements.WithLabelValues(chainID, method, r.node.String()).Inc()
+		p.logger.Warnw(fmt.Sprintf("Node disagreed with the majority response to %s", method), "nodeName", r.node.String(),
+			"response", r.key, "majorityResponse", winner.key, "quorumReached", reached)
+	}
+
+	if !reached {
+		PromEVMPoolQuorumReads.WithLabelValues(chainID, method, quorumResultFailed).Inc()
+		p.logger.Errorw(fmt.Sprintf("Quorum not reached for %s", method), "matching", counts[winner.key], "threshold", threshold, "nodes", len(nodes))
+		return res, errors.Wrapf(ErrQuorumNotReached, "%s: %d/%d nodes agreed, need %d", method, counts[winner.key], len(nodes), threshold)
+	}
+	if counts[winner.key] == len(nodes) {
+		PromEVMPoolQuorumReads.WithLabelValues(chainID, method, quorumResultUnanimous).Inc()
+	} else {
+		PromEVMPoolQuorumReads.WithLabelValues(chainID, method, quorumResultMismatch).Inc()
+	}
+	return winner.res, winner.err
+}
+
+func quorumErrorKey(err error) string {
+	if errors.Is(err, ethereum.NotFound) {
+		return "error: " + ethereum.NotFound.Error()
+	}
+	return "error: " + err.Error()
+}
+
+func bytesQuorumKey(b []byte) string {
+	return hexutil.Encode(b)
+}
+
+func bigIntQuorumKey(i *big.Int) string {
+	return i.String()
+}
+
+func blockQuorumKey(b *types.Block) string {
+	if b == nil {
+		return "<nil>"
+	}
+	return b.Hash().Hex()
+}
+
+// receiptQuorumKey only includes consensus fields, since optional fields like
+// the effective gas price are not populated consistently by all clients
+func receiptQuorumKey(r *types.Receipt) string {
+	if r == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("tx=%s block=%s status=%d gasUsed=%d cumulativeGasUsed=%d logs=%d",
+		r.TxHash, r.BlockHash, r.Status, r.GasUsed, r.CumulativeGasUsed, len(r.Logs))
+}
@@ -8,8 +8,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
 	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -22,11 +24,16 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 type poolConfig struct {
 	selectionMode       string
 	noNewHeadsThreshold time.Duration
+	headLagTolerance    uint32
+	quorumMethods       []string
+	quorumNodes         uint32
+	quorumThreshold     uint32
 }
 
 func (c poolConfig) NodeSelectionMode() string {
@@ -37,6 +44,26 @@ func (c poolConfig) NodeNoNewHeadsThreshold() time.Duration {
 	return c.noNewHeadsThreshold
 }
 
+func (c poolConfig) NodeHeadLagTolerance() uint32 {
+	return c.headLagTolerance
+}
+
+func (c poolConfig) NodeQuorumEnabled() bool {
+	return len(c.quorumMethods) > 0
+}
+
+func (c poolConfig) NodeQuorumMethods() []string {
+	return c.quorumMethods
+}
+
+func (c poolConfig) NodeQuorumNodes() uint32 {
+	return c.quorumNodes
+}
+
+func (c poolConfig) NodeQuorumThreshold() uint32 {
+	return c.quorumThreshold
+}
+
 var defaultConfig evmclient.PoolConfig = &poolConfig{
 	selectionMode:       evmclient.NodeSelectionMode_RoundRobin,
 	noNewHeadsThreshold: 0,
@@ -226,7 +253,7 @@ func (r *chainIDResps) newNode(t *testing.T, nodeChainID int64) evmclient.Node {
 	}
 
 	defer func() { r.id++ }()
-	return evmclient.NewNode(evmclient.TestNodeConfig{}, logger.TestLogger(t), *wsURL, httpURL, t.Name(), r.id, big.NewInt(nodeChainID))
+	return evmclient.NewNode(evmclient.TestNodeConfig{}, logger.TestLogger(t), *wsURL, httpURL, t.Name(), r.id, big.NewInt(nodeChainID), 1)
 }
 
 type chainIDService struct {
@@ -329,3 +356,94 @@ func TestUnit_Pool_BatchCallContextAll(t *testing.T) {
 	assert.False(t, p.ChainType().IsL2())
 	require.NoError(t, p.BatchCallContextAll(ctx, b))
 }
+
+func TestUnit_Pool_QuorumReads(t *testing.T) {
+	t.Parallel()
+
+	cfg := &poolConfig{
+		selectionMode:   evmclient.NodeSelectionMode_RoundRobin,
+		quorumMethods:   []string{"BalanceAt", "CallContract", "TransactionReceipt"},
+		quorumNodes:     3,
+		quorumThreshold: 2,
+	}
+	newNode := func(t *testing.T, name string, state evmclient.NodeState, latest int64) *evmmocks.Node {
+		n := evmmocks.NewNode(t)
+		n.On("String").Maybe().Return(name)
+		n.On("State").Maybe().Return(state)
+		n.On("StateAndLatest").Maybe().Return(state, latest, nil)
+		return n
+	}
+	addr := testutils.NewAddress()
+
+	t.Run("returns the response the quorum agrees on and records disagreements", func(t *testing.T) {
+		n1 := newNode(t, "n1", evmclient.NodeStateAlive, 10)
+		n2 := newNode(t, "n2", evmclient.NodeStateAlive, 11)
+		n3 := newNode(t, "n3", evmclient.NodeStateAlive, 12)
+		// reads at latest are pinned to the lowest head among the queried nodes
+		n1.On("BalanceAt", mock.Anything, addr, big.NewInt(10)).Return(big.NewInt(1), nil).Once()
+		n2.On("BalanceAt", mock.Anything, addr, big.NewInt(10)).Return(big.NewInt(42), nil).Once()
+		n3.On("BalanceAt", mock.Anything, addr, big.NewInt(10)).Return(big.NewInt(42), nil).Once()
+
+		lggr, observedLogs := logger.TestLoggerObserved(t, zap.WarnLevel)
+		p := evmclient.NewPool(lggr, cfg, []evmclient.Node{n1, n2, n3}, nil, &cltest.FixtureChainID, "")
+
+		disagreements := promtestutil.ToFloat64(evmclient.PromEVMPoolQuorumNodeDisagreements.WithLabelValues("0", "BalanceAt", "n1"))
+		balance, err := p.BalanceAt(testutils.Context(t), addr, nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), balance)
+		assert.Equal(t, disagreements+1, promtestutil.ToFloat64(evmclient.PromEVMPoolQuorumNodeDisagreements.WithLabelValues("0", "BalanceAt", "n1")))
+		testutils.RequireLogMessage(t, observedLogs, "Node disagreed with the majority response to BalanceAt")
+	})
+
+	t.Run("returns an error if the quorum is not reached", func(t *testing.T) {
+		n1 := newNode(t, "n1", evmclient.NodeStateAlive, 10)
+		n2 := newNode(t, "n2", evmclient.NodeStateAlive, 10)
+		n3 := newNode(t, "n3", evmclient.NodeStateAlive, 10)
+		blockNumber := big.NewInt(5)
+		n1.On("CallContract", mock.Anything, mock.Anything, blockNumber).Return([]byte{1}, nil).Once()
+		n2.On("CallContract", mock.Anything, mock.Anything, blockNumber).Return([]byte{2}, nil).Once()
+		n3.On("CallContract", mock.Anything, mock.Anything, blockNumber).Return(nil, errors.New("timeout")).Once()
+
+		p := evmclient.NewPool(logger.TestLogger(t), cfg, []evmclient.Node{n1, n2, n3}, nil, &cltest.FixtureChainID, "")
+
+		_, err := p.CallContract(testutils.Context(t), ethereum.CallMsg{}, blockNumber)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, evmclient.ErrQuorumNotReached))
+	})
+
+	t.Run("errors can reach quorum", func(t *testing.T) {
+		n1 := newNode(t, "n1", evmclient.NodeStateAlive, 10)
+		n2 := newNode(t, "n2", evmclient.NodeStateAlive, 10)
+		hash := utils.NewHash()
+		n1.On("TransactionReceipt", mock.Anything, hash).Return(nil, ethereum.NotFound).Once()
+		n2.On("TransactionReceipt", mock.Anything, hash).Return(nil, ethereum.NotFound).Once()
+
+		p := evmclient.NewPool(logger.TestLogger(t), cfg, []evmclient.Node{n1, n2}, nil, &cltest.FixtureChainID, "")
+
+		_, err := p.TransactionReceipt(testutils.Context(t), hash)
+		assert.True(t, errors.Is(err, ethereum.NotFound))
+	})
+
+	t.Run("only queries live nodes and fails if too few are available", func(t *testing.T) {
+		n1 := newNode(t, "n1", evmclient.NodeStateAlive, 10)
+		n2 := newNode(t, "n2", evmclient.NodeStateOutOfSync, 5)
+
+		p := evmclient.NewPool(logger.TestLogger(t), cfg, []evmclient.Node{n1, n2}, nil, &cltest.FixtureChainID, "")
+
+		_, err := p.BalanceAt(testutils.Context(t), addr, nil)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, evmclient.ErrQuorumNotReached))
+		assert.Contains(t, err.Error(), "only 1 live nodes available, need 2")
+	})
+
+	t.Run("methods not configured for quorum use a single node", func(t *testing.T) {
+		n1 := newNode(t, "n1", evmclient.NodeStateAlive, 10)
+		n2 := newNode(t, "n2", evmclient.NodeStateAlive, 10)
+		n1.On("BlockByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, nil).Once()
+
+		p := evmclient.NewPool(logger.TestLogger(t), cfg, []evmclient.Node{n1, n2}, nil, &cltest.FixtureChainID, "")
+
+		_, err := p.BlockByNumber(testutils.Context(t), nil)
+		require.NoError(t, err)
+	})
+}
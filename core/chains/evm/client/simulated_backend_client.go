@@ -3,8 +3,10 @@ package client
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -14,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/misc"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
@@ -104,6 +107,8 @@ func (c *SimulatedBackendClient) CallContext(ctx context.Context, result interfa
 			return fmt.Errorf("first arg to SimulatedBackendClient.Call is an "+
 				"unrecognized type: %T; add processing logic for it here", result)
 		}
+	case "eth_feeHistory":
+		return c.feeHistory(ctx, result, args...)
 	default:
 		return fmt.Errorf("second arg to SimulatedBackendClient.Call is an RPC "+
 			"API method which has not yet been implemented: %s. Add processing for "+
@@ -111,6 +116,109 @@ func (c *SimulatedBackendClient) CallContext(ctx context.Context, result interfa
 	}
 }
 
+// feeHistory emulates eth_feeHistory by walking the requested blocks of the
+// simulated chain. Rewards are computed the same way as go-ethereum: the
+// effective tips of the transactions in each block, weighted by gas used.
+func (c *SimulatedBackendClient) feeHistory(ctx context.Context, result interface{}, args ...interface{}) error {
+	if len(args) != 3 {
+		return fmt.Errorf("eth_feeHistory should have three arguments, got %d", len(args))
+	}
+	var blockCount uint64
+	switch n := args[0].(type) {
+	case hexutil.Uint64:
+		blockCount = uint64(n)
+	case uint64:
+		blockCount = n
+	default:
+		return fmt.Errorf("first arg to eth_feeHistory must be a block count, got %T", args[0])
+	}
+	if blockCount == 0 {
+		return errors.New("eth_feeHistory block count must be greater than zero")
+	}
+	newest, err := c.blockNumber(args[1])
+	if err != nil {
+		return err
+	}
+	percentiles, ok := args[2].([]float64)
+	if !ok {
+		return fmt.Errorf("third arg to eth_feeHistory must be []float64, got %T", args[2])
+	}
+
+	oldest := new(big.Int).Sub(newest, new(big.Int).SetUint64(blockCount-1))
+	if oldest.Sign() < 0 {
+		oldest.SetInt64(0)
+	}
+	res := struct {
+		OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+		Reward       [][]*hexutil.Big `json:"reward,omitempty"`
+		BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
+		GasUsedRatio []float64        `json:"gasUsedRatio"`
+	}{OldestBlock: (*hexutil.Big)(oldest)}
+
+	var last *types.Header
+	for n := new(big.Int).Set(oldest); n.Cmp(newest) <= 0; n.Add(n, big.NewInt(1)) {
+		block, err := c.b.BlockByNumber(ctx, n)
+		if err != nil {
+			return errors.Wrapf(err, "eth_feeHistory failed to fetch block %s", n)
+		}
+		header := block.Header()
+		baseFee := new(big.Int)
+		if header.BaseFee != nil {
+			baseFee.Set(header.BaseFee)
+		}
+		res.BaseFee = append(res.BaseFee, (*hexutil.Big)(baseFee))
+		res.GasUsedRatio = append(res.GasUsedRatio, float64(header.GasUsed)/float64(header.GasLimit))
+
+		type tip struct {
+			gasUsed uint64
+			reward  *big.Int
+		}
+		tips := make([]tip, len(block.Transactions()))
+		for i, tx := range block.Transactions() {
+			receipt, err := c.b.TransactionReceipt(ctx, tx.Hash())
+			if err != nil {
+				return errors.Wrapf(err, "eth_feeHistory failed to fetch receipt for tx %s", tx.Hash())
+			}
+			reward, _ := tx.EffectiveGasTip(header.BaseFee)
+			tips[i] = tip{receipt.GasUsed, reward}
+		}
+		sort.Slice(tips, func(i, j int) bool { return tips[i].reward.Cmp(tips[j].reward) < 0 })
+
+		rewards := make([]*hexutil.Big, len(percentiles))
+		var txIndex int
+		var sumGasUsed uint64
+		if len(tips) > 0 {
+			sumGasUsed = tips[0].gasUsed
+		}
+		for i, p := range percentiles {
+			if len(tips) == 0 {
+				rewards[i] = (*hexutil.Big)(new(big.Int))
+				continue
+			}
+			thresholdGasUsed := uint64(float64(header.GasUsed) * p / 100)
+			for sumGasUsed < thresholdGasUsed && txIndex < len(tips)-1 {
+				txIndex++
+				sumGasUsed += tips[txIndex].gasUsed
+			}
+			rewards[i] = (*hexutil.Big)(tips[txIndex].reward)
+		}
+		res.Reward = append(res.Reward, rewards)
+		last = header
+	}
+	// The base fee of the next block is always included
+	nextBaseFee := new(big.Int)
+	if last != nil && last.BaseFee != nil {
+		nextBaseFee = misc.CalcBaseFee(c.b.Blockchain().Config(), last)
+	}
+	res.BaseFee = append(res.BaseFee, (*hexutil.Big)(nextBaseFee))
+
+	b, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, result)
+}
+
 func (c *SimulatedBackendClient) FilterEvents(ctx context.Context, q ethereum.FilterQuery) (logs []types.Log, err error) {
 	return c.b.FilterLogs(ctx, q)
 }
@@ -228,7 +336,9 @@ func (c *SimulatedBackendClient) blockNumber(number interface{}) (blockNumber *b
 
 // HeadByNumber returns our own header type.
 func (c *SimulatedBackendClient) HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error) {
-	if n == nil {
+	if n == nil || n.Sign() < 0 {
+		// The simulated chain has instant finality, so the block tags, e.g.
+		// finalized, all refer to the latest block
 		n = c.currentBlockNumber()
 	}
 	header, err := c.b.HeaderByNumber(ctx, n)
@@ -13,6 +13,7 @@ import (
 
 type ChainScopedOnlyConfig interface {
 	evmclient.NodeConfig
+	evmclient.PoolConfig
 
 	AutoCreateKey() bool
 	BalanceMonitorEnabled() bool
@@ -27,11 +28,14 @@ type ChainScopedOnlyConfig interface {
 	BlockHistoryEstimatorEIP1559FeeCapBufferBlocks() uint16
 	BlockHistoryEstimatorTransactionPercentile() uint16
 	ChainID() *big.Int
+	CompositeEstimatorMaxDeviationPercent() uint16
+	CompositeEstimatorModes() []string
 	EvmEIP1559DynamicFees() bool
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
 	EvmFinalityDepth() uint32
+	EvmFinalityTagEnabled() bool
 	EvmGasBumpPercent() uint16
 	EvmGasBumpThreshold() uint64
 	EvmGasBumpTxDepth() uint16
@@ -62,6 +66,9 @@ type ChainScopedOnlyConfig interface {
 	EvmNonceAutoSync() bool
 	EvmUseForwarders() bool
 	EvmRPCDefaultBatchSize() uint32
+	FeeHistoryEstimatorBaseFeeBufferBlocks() uint16
+	FeeHistoryEstimatorBlockCount() uint16
+	FeeHistoryEstimatorRewardPercentile() uint16
 	FlagsContractAddress() string
 	GasEstimatorMode() string
 	ChainType() config.ChainType
@@ -369,6 +369,93 @@ func Test_chainScopedConfig_Validate(t *testing.T) {
 			})
 			assert.Error(t, cfg.Validate())
 		})
+		t.Run("composite", func(t *testing.T) {
+			cfg := configWithChains(t, 10, &v2.Chain{
+				GasEstimator: v2.GasEstimator{
+					Mode: ptr("Composite"),
+					Composite: v2.CompositeEstimator{
+						Modes: &[]string{"L2Suggested", "FixedPrice"},
+					},
+				},
+			})
+			assert.NoError(t, cfg.Validate())
+
+			cfg = configWithChains(t, 10, &v2.Chain{
+				GasEstimator: v2.GasEstimator{
+					Mode: ptr("Composite"),
+					Composite: v2.CompositeEstimator{
+						Modes: &[]string{"FixedPrice", "L2Suggested"},
+					},
+				},
+			})
+			assert.Error(t, cfg.Validate())
+		})
+	})
+
+	t.Run("composite-estimator", func(t *testing.T) {
+		for _, tt := range []struct {
+			name  string
+			modes []string
+			ok    bool
+		}{
+			{"valid", []string{"Arbitrum", "BlockHistory", "FixedPrice"}, true},
+			{"empty", []string{}, false},
+			{"unknown", []string{"BlockHistory", "Foo"}, false},
+			{"nested", []string{"Composite", "FixedPrice"}, false},
+			{"duplicate", []string{"BlockHistory", "BlockHistory"}, false},
+		} {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				cfg := configWithChains(t, 0, &v2.Chain{
+					GasEstimator: v2.GasEstimator{
+						Mode: ptr("Composite"),
+						Composite: v2.CompositeEstimator{
+							Modes: &tt.modes,
+						},
+					},
+				})
+				if tt.ok {
+					assert.NoError(t, cfg.Validate())
+				} else {
+					assert.Error(t, cfg.Validate())
+				}
+			})
+		}
+	})
+
+	t.Run("node-pool-quorum", func(t *testing.T) {
+		for _, tt := range []struct {
+			name      string
+			methods   []string
+			nodes     uint32
+			threshold uint32
+			ok        bool
+		}{
+			{"valid", []string{"CallContract"}, 1, 1, true},
+			{"zero threshold", []string{"CallContract"}, 1, 0, false},
+			{"threshold above nodes", []string{"CallContract"}, 1, 2, false},
+			{"threshold above primary nodes", []string{"CallContract"}, 3, 2, false},
+			{"unsupported method", []string{"EstimateGas"}, 1, 1, false},
+		} {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				cfg := configWithChains(t, 0, &v2.Chain{
+					NodePool: v2.NodePool{
+						Quorum: v2.NodePoolQuorum{
+							Enabled:   ptr(true),
+							Methods:   &tt.methods,
+							Nodes:     &tt.nodes,
+							Threshold: &tt.threshold,
+						},
+					},
+				})
+				if tt.ok {
+					assert.NoError(t, cfg.Validate())
+				} else {
+					assert.Error(t, cfg.Validate())
+				}
+			})
+		}
 	})
 }
 
@@ -608,6 +608,36 @@ func (_m *ChainScopedConfig) ChainType() coreconfig.ChainType {
 	return r0
 }
 
+// CompositeEstimatorMaxDeviationPercent provides a mock function with given fields:
+func (_m *ChainScopedConfig) CompositeEstimatorMaxDeviationPercent() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// CompositeEstimatorModes provides a mock function with given fields:
+func (_m *ChainScopedConfig) CompositeEstimatorModes() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // CosmosEnabled provides a mock function with given fields:
 func (_m *ChainScopedConfig) CosmosEnabled() bool {
 	ret := _m.Called()
@@ -636,6 +666,20 @@ func (_m *ChainScopedConfig) DatabaseBackupDir() string {
 	return r0
 }
 
+// DatabaseBackupEncrypt provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseBackupEncrypt() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // DatabaseBackupFrequency provides a mock function with given fields:
 func (_m *ChainScopedConfig) DatabaseBackupFrequency() time.Duration {
 	ret := _m.Called()
@@ -650,6 +694,34 @@ func (_m *ChainScopedConfig) DatabaseBackupFrequency() time.Duration {
 	return r0
 }
 
+// DatabaseBackupMaxAge provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseBackupMaxAge() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// DatabaseBackupMaxCount provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseBackupMaxCount() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // DatabaseBackupMode provides a mock function with given fields:
 func (_m *ChainScopedConfig) DatabaseBackupMode() coreconfig.DatabaseBackupMode {
 	ret := _m.Called()
@@ -1022,6 +1094,20 @@ func (_m *ChainScopedConfig) EvmFinalityDepth() uint32 {
 	return r0
 }
 
+// EvmFinalityTagEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmFinalityTagEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmGasBumpPercent provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmGasBumpPercent() uint16 {
 	ret := _m.Called()
@@ -1566,6 +1652,20 @@ func (_m *ChainScopedConfig) FeatureFeedsManager() bool {
 	return r0
 }
 
+// FeatureFeedsManagerAutoApproval provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeatureFeedsManagerAutoApproval() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // FeatureLogPoller provides a mock function with given fields:
 func (_m *ChainScopedConfig) FeatureLogPoller() bool {
 	ret := _m.Called()
@@ -1622,6 +1722,48 @@ func (_m *ChainScopedConfig) FeatureUICSAKeys() bool {
 	return r0
 }
 
+// FeeHistoryEstimatorBaseFeeBufferBlocks provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeeHistoryEstimatorBaseFeeBufferBlocks() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorBlockCount provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeeHistoryEstimatorBlockCount() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorRewardPercentile provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeeHistoryEstimatorRewardPercentile() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // FlagsContractAddress provides a mock function with given fields:
 func (_m *ChainScopedConfig) FlagsContractAddress() string {
 	ret := _m.Called()
@@ -1720,6 +1862,34 @@ func (_m *ChainScopedConfig) JSONConsole() bool {
 	return r0
 }
 
+// JobPipelineCircuitBreakerCooldown provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineCircuitBreakerCooldown() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// JobPipelineCircuitBreakerFailureThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineCircuitBreakerFailureThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // JobPipelineMaxRunDuration provides a mock function with given fields:
 func (_m *ChainScopedConfig) JobPipelineMaxRunDuration() time.Duration {
 	ret := _m.Called()
@@ -2193,6 +2363,20 @@ func (_m *ChainScopedConfig) MinimumContractPayment() *assets.Link {
 	return r0
 }
 
+// NodeHeadLagTolerance provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodeHeadLagTolerance() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // NodeNoNewHeadsThreshold provides a mock function with given fields:
 func (_m *ChainScopedConfig) NodeNoNewHeadsThreshold() time.Duration {
 	ret := _m.Called()
@@ -2235,6 +2419,64 @@ func (_m *ChainScopedConfig) NodePollInterval() time.Duration {
 	return r0
 }
 
+// NodeQuorumEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodeQuorumEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// NodeQuorumMethods provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodeQuorumMethods() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// NodeQuorumNodes provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodeQuorumNodes() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// NodeQuorumThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodeQuorumThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // NodeSelectionMode provides a mock function with given fields:
 func (_m *ChainScopedConfig) NodeSelectionMode() string {
 	ret := _m.Called()
@@ -3503,6 +3745,48 @@ func (_m *ChainScopedConfig) TelemetryIngressServerPubKey() string {
 	return r0
 }
 
+// TelemetryIngressSpoolEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryIngressSpoolEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TelemetryIngressSpoolMaxSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryIngressSpoolMaxSize() utils.FileSize {
+	ret := _m.Called()
+
+	var r0 utils.FileSize
+	if rf, ok := ret.Get(0).(func() utils.FileSize); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(utils.FileSize)
+	}
+
+	return r0
+}
+
+// TelemetryIngressSpoolSegmentSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryIngressSpoolSegmentSize() utils.FileSize {
+	ret := _m.Called()
+
+	var r0 utils.FileSize
+	if rf, ok := ret.Get(0).(func() utils.FileSize); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(utils.FileSize)
+	}
+
+	return r0
+}
+
 // TelemetryIngressURL provides a mock function with given fields:
 func (_m *ChainScopedConfig) TelemetryIngressURL() *url.URL {
 	ret := _m.Called()
@@ -3547,6 +3831,118 @@ func (_m *ChainScopedConfig) TelemetryIngressUseBatchSend() bool {
 	return r0
 }
 
+// TelemetryOTLPBufferSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryOTLPBufferSize() uint {
+	ret := _m.Called()
+
+	var r0 uint
+	if rf, ok := ret.Get(0).(func() uint); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	return r0
+}
+
+// TelemetryOTLPEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryOTLPEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TelemetryOTLPEndpoint provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryOTLPEndpoint() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TelemetryOTLPInsecure provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryOTLPInsecure() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TelemetryOTLPMaxBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryOTLPMaxBatchSize() uint {
+	ret := _m.Called()
+
+	var r0 uint
+	if rf, ok := ret.Get(0).(func() uint); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	return r0
+}
+
+// TelemetryOTLPProtocol provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryOTLPProtocol() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TelemetryOTLPSendInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryOTLPSendInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// TelemetryOTLPSendTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryOTLPSendTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
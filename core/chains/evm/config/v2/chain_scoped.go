@@ -107,6 +107,26 @@ func (c *ChainScoped) BlockHistoryEstimatorTransactionPercentile() uint16 {
 	return *c.cfg.GasEstimator.BlockHistory.TransactionPercentile
 }
 
+func (c *ChainScoped) FeeHistoryEstimatorBaseFeeBufferBlocks() uint16 {
+	return *c.cfg.GasEstimator.FeeHistory.BaseFeeBufferBlocks
+}
+
+func (c *ChainScoped) FeeHistoryEstimatorBlockCount() uint16 {
+	return *c.cfg.GasEstimator.FeeHistory.BlockCount
+}
+
+func (c *ChainScoped) FeeHistoryEstimatorRewardPercentile() uint16 {
+	return *c.cfg.GasEstimator.FeeHistory.RewardPercentile
+}
+
+func (c *ChainScoped) CompositeEstimatorMaxDeviationPercent() uint16 {
+	return *c.cfg.GasEstimator.Composite.MaxDeviationPercent
+}
+
+func (c *ChainScoped) CompositeEstimatorModes() []string {
+	return *c.cfg.GasEstimator.Composite.Modes
+}
+
 func (c *ChainScoped) EvmEIP1559DynamicFees() bool {
 	return *c.cfg.GasEstimator.EIP1559DynamicFees
 }
@@ -127,6 +147,10 @@ func (c *ChainScoped) EvmFinalityDepth() uint32 {
 	return *c.cfg.FinalityDepth
 }
 
+func (c *ChainScoped) EvmFinalityTagEnabled() bool {
+	return *c.cfg.FinalityTagEnabled
+}
+
 func (c *ChainScoped) EvmGasBumpPercent() uint16 {
 	return *c.cfg.GasEstimator.BumpPercent
 }
@@ -310,10 +334,30 @@ func (c *ChainScoped) NodePollInterval() time.Duration {
 	return c.cfg.NodePool.PollInterval.Duration()
 }
 
+func (c *ChainScoped) NodeQuorumEnabled() bool {
+	return *c.cfg.NodePool.Quorum.Enabled
+}
+
+func (c *ChainScoped) NodeQuorumMethods() []string {
+	return *c.cfg.NodePool.Quorum.Methods
+}
+
+func (c *ChainScoped) NodeQuorumNodes() uint32 {
+	return *c.cfg.NodePool.Quorum.Nodes
+}
+
+func (c *ChainScoped) NodeQuorumThreshold() uint32 {
+	return *c.cfg.NodePool.Quorum.Threshold
+}
+
 func (c *ChainScoped) NodeSelectionMode() string {
 	return *c.cfg.NodePool.SelectionMode
 }
 
+func (c *ChainScoped) NodeHeadLagTolerance() uint32 {
+	return *c.cfg.NodePool.HeadLagTolerance
+}
+
 func (c *ChainScoped) NodeSyncThreshold() uint32 {
 	return *c.cfg.NodePool.SyncThreshold
 }
@@ -3,6 +3,7 @@ package v2
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/pelletier/go-toml/v2"
@@ -288,17 +289,19 @@ func (c *EVMConfig) ValidateConfig() (err error) {
 	if len(c.Nodes) == 0 {
 		err = multierr.Append(err, v2.ErrMissing{Name: "Nodes", Msg: "must have at least one node"})
 	} else {
-		var hasPrimary bool
+		var primaries int
 		for _, n := range c.Nodes {
 			if n.SendOnly != nil && *n.SendOnly {
 				continue
 			}
-			hasPrimary = true
-			break
+			primaries++
 		}
-		if !hasPrimary {
+		if primaries == 0 {
 			err = multierr.Append(err, v2.ErrMissing{Name: "Nodes",
 				Msg: "must have at least one primary node with WSURL"})
+		} else if q := c.NodePool.Quorum; q.Enabled != nil && *q.Enabled && q.Threshold != nil && int(*q.Threshold) > primaries {
+			err = multierr.Append(err, v2.ErrInvalid{Name: "NodePool.Quorum.Threshold", Value: *q.Threshold,
+				Msg: fmt.Sprintf("must be less than or equal to the number of primary nodes (%d)", primaries)})
 		}
 	}
 
@@ -321,6 +324,7 @@ type Chain struct {
 	BlockBackfillSkip        *bool
 	ChainType                *string
 	FinalityDepth            *uint32
+	FinalityTagEnabled       *bool
 	FlagsContractAddress     *ethkey.EIP55Address
 	LinkContractAddress      *ethkey.EIP55Address
 	LogBackfillBatchSize     *uint32
@@ -360,6 +364,11 @@ func (c *Chain) ValidateConfig() (err error) {
 			switch gasEst {
 			case "Optimism2", "L2Suggested":
 				// valid
+			case "Composite":
+				if modes := *c.GasEstimator.Composite.Modes; len(modes) > 0 && modes[0] != "Optimism2" && modes[0] != "L2Suggested" {
+					err = multierr.Append(err, v2.ErrInvalid{Name: "GasEstimator.Composite.Modes", Value: modes,
+						Msg: fmt.Sprintf("must start with L2Suggested with ChainType %q", chainType)})
+				}
 			case "Optimism":
 				err = multierr.Append(err, v2.ErrInvalid{Name: "GasEstimator.Mode", Value: gasEst,
 					Msg: "unsupported since OVM 1.0 was discontinued - use L2Suggested"})
@@ -473,6 +482,8 @@ type GasEstimator struct {
 	TipCapMin     *assets.Wei
 
 	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
+	FeeHistory   FeeHistoryEstimator   `toml:",omitempty"`
+	Composite    CompositeEstimator    `toml:",omitempty"`
 }
 
 func (e *GasEstimator) ValidateConfig() (err error) {
@@ -509,6 +520,34 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, v2.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
 			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
 	}
+	if *e.Mode == "FeeHistory" && *e.FeeHistory.BlockCount <= 0 {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "FeeHistory.BlockCount", Value: *e.FeeHistory.BlockCount,
+			Msg: "must be greater than or equal to 1 with FeeHistory Mode"})
+	}
+	if *e.FeeHistory.RewardPercentile > 100 {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "FeeHistory.RewardPercentile", Value: *e.FeeHistory.RewardPercentile,
+			Msg: "must be less than or equal to 100"})
+	}
+	if *e.Mode == "Composite" {
+		if len(*e.Composite.Modes) == 0 {
+			err = multierr.Append(err, v2.ErrInvalid{Name: "Composite.Modes", Value: *e.Composite.Modes,
+				Msg: "must contain at least one mode with Composite Mode"})
+		}
+		seen := map[string]struct{}{}
+		for _, m := range *e.Composite.Modes {
+			switch m {
+			case "Arbitrum", "BlockHistory", "FeeHistory", "FixedPrice", "L2Suggested", "Optimism2":
+			default:
+				err = multierr.Append(err, v2.ErrInvalid{Name: "Composite.Modes", Value: m,
+					Msg: "must be one of Arbitrum, BlockHistory, FeeHistory, FixedPrice, L2Suggested or Optimism2"})
+				continue
+			}
+			if _, ok := seen[m]; ok {
+				err = multierr.Append(err, v2.NewErrDuplicate("Composite.Modes", m))
+			}
+			seen[m] = struct{}{}
+		}
+	}
 
 	return
 }
@@ -564,6 +603,8 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	}
 	e.LimitJobType.setFrom(&f.LimitJobType)
 	e.BlockHistory.setFrom(&f.BlockHistory)
+	e.FeeHistory.setFrom(&f.FeeHistory)
+	e.Composite.setFrom(&f.Composite)
 }
 
 type GasLimitJobType struct {
@@ -622,6 +663,38 @@ func (e *BlockHistoryEstimator) setFrom(f *BlockHistoryEstimator) {
 	}
 }
 
+type FeeHistoryEstimator struct {
+	BaseFeeBufferBlocks *uint16
+	BlockCount          *uint16
+	RewardPercentile    *uint16
+}
+
+func (e *FeeHistoryEstimator) setFrom(f *FeeHistoryEstimator) {
+	if v := f.BaseFeeBufferBlocks; v != nil {
+		e.BaseFeeBufferBlocks = v
+	}
+	if v := f.BlockCount; v != nil {
+		e.BlockCount = v
+	}
+	if v := f.RewardPercentile; v != nil {
+		e.RewardPercentile = v
+	}
+}
+
+type CompositeEstimator struct {
+	Modes               *[]string
+	MaxDeviationPercent *uint16
+}
+
+func (e *CompositeEstimator) setFrom(f *CompositeEstimator) {
+	if v := f.Modes; v != nil {
+		e.Modes = v
+	}
+	if v := f.MaxDeviationPercent; v != nil {
+		e.MaxDeviationPercent = v
+	}
+}
+
 type KeySpecificConfig []KeySpecific
 
 func (ks KeySpecificConfig) ValidateConfig() (err error) {
@@ -675,6 +748,9 @@ type NodePool struct {
 	PollInterval         *models.Duration
 	SelectionMode        *string
 	SyncThreshold        *uint32
+	HeadLagTolerance     *uint32
+
+	Quorum NodePoolQuorum `toml:",omitempty"`
 }
 
 func (p *NodePool) setFrom(f *NodePool) {
@@ -690,6 +766,56 @@ func (p *NodePool) setFrom(f *NodePool) {
 	if v := f.SyncThreshold; v != nil {
 		p.SyncThreshold = v
 	}
+	if v := f.HeadLagTolerance; v != nil {
+		p.HeadLagTolerance = v
+	}
+	p.Quorum.setFrom(&f.Quorum)
+}
+
+// QuorumMethods are the Pool read methods which support quorum reads
+var QuorumMethods = []string{"BalanceAt", "BlockByNumber", "CallContract", "TransactionReceipt"}
+
+type NodePoolQuorum struct {
+	Enabled   *bool
+	Methods   *[]string
+	Nodes     *uint32
+	Threshold *uint32
+}
+
+func (q *NodePoolQuorum) ValidateConfig() (err error) {
+	if q.Enabled == nil || !*q.Enabled {
+		return
+	}
+	if *q.Threshold == 0 {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "Threshold", Value: *q.Threshold,
+			Msg: "must be greater than 0"})
+	}
+	if *q.Threshold > *q.Nodes {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "Threshold", Value: *q.Threshold,
+			Msg: fmt.Sprintf("must be less than or equal to Nodes (%d)", *q.Nodes)})
+	}
+	for _, m := range *q.Methods {
+		if !slices.Contains(QuorumMethods, m) {
+			err = multierr.Append(err, v2.ErrInvalid{Name: "Methods", Value: m,
+				Msg: fmt.Sprintf("must be one of %s", strings.Join(QuorumMethods, ", "))})
+		}
+	}
+	return
+}
+
+func (q *NodePoolQuorum) setFrom(f *NodePoolQuorum) {
+	if v := f.Enabled; v != nil {
+		q.Enabled = v
+	}
+	if v := f.Methods; v != nil {
+		q.Methods = v
+	}
+	if v := f.Nodes; v != nil {
+		q.Nodes = v
+	}
+	if v := f.Threshold; v != nil {
+		q.Threshold = v
+	}
 }
 
 type OCR struct {
@@ -714,11 +840,15 @@ func (o *OCR) setFrom(f *OCR) {
 	}
 }
 
+// DefaultNodeOrder is the Order of nodes which do not set one, i.e. the lowest priority
+const DefaultNodeOrder int32 = 100
+
 type Node struct {
 	Name     *string
 	WSURL    *models.URL
 	HTTPURL  *models.URL
 	SendOnly *bool
+	Order    *int32
 }
 
 func (n *Node) ValidateConfig() (err error) {
@@ -760,6 +890,10 @@ func (n *Node) ValidateConfig() (err error) {
 		}
 	}
 
+	if n.Order != nil && (*n.Order < 1 || *n.Order > DefaultNodeOrder) {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "Order", Value: *n.Order, Msg: "must be between 1 and 100"})
+	}
+
 	return
 }
 
@@ -776,4 +910,7 @@ func (n *Node) SetFrom(f *Node) {
 	if f.SendOnly != nil {
 		n.SendOnly = f.SendOnly
 	}
+	if f.Order != nil {
+		n.Order = f.Order
+	}
 }
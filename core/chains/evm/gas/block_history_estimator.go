@@ -417,9 +417,13 @@ func (b *BlockHistoryEstimator) GetDynamicFee(_ context.Context, gasLimit uint32
 }
 
 func calcFeeCap(latestAvailableBaseFeePerGas *assets.Wei, cfg Config, tipCap *assets.Wei, maxGasPriceWei *assets.Wei) (feeCap *assets.Wei) {
-	const maxBaseFeeIncreasePerBlock float64 = 1.125
+	return projectFeeCap(latestAvailableBaseFeePerGas, int(cfg.BlockHistoryEstimatorEIP1559FeeCapBufferBlocks()), tipCap, maxGasPriceWei)
+}
 
-	bufferBlocks := int(cfg.BlockHistoryEstimatorEIP1559FeeCapBufferBlocks())
+// projectFeeCap returns the fee cap needed to cover the worst case base fee
+// after bufferBlocks full blocks, plus the tip cap
+func projectFeeCap(latestAvailableBaseFeePerGas *assets.Wei, bufferBlocks int, tipCap *assets.Wei, maxGasPriceWei *assets.Wei) (feeCap *assets.Wei) {
+	const maxBaseFeeIncreasePerBlock float64 = 1.125
 
 	baseFee := new(big.Float)
 	baseFee.SetInt(latestAvailableBaseFeePerGas.ToInt())
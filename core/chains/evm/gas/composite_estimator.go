@@ -0,0 +1,284 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/multierr"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var (
+	promCompositeEstimatorSourceUsed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gas_estimator_composite_source_used",
+		Help: "Number of fee estimations served by each source of the Composite estimator",
+	},
+		[]string{"evmChainID", "method", "source"},
+	)
+	promCompositeEstimatorSourceSkipped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gas_estimator_composite_source_skipped",
+		Help: "Number of times a source of the Composite estimator was skipped, by reason (error, out_of_bounds or deviation)",
+	},
+		[]string{"evmChainID", "method", "source", "reason"},
+	)
+)
+
+const (
+	compositeSkipError       = "error"
+	compositeSkipOutOfBounds = "out_of_bounds"
+	compositeSkipDeviation   = "deviation"
+)
+
+var _ EvmEstimator = &CompositeEstimator{}
+
+// CompositeSource is a named estimator used by the CompositeEstimator
+type CompositeSource struct {
+	Name      string
+	Estimator EvmEstimator
+}
+
+// CompositeEstimator chains an ordered list of estimators. Each estimation is
+// served by the first source that returns a result without error and within
+// the configured price bounds.
+//
+// If MaxDeviationPercent is set, the chosen result is also compared against
+// the next source that can produce one. When they are further apart than the
+// allowed deviation the chosen source is assumed to be misbehaving and the
+// next source is used instead.
+type CompositeEstimator struct {
+	utils.StartStopOnce
+	sources    []CompositeSource
+	config     Config
+	chainIDStr string
+	ms         services.MultiStart
+
+	logger logger.SugaredLogger
+}
+
+// NewCompositeEstimator returns a new CompositeEstimator wrapping sources, in
+// order of preference
+func NewCompositeEstimator(lggr logger.Logger, cfg Config, chainID big.Int, sources []CompositeSource) EvmEstimator {
+	return &CompositeEstimator{
+		sources:    sources,
+		config:     cfg,
+		chainIDStr: chainID.String(),
+		logger:     logger.Sugared(lggr.Named("CompositeEstimator")),
+	}
+}
+
+func (c *CompositeEstimator) Name() string {
+	return c.logger.Name()
+}
+
+func (c *CompositeEstimator) Start(ctx context.Context) error {
+	return c.StartOnce("CompositeEstimator", func() error {
+		var srvcs []services.StartClose
+		for _, s := range c.sources {
+			srvcs = append(srvcs, s.Estimator)
+		}
+		return c.ms.Start(ctx, srvcs...)
+	})
+}
+
+func (c *CompositeEstimator) Close() error {
+	return c.StopOnce("CompositeEstimator", func() error {
+		return c.ms.Close()
+	})
+}
+
+func (c *CompositeEstimator) HealthReport() map[string]error {
+	report := map[string]error{c.Name(): c.StartStopOnce.Healthy()}
+	for _, s := range c.sources {
+		for k, v := range s.Estimator.HealthReport() {
+			report[k] = v
+		}
+	}
+	return report
+}
+
+func (c *CompositeEstimator) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	for _, s := range c.sources {
+		s.Estimator.OnNewLongestChain(ctx, head)
+	}
+}
+
+func (c *CompositeEstimator) GetLegacyGas(ctx context.Context, calldata []byte, gasLimit uint32, maxGasPriceWei *assets.Wei, opts ...txmgrtypes.Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	const method = "GetLegacyGas"
+	maxGasPrice := getMaxGasPrice(maxGasPriceWei, c.config.EvmMaxGasPriceWei())
+	var chosen *CompositeSource
+	var errs error
+	for i := range c.sources {
+		s := &c.sources[i]
+		price, limit, serr := s.Estimator.GetLegacyGas(ctx, calldata, gasLimit, maxGasPriceWei, opts...)
+		if serr != nil {
+			c.skip(method, s.Name, compositeSkipError, "err", serr)
+			errs = multierr.Append(errs, errors.Wrap(serr, s.Name))
+			continue
+		}
+		if reason := c.legacyOutOfBounds(price, maxGasPrice); reason != "" {
+			c.skip(method, s.Name, compositeSkipOutOfBounds, "gasPrice", price, "reason", reason)
+			errs = multierr.Append(errs, errors.Errorf("%s: %s", s.Name, reason))
+			continue
+		}
+		if chosen == nil {
+			chosen, gasPrice, chainSpecificGasLimit = s, price, limit
+			if c.config.CompositeEstimatorMaxDeviationPercent() == 0 {
+				break
+			}
+			continue
+		}
+		// s is the reference for the deviation check of chosen
+		if deviation, exceeded := c.deviates(gasPrice, price); exceeded {
+			c.skip(method, chosen.Name, compositeSkipDeviation, "gasPrice", gasPrice, "referenceSource", s.Name,
+				"referenceGasPrice", price, "deviationPercent", deviation)
+			chosen, gasPrice, chainSpecificGasLimit = s, price, limit
+		}
+		break
+	}
+	if chosen == nil {
+		return nil, 0, errors.Errorf("CompositeEstimator: all sources failed to estimate legacy gas: %v", errs)
+	}
+	c.use(method, chosen.Name, "gasPrice", gasPrice)
+	return
+}
+
+func (c *CompositeEstimator) GetDynamicFee(ctx context.Context, gasLimit uint32, maxGasPriceWei *assets.Wei) (fee DynamicFee, chainSpecificGasLimit uint32, err error) {
+	const method = "GetDynamicFee"
+	maxGasPrice := getMaxGasPrice(maxGasPriceWei, c.config.EvmMaxGasPriceWei())
+	var chosen *CompositeSource
+	var errs error
+	for i := range c.sources {
+		s := &c.sources[i]
+		f, limit, serr := s.Estimator.GetDynamicFee(ctx, gasLimit, maxGasPriceWei)
+		if serr != nil {
+			c.skip(method, s.Name, compositeSkipError, "err", serr)
+			errs = multierr.Append(errs, errors.Wrap(serr, s.Name))
+			continue
+		}
+		if reason := c.dynamicOutOfBounds(f, maxGasPrice); reason != "" {
+			c.skip(method, s.Name, compositeSkipOutOfBounds, "fee", f, "reason", reason)
+			errs = multierr.Append(errs, errors.Errorf("%s: %s", s.Name, reason))
+			continue
+		}
+		if chosen == nil {
+			chosen, fee, chainSpecificGasLimit = s, f, limit
+			if c.config.CompositeEstimatorMaxDeviationPercent() == 0 {
+				break
+			}
+			continue
+		}
+		// The fee cap includes a buffer that differs between estimators, so
+		// only the tip cap is a meaningful basis for comparison
+		if deviation, exceeded := c.deviates(fee.TipCap, f.TipCap); exceeded {
+			c.skip(method, chosen.Name, compositeSkipDeviation, "fee", fee, "referenceSource", s.Name,
+				"referenceFee", f, "deviationPercent", deviation)
+			chosen, fee, chainSpecificGasLimit = s, f, limit
+		}
+		break
+	}
+	if chosen == nil {
+		return DynamicFee{}, 0, errors.Errorf("CompositeEstimator: all sources failed to estimate dynamic fee: %v", errs)
+	}
+	c.use(method, chosen.Name, "fee", fee)
+	return
+}
+
+// BumpLegacyGas is delegated to the first source that succeeds. Bump errors
+// (e.g. exceeding the max gas price) are returned immediately since they
+// concern the transaction rather than the source.
+func (c *CompositeEstimator) BumpLegacyGas(ctx context.Context, originalGasPrice *assets.Wei, gasLimit uint32, maxGasPriceWei *assets.Wei, attempts []EvmPriorAttempt) (bumpedGasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	const method = "BumpLegacyGas"
+	var errs error
+	for _, s := range c.sources {
+		bumpedGasPrice, chainSpecificGasLimit, err = s.Estimator.BumpLegacyGas(ctx, originalGasPrice, gasLimit, maxGasPriceWei, attempts)
+		if IsBumpErr(err) {
+			return nil, 0, err
+		} else if err != nil {
+			c.skip(method, s.Name, compositeSkipError, "err", err)
+			errs = multierr.Append(errs, errors.Wrap(err, s.Name))
+			continue
+		}
+		c.use(method, s.Name, "bumpedGasPrice", bumpedGasPrice)
+		return
+	}
+	return nil, 0, errors.Errorf("CompositeEstimator: all sources failed to bump legacy gas: %v", errs)
+}
+
+// BumpDynamicFee is delegated to the first source that succeeds, see
+// BumpLegacyGas
+func (c *CompositeEstimator) BumpDynamicFee(ctx context.Context, originalFee DynamicFee, gasLimit uint32, maxGasPriceWei *assets.Wei, attempts []EvmPriorAttempt) (bumped DynamicFee, chainSpecificGasLimit uint32, err error) {
+	const method = "BumpDynamicFee"
+	var errs error
+	for _, s := range c.sources {
+		bumped, chainSpecificGasLimit, err = s.Estimator.BumpDynamicFee(ctx, originalFee, gasLimit, maxGasPriceWei, attempts)
+		if IsBumpErr(err) {
+			return DynamicFee{}, 0, err
+		} else if err != nil {
+			c.skip(method, s.Name, compositeSkipError, "err", err)
+			errs = multierr.Append(errs, errors.Wrap(err, s.Name))
+			continue
+		}
+		c.use(method, s.Name, "bumpedFee", bumped)
+		return
+	}
+	return DynamicFee{}, 0, errors.Errorf("CompositeEstimator: all sources failed to bump dynamic fee: %v", errs)
+}
+
+func (c *CompositeEstimator) legacyOutOfBounds(gasPrice, maxGasPrice *assets.Wei) string {
+	switch {
+	case gasPrice == nil:
+		return "no gas price"
+	case gasPrice.Cmp(c.config.EvmMinGasPriceWei()) < 0:
+		return fmt.Sprintf("gas price %s is below the minimum of %s", gasPrice, c.config.EvmMinGasPriceWei())
+	case gasPrice.Cmp(maxGasPrice) > 0:
+		return fmt.Sprintf("gas price %s is above the maximum of %s", gasPrice, maxGasPrice)
+	}
+	return ""
+}
+
+func (c *CompositeEstimator) dynamicOutOfBounds(fee DynamicFee, maxGasPrice *assets.Wei) string {
+	switch {
+	case fee.FeeCap == nil || fee.TipCap == nil:
+		return "incomplete dynamic fee"
+	case fee.TipCap.Cmp(c.config.EvmGasTipCapMinimum()) < 0:
+		return fmt.Sprintf("tip cap %s is below the minimum of %s", fee.TipCap, c.config.EvmGasTipCapMinimum())
+	case fee.TipCap.Cmp(fee.FeeCap) > 0:
+		return fmt.Sprintf("tip cap %s is above the fee cap of %s", fee.TipCap, fee.FeeCap)
+	case fee.FeeCap.Cmp(maxGasPrice) > 0:
+		return fmt.Sprintf("fee cap %s is above the maximum of %s", fee.FeeCap, maxGasPrice)
+	}
+	return ""
+}
+
+// deviates returns the deviation of price from reference in percent, and
+// whether it exceeds MaxDeviationPercent
+func (c *CompositeEstimator) deviates(price, reference *assets.Wei) (deviation *big.Int, exceeded bool) {
+	max := big.NewInt(int64(c.config.CompositeEstimatorMaxDeviationPercent()))
+	diff := new(big.Int).Abs(new(big.Int).Sub(price.ToInt(), reference.ToInt()))
+	if reference.IsZero() {
+		return nil, diff.Sign() > 0
+	}
+	deviation = diff.Mul(diff, big.NewInt(100))
+	deviation.Div(deviation, reference.ToInt())
+	return deviation, deviation.Cmp(max) > 0
+}
+
+func (c *CompositeEstimator) use(method, source string, keyvals ...interface{}) {
+	promCompositeEstimatorSourceUsed.WithLabelValues(c.chainIDStr, method, source).Inc()
+	c.logger.Debugw(fmt.Sprintf("%s served by %s", method, source), append(keyvals, "source", source)...)
+}
+
+func (c *CompositeEstimator) skip(method, source, reason string, keyvals ...interface{}) {
+	promCompositeEstimatorSourceSkipped.WithLabelValues(c.chainIDStr, method, source, reason).Inc()
+	c.logger.Warnw(fmt.Sprintf("%s: skipping source %s (%s)", method, source, reason), append(keyvals, "source", source)...)
+}
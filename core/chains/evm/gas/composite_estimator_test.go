@@ -0,0 +1,204 @@
+package gas_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func newCompositeConfig() *gas.MockConfig {
+	cfg := gas.NewMockConfig()
+	cfg.EvmMinGasPriceWeiF = assets.NewWeiI(10)
+	cfg.EvmMaxGasPriceWeiF = assets.NewWeiI(1000)
+	cfg.EvmGasTipCapMinimumF = assets.NewWeiI(1)
+	return cfg
+}
+
+func newCompositeEstimator(t *testing.T, cfg gas.Config, estimators ...*mocks.EvmEstimator) gas.EvmEstimator {
+	var sources []gas.CompositeSource
+	for i, e := range estimators {
+		sources = append(sources, gas.CompositeSource{Name: []string{"Arbitrum", "BlockHistory", "FixedPrice"}[i], Estimator: e})
+	}
+	return gas.NewCompositeEstimator(logger.TestLogger(t), cfg, *testutils.FixtureChainID, sources)
+}
+
+func TestCompositeEstimator_GetLegacyGas(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(500)
+	const gasLimit uint32 = 21000
+
+	t.Run("uses the first source if it succeeds", func(t *testing.T) {
+		primary, secondary := mocks.NewEvmEstimator(t), mocks.NewEvmEstimator(t)
+		primary.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(100), gasLimit+1, nil).Once()
+
+		c := newCompositeEstimator(t, newCompositeConfig(), primary, secondary)
+		gasPrice, chainSpecificGasLimit, err := c.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(100), gasPrice)
+		assert.Equal(t, gasLimit+1, chainSpecificGasLimit)
+	})
+
+	t.Run("falls back on error", func(t *testing.T) {
+		primary, secondary := mocks.NewEvmEstimator(t), mocks.NewEvmEstimator(t)
+		primary.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(nil, uint32(0), errors.New("rpc down")).Once()
+		secondary.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(200), gasLimit, nil).Once()
+
+		c := newCompositeEstimator(t, newCompositeConfig(), primary, secondary)
+		gasPrice, _, err := c.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(200), gasPrice)
+	})
+
+	t.Run("falls back on out of bounds results", func(t *testing.T) {
+		primary, secondary, tertiary := mocks.NewEvmEstimator(t), mocks.NewEvmEstimator(t), mocks.NewEvmEstimator(t)
+		// above the user specified max
+		primary.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(501), gasLimit, nil).Once()
+		// below the configured min
+		secondary.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(9), gasLimit, nil).Once()
+		tertiary.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(300), gasLimit, nil).Once()
+
+		c := newCompositeEstimator(t, newCompositeConfig(), primary, secondary, tertiary)
+		gasPrice, _, err := c.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(300), gasPrice)
+	})
+
+	t.Run("returns error if all sources fail", func(t *testing.T) {
+		primary, secondary := mocks.NewEvmEstimator(t), mocks.NewEvmEstimator(t)
+		primary.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(nil, uint32(0), errors.New("rpc down")).Once()
+		secondary.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(nil, uint32(0), errors.New("not started")).Once()
+
+		c := newCompositeEstimator(t, newCompositeConfig(), primary, secondary)
+		_, _, err := c.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "all sources failed to estimate legacy gas")
+		assert.Contains(t, err.Error(), "Arbitrum: rpc down")
+		assert.Contains(t, err.Error(), "BlockHistory: not started")
+	})
+
+	t.Run("with max deviation", func(t *testing.T) {
+		cfg := newCompositeConfig()
+		cfg.CompositeEstimatorMaxDeviationPercentF = 50
+
+		t.Run("keeps the first source if within the deviation of the next", func(t *testing.T) {
+			primary, secondary, tertiary := mocks.NewEvmEstimator(t), mocks.NewEvmEstimator(t), mocks.NewEvmEstimator(t)
+			primary.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(150), gasLimit, nil).Once()
+			secondary.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(100), gasLimit, nil).Once()
+
+			c := newCompositeEstimator(t, cfg, primary, secondary, tertiary)
+			gasPrice, _, err := c.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+			require.NoError(t, err)
+			assert.Equal(t, assets.NewWeiI(150), gasPrice)
+		})
+
+		t.Run("uses the next source if the deviation is exceeded", func(t *testing.T) {
+			primary, secondary := mocks.NewEvmEstimator(t), mocks.NewEvmEstimator(t)
+			primary.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(151), gasLimit, nil).Once()
+			secondary.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(100), gasLimit, nil).Once()
+
+			c := newCompositeEstimator(t, cfg, primary, secondary)
+			gasPrice, _, err := c.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+			require.NoError(t, err)
+			assert.Equal(t, assets.NewWeiI(100), gasPrice)
+		})
+
+		t.Run("keeps the first source if no reference is available", func(t *testing.T) {
+			primary, secondary := mocks.NewEvmEstimator(t), mocks.NewEvmEstimator(t)
+			primary.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.NewWeiI(151), gasLimit, nil).Once()
+			secondary.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(nil, uint32(0), errors.New("rpc down")).Once()
+
+			c := newCompositeEstimator(t, cfg, primary, secondary)
+			gasPrice, _, err := c.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+			require.NoError(t, err)
+			assert.Equal(t, assets.NewWeiI(151), gasPrice)
+		})
+	})
+}
+
+func TestCompositeEstimator_GetDynamicFee(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(500)
+	const gasLimit uint32 = 21000
+
+	t.Run("falls back if the tip cap is above the fee cap", func(t *testing.T) {
+		primary, secondary := mocks.NewEvmEstimator(t), mocks.NewEvmEstimator(t)
+		primary.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(gas.DynamicFee{FeeCap: assets.NewWeiI(10), TipCap: assets.NewWeiI(20)}, gasLimit, nil).Once()
+		secondary.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(gas.DynamicFee{FeeCap: assets.NewWeiI(200), TipCap: assets.NewWeiI(20)}, gasLimit, nil).Once()
+
+		c := newCompositeEstimator(t, newCompositeConfig(), primary, secondary)
+		fee, _, err := c.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(200), fee.FeeCap)
+	})
+
+	t.Run("compares tip caps for the deviation check", func(t *testing.T) {
+		cfg := newCompositeConfig()
+		cfg.CompositeEstimatorMaxDeviationPercentF = 10
+
+		primary, secondary := mocks.NewEvmEstimator(t), mocks.NewEvmEstimator(t)
+		primary.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(gas.DynamicFee{FeeCap: assets.NewWeiI(400), TipCap: assets.NewWeiI(50)}, gasLimit, nil).Once()
+		secondary.On("GetDynamicFee", mock.Anything, gasLimit, maxGasPrice).Return(gas.DynamicFee{FeeCap: assets.NewWeiI(200), TipCap: assets.NewWeiI(20)}, gasLimit, nil).Once()
+
+		c := newCompositeEstimator(t, cfg, primary, secondary)
+		fee, _, err := c.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{FeeCap: assets.NewWeiI(200), TipCap: assets.NewWeiI(20)}, fee)
+	})
+}
+
+func TestCompositeEstimator_Bump(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(500)
+	const gasLimit uint32 = 21000
+
+	t.Run("falls back on error", func(t *testing.T) {
+		primary, secondary := mocks.NewEvmEstimator(t), mocks.NewEvmEstimator(t)
+		primary.On("BumpLegacyGas", mock.Anything, assets.NewWeiI(100), gasLimit, maxGasPrice, mock.Anything).Return(nil, uint32(0), errors.New("rpc down")).Once()
+		secondary.On("BumpLegacyGas", mock.Anything, assets.NewWeiI(100), gasLimit, maxGasPrice, mock.Anything).Return(assets.NewWeiI(120), gasLimit, nil).Once()
+
+		c := newCompositeEstimator(t, newCompositeConfig(), primary, secondary)
+		bumped, _, err := c.BumpLegacyGas(testutils.Context(t), assets.NewWeiI(100), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(120), bumped)
+	})
+
+	t.Run("returns bump errors immediately", func(t *testing.T) {
+		primary, secondary := mocks.NewEvmEstimator(t), mocks.NewEvmEstimator(t)
+		original := gas.DynamicFee{FeeCap: assets.NewWeiI(490), TipCap: assets.NewWeiI(20)}
+		primary.On("BumpDynamicFee", mock.Anything, original, gasLimit, maxGasPrice, mock.Anything).Return(gas.DynamicFee{}, uint32(0), errors.Wrap(gas.ErrBumpGasExceedsLimit, "too high")).Once()
+
+		c := newCompositeEstimator(t, newCompositeConfig(), primary, secondary)
+		_, _, err := c.BumpDynamicFee(testutils.Context(t), original, gasLimit, maxGasPrice, nil)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, gas.ErrBumpGasExceedsLimit))
+	})
+}
+
+func TestCompositeEstimator_StartClose(t *testing.T) {
+	t.Parallel()
+
+	primary, secondary := mocks.NewEvmEstimator(t), mocks.NewEvmEstimator(t)
+	primary.On("Start", mock.Anything).Return(nil).Once()
+	secondary.On("Start", mock.Anything).Return(nil).Once()
+	primary.On("OnNewLongestChain", mock.Anything, mock.Anything).Once()
+	secondary.On("OnNewLongestChain", mock.Anything, mock.Anything).Once()
+	primary.On("Close").Return(nil).Once()
+	secondary.On("Close").Return(nil).Once()
+
+	c := newCompositeEstimator(t, newCompositeConfig(), primary, secondary)
+	require.NoError(t, c.Start(testutils.Context(t)))
+	c.OnNewLongestChain(testutils.Context(t), nil)
+	require.NoError(t, c.Close())
+}
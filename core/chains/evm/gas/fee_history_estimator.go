@@ -0,0 +1,263 @@
+package gas
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var _ EvmEstimator = &FeeHistoryEstimator{}
+
+// feeHistory is the result of an eth_feeHistory call
+type feeHistory struct {
+	OldestBlock *hexutil.Big     `json:"oldestBlock"`
+	Reward      [][]*hexutil.Big `json:"reward"`
+	// BaseFee has one more entry than the number of blocks requested, the
+	// last one being the base fee of the next (pending) block
+	BaseFee      []*hexutil.Big `json:"baseFeePerGas"`
+	GasUsedRatio []float64      `json:"gasUsedRatio"`
+}
+
+// FeeHistoryEstimator is an Estimator which uses the eth_feeHistory RPC to
+// estimate prices. Unlike the BlockHistoryEstimator it does not need to
+// download full blocks; the node computes the reward percentiles itself.
+type FeeHistoryEstimator struct {
+	utils.StartStopOnce
+	client    rpcClient
+	config    Config
+	mb        *utils.Mailbox[*evmtypes.Head]
+	wg        *sync.WaitGroup
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+
+	gasPrice     *assets.Wei
+	tipCap       *assets.Wei
+	baseFee      *assets.Wei
+	priceMu      sync.RWMutex
+	initialFetch atomic.Bool
+
+	logger logger.SugaredLogger
+}
+
+// NewFeeHistoryEstimator returns a new FeeHistoryEstimator that refreshes its
+// prices from eth_feeHistory on every new head
+func NewFeeHistoryEstimator(lggr logger.Logger, client rpcClient, cfg Config) EvmEstimator {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &FeeHistoryEstimator{
+		client:    client,
+		config:    cfg,
+		mb:        utils.NewSingleMailbox[*evmtypes.Head](),
+		wg:        new(sync.WaitGroup),
+		ctx:       ctx,
+		ctxCancel: cancel,
+		logger:    logger.Sugared(lggr.Named("FeeHistoryEstimator")),
+	}
+}
+
+func (f *FeeHistoryEstimator) Name() string {
+	return f.logger.Name()
+}
+
+// Start fetches the initial fee history and starts listening for new heads.
+// A failure to fetch is not fatal; the default prices are used until the
+// first successful fetch.
+func (f *FeeHistoryEstimator) Start(ctx context.Context) error {
+	return f.StartOnce("FeeHistoryEstimator", func() error {
+		f.logger.Trace("Starting")
+
+		fetchCtx, cancel := context.WithTimeout(ctx, MaxStartTime)
+		defer cancel()
+		if err := f.FetchAndRecalculate(fetchCtx); err != nil {
+			f.logger.Warnw("Initial eth_feeHistory fetch failed; will retry on next head", "err", err)
+		}
+
+		f.wg.Add(1)
+		go f.runLoop()
+
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) Close() error {
+	return f.StopOnce("FeeHistoryEstimator", func() error {
+		f.ctxCancel()
+		f.wg.Wait()
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) HealthReport() map[string]error {
+	return map[string]error{f.Name(): f.StartStopOnce.Healthy()}
+}
+
+// OnNewLongestChain triggers a refresh of the fee history
+func (f *FeeHistoryEstimator) OnNewLongestChain(_ context.Context, head *evmtypes.Head) {
+	f.mb.Deliver(head)
+}
+
+func (f *FeeHistoryEstimator) runLoop() {
+	defer f.wg.Done()
+	for {
+		select {
+		case <-f.ctx.Done():
+			return
+		case <-f.mb.Notify():
+			head, exists := f.mb.Retrieve()
+			if !exists {
+				f.logger.Debug("No head to retrieve")
+				continue
+			}
+			if err := f.FetchAndRecalculate(f.ctx); err != nil {
+				f.logger.Warnw("Error fetching fee history", "head", head, "err", err)
+			}
+		}
+	}
+}
+
+// FetchAndRecalculate calls eth_feeHistory for the configured number of
+// blocks and recalculates the tip cap, gas price and base fee.
+//
+// The tip cap is the median across non-empty blocks of the configured reward
+// percentile. The legacy gas price is the next block's base fee plus this tip,
+// which on chains without a base fee is simply the gas price percentile.
+func (f *FeeHistoryEstimator) FetchAndRecalculate(ctx context.Context) error {
+	var res feeHistory
+	blockCount := hexutil.Uint64(f.config.FeeHistoryEstimatorBlockCount())
+	percentile := float64(f.config.FeeHistoryEstimatorRewardPercentile())
+	if err := f.client.CallContext(ctx, &res, "eth_feeHistory", blockCount, "latest", []float64{percentile}); err != nil {
+		return errors.Wrap(err, "eth_feeHistory failed")
+	}
+	f.initialFetch.Store(true)
+
+	var baseFee *assets.Wei
+	if len(res.BaseFee) > 0 && res.BaseFee[len(res.BaseFee)-1] != nil {
+		baseFee = assets.NewWei(res.BaseFee[len(res.BaseFee)-1].ToInt())
+	}
+
+	var rewards []*big.Int
+	for i, reward := range res.Reward {
+		if i >= len(res.GasUsedRatio) || res.GasUsedRatio[i] == 0 || len(reward) == 0 || reward[0] == nil {
+			// Empty blocks always report a zero reward, which says nothing about the market price
+			continue
+		}
+		rewards = append(rewards, reward[0].ToInt())
+	}
+
+	f.priceMu.Lock()
+	defer f.priceMu.Unlock()
+	f.baseFee = baseFee
+	if len(rewards) == 0 {
+		f.logger.Debugw("No non-empty blocks in fee history; keeping previous prices", "oldestBlock", res.OldestBlock, "baseFee", baseFee)
+		return nil
+	}
+	sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+	tipCap := assets.NewWei(rewards[len(rewards)/2])
+
+	gasPrice := tipCap
+	if baseFee != nil {
+		gasPrice = baseFee.Add(tipCap)
+	}
+	gasPrice = assets.WeiMin(assets.WeiMax(gasPrice, f.config.EvmMinGasPriceWei()), f.config.EvmMaxGasPriceWei())
+	tipCap = assets.WeiMin(assets.WeiMax(tipCap, f.config.EvmGasTipCapMinimum()), f.config.EvmMaxGasPriceWei())
+
+	f.logger.Debugw("Recalculated prices from fee history", "oldestBlock", res.OldestBlock, "baseFee", baseFee, "tipCap", tipCap, "gasPrice", gasPrice)
+	f.gasPrice = gasPrice
+	f.tipCap = tipCap
+	return nil
+}
+
+func (f *FeeHistoryEstimator) GetLegacyGas(_ context.Context, _ []byte, gasLimit uint32, maxGasPriceWei *assets.Wei, _ ...txmgrtypes.Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	ok := f.IfStarted(func() {
+		gasPrice = f.getGasPrice()
+	})
+	if !ok {
+		return nil, 0, errors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	if gasPrice == nil {
+		if !f.initialFetch.Load() {
+			return nil, 0, errors.New("FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+		}
+		f.logger.Warn("Failed to estimate gas price. This is likely because there aren't any recent non-empty blocks to estimate from. " +
+			"Using EvmGasPriceDefault as fallback.")
+		gasPrice = f.config.EvmGasPriceDefault()
+	}
+	gasPrice, chainSpecificGasLimit = capGasPrice(gasPrice, maxGasPriceWei, f.config.EvmMaxGasPriceWei(), gasLimit, f.config.EvmGasLimitMultiplier())
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpLegacyGas(_ context.Context, originalGasPrice *assets.Wei, gasLimit uint32, maxGasPriceWei *assets.Wei, _ []EvmPriorAttempt) (bumpedGasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	return BumpLegacyGasPriceOnly(f.config, f.logger, f.getGasPrice(), originalGasPrice, gasLimit, maxGasPriceWei)
+}
+
+func (f *FeeHistoryEstimator) GetDynamicFee(_ context.Context, gasLimit uint32, maxGasPriceWei *assets.Wei) (fee DynamicFee, chainSpecificGasLimit uint32, err error) {
+	if !f.config.EvmEIP1559DynamicFees() {
+		return fee, 0, errors.New("Can't get dynamic fee, EIP1559 is disabled")
+	}
+
+	ok := f.IfStarted(func() {
+		chainSpecificGasLimit = commonfee.ApplyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
+		f.priceMu.RLock()
+		defer f.priceMu.RUnlock()
+		fee.TipCap = f.tipCap
+		if fee.TipCap == nil {
+			if !f.initialFetch.Load() {
+				err = errors.New("FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+				return
+			}
+			f.logger.Warn("Failed to estimate tip cap. This is likely because there aren't any recent non-empty blocks to estimate from. " +
+				"Using EvmGasTipCapDefault as fallback.")
+			fee.TipCap = f.config.EvmGasTipCapDefault()
+		}
+		maxGasPrice := getMaxGasPrice(maxGasPriceWei, f.config.EvmMaxGasPriceWei())
+		if f.config.EvmGasBumpThreshold() == 0 {
+			// just use the max gas price if gas bumping is disabled
+			fee.FeeCap = maxGasPrice
+		} else if f.baseFee != nil {
+			// Leave headroom for the base fee to rise over the buffer blocks before we would bump
+			fee.FeeCap = projectFeeCap(f.baseFee, int(f.config.FeeHistoryEstimatorBaseFeeBufferBlocks()), fee.TipCap, maxGasPrice)
+		} else {
+			err = errors.New("FeeHistoryEstimator: no value for next block base fee; cannot estimate EIP-1559 base fee. Are you trying to run with EIP1559 enabled on a non-EIP1559 chain?")
+		}
+	})
+	if !ok {
+		return fee, 0, errors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	if err != nil {
+		return DynamicFee{}, 0, err
+	}
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpDynamicFee(_ context.Context, originalFee DynamicFee, originalGasLimit uint32, maxGasPriceWei *assets.Wei, _ []EvmPriorAttempt) (bumped DynamicFee, chainSpecificGasLimit uint32, err error) {
+	return BumpDynamicFeeOnly(f.config, f.logger, f.getTipCap(), f.getBaseFee(), originalFee, originalGasLimit, maxGasPriceWei)
+}
+
+func (f *FeeHistoryEstimator) getGasPrice() *assets.Wei {
+	f.priceMu.RLock()
+	defer f.priceMu.RUnlock()
+	return f.gasPrice
+}
+
+func (f *FeeHistoryEstimator) getTipCap() *assets.Wei {
+	f.priceMu.RLock()
+	defer f.priceMu.RUnlock()
+	return f.tipCap
+}
+
+func (f *FeeHistoryEstimator) getBaseFee() *assets.Wei {
+	f.priceMu.RLock()
+	defer f.priceMu.RUnlock()
+	return f.baseFee
+}
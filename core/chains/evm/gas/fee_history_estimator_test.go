@@ -0,0 +1,243 @@
+package gas_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/assets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func newFeeHistoryConfig() *gas.MockConfig {
+	cfg := gas.NewMockConfig()
+	cfg.FeeHistoryEstimatorBaseFeeBufferBlocksF = 4
+	cfg.FeeHistoryEstimatorBlockCountF = 3
+	cfg.FeeHistoryEstimatorRewardPercentileF = 60
+	cfg.EvmGasBumpThresholdF = 3
+	cfg.EvmGasBumpPercentF = 10
+	cfg.EvmGasBumpWeiF = assets.NewWeiI(5)
+	cfg.EvmGasLimitMultiplierF = 1
+	cfg.EvmGasPriceDefaultF = assets.NewWeiI(42)
+	cfg.EvmGasTipCapDefaultF = assets.NewWeiI(7)
+	cfg.EvmGasTipCapMinimumF = assets.NewWeiI(1)
+	cfg.EvmMinGasPriceWeiF = assets.NewWeiI(1)
+	cfg.EvmMaxGasPriceWeiF = assets.NewWeiI(1000)
+	return cfg
+}
+
+func mockFeeHistory(client *mocks.RPCClient, baseFees []int64, rewards []int64, gasUsedRatios []float64) *mock.Call {
+	res := map[string]interface{}{
+		"oldestBlock":  (*hexutil.Big)(big.NewInt(1)),
+		"gasUsedRatio": gasUsedRatios,
+	}
+	var hexBaseFees []*hexutil.Big
+	for _, f := range baseFees {
+		hexBaseFees = append(hexBaseFees, (*hexutil.Big)(big.NewInt(f)))
+	}
+	res["baseFeePerGas"] = hexBaseFees
+	var hexRewards [][]*hexutil.Big
+	for _, r := range rewards {
+		hexRewards = append(hexRewards, []*hexutil.Big{(*hexutil.Big)(big.NewInt(r))})
+	}
+	res["reward"] = hexRewards
+	b, err := json.Marshal(res)
+	if err != nil {
+		panic(err)
+	}
+
+	return client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", hexutil.Uint64(3), "latest", []float64{60}).Return(nil).Run(func(args mock.Arguments) {
+		// Go through JSON as the result type is unexported
+		if err := json.Unmarshal(b, args.Get(1)); err != nil {
+			panic(err)
+		}
+	})
+}
+
+func TestFeeHistoryEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(1000)
+	const gasLimit uint32 = 21000
+
+	t.Run("calling GetLegacyGas on unstarted estimator returns error", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig())
+		_, _, err := f.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "FeeHistoryEstimator is not started; cannot estimate gas")
+	})
+
+	t.Run("returns error if the initial fetch failed", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("kaboom"))
+
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig())
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		_, _, err := f.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+	})
+
+	t.Run("estimates legacy gas price as next base fee plus median reward of non-empty blocks", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		// the second block is empty and must be ignored
+		mockFeeHistory(client, []int64{90, 95, 100, 110}, []int64{20, 0, 10, 30}, []float64{0.5, 0, 0.3, 0.9})
+
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig())
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		gasPrice, chainSpecificGasLimit, err := f.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(110+20), gasPrice)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+	})
+
+	t.Run("caps the legacy gas price at the user specified max gas price", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, []int64{90, 95, 100, 110}, []int64{20, 10, 30}, []float64{0.5, 0.3, 0.9})
+
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig())
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		gasPrice, _, err := f.GetLegacyGas(testutils.Context(t), nil, gasLimit, assets.NewWeiI(100))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(100), gasPrice)
+	})
+
+	t.Run("falls back to defaults if all blocks are empty", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, []int64{90, 95, 100, 110}, []int64{0, 0, 0}, []float64{0, 0, 0})
+
+		cfg := newFeeHistoryConfig()
+		cfg.EvmEIP1559DynamicFeesF = true
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, cfg)
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		gasPrice, _, err := f.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(42), gasPrice)
+
+		fee, _, err := f.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(7), fee.TipCap)
+	})
+
+	t.Run("GetDynamicFee returns error if EIP1559 is disabled", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig())
+		_, _, err := f.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "Can't get dynamic fee, EIP1559 is disabled")
+	})
+
+	t.Run("GetDynamicFee projects the fee cap over the base fee buffer blocks", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, []int64{90, 95, 100, 100}, []int64{20, 10, 30}, []float64{0.5, 0.3, 0.9})
+
+		cfg := newFeeHistoryConfig()
+		cfg.EvmEIP1559DynamicFeesF = true
+		cfg.EvmMaxGasPriceWeiF = assets.NewWeiI(10000)
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, cfg)
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		fee, chainSpecificGasLimit, err := f.GetDynamicFee(testutils.Context(t), gasLimit, assets.NewWeiI(10000))
+		require.NoError(t, err)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+		assert.Equal(t, assets.NewWeiI(20), fee.TipCap)
+		// 100 * 1.125^4 rounded down, plus the tip
+		assert.Equal(t, assets.NewWeiI(160+20), fee.FeeCap)
+	})
+
+	t.Run("GetDynamicFee uses the max gas price as fee cap if gas bumping is disabled", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, []int64{90, 95, 100, 100}, []int64{20, 10, 30}, []float64{0.5, 0.3, 0.9})
+
+		cfg := newFeeHistoryConfig()
+		cfg.EvmEIP1559DynamicFeesF = true
+		cfg.EvmGasBumpThresholdF = 0
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, cfg)
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		fee, _, err := f.GetDynamicFee(testutils.Context(t), gasLimit, assets.NewWeiI(500))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(500), fee.FeeCap)
+	})
+
+	t.Run("BumpLegacyGas bumps by at least the current estimate", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, []int64{90, 95, 100, 110}, []int64{20, 10, 30}, []float64{0.5, 0.3, 0.9})
+
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig())
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		bumped, _, err := f.BumpLegacyGas(testutils.Context(t), assets.NewWeiI(100), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		// current estimate of 130 beats 100 bumped by 10%
+		assert.Equal(t, assets.NewWeiI(130), bumped)
+	})
+}
+
+func TestFeeHistoryEstimator_SimulatedBackend(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	backend := cltest.NewSimulatedBackend(t, core.GenesisAlloc{
+		from: {Balance: assets.Ether(100).ToInt()},
+	}, 10e6)
+	chainID := backend.Blockchain().Config().ChainID
+	client := evmclient.NewSimulatedBackendClient(t, backend, chainID)
+
+	signer := types.LatestSignerForChainID(chainID)
+	to := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	for nonce, tip := range []int64{2e9, 3e9, 4e9} {
+		head, err := backend.HeaderByNumber(testutils.Context(t), nil)
+		require.NoError(t, err)
+		tx, err := types.SignNewTx(key, signer, &types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     uint64(nonce),
+			GasTipCap: big.NewInt(tip),
+			GasFeeCap: new(big.Int).Add(new(big.Int).Mul(head.BaseFee, big.NewInt(2)), big.NewInt(tip)),
+			Gas:       21000,
+			To:        &to,
+			Value:     big.NewInt(1),
+		})
+		require.NoError(t, err)
+		require.NoError(t, backend.SendTransaction(testutils.Context(t), tx))
+		backend.Commit()
+	}
+
+	cfg := newFeeHistoryConfig()
+	cfg.EvmEIP1559DynamicFeesF = true
+	cfg.EvmMaxGasPriceWeiF = assets.GWei(1000)
+	f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, cfg)
+	require.NoError(t, f.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+	fee, _, err := f.GetDynamicFee(testutils.Context(t), 21000, assets.GWei(1000))
+	require.NoError(t, err)
+	assert.Equal(t, assets.GWei(3), fee.TipCap)
+	assert.True(t, fee.FeeCap.Cmp(fee.TipCap) > 0)
+}
@@ -82,6 +82,8 @@ type MockConfig struct {
 	BlockHistoryEstimatorEIP1559FeeCapBufferBlocksF uint16
 	BlockHistoryEstimatorTransactionPercentileF     uint16
 	ChainTypeF                                      string
+	CompositeEstimatorMaxDeviationPercentF          uint16
+	CompositeEstimatorModesF                        []string
 	EvmEIP1559DynamicFeesF                          bool
 	EvmGasBumpPercentF                              uint16
 	EvmGasBumpThresholdF                            uint64
@@ -92,6 +94,9 @@ type MockConfig struct {
 	EvmMaxGasPriceWeiF                              *assets.Wei
 	EvmMinGasPriceWeiF                              *assets.Wei
 	EvmGasPriceDefaultF                             *assets.Wei
+	FeeHistoryEstimatorBaseFeeBufferBlocksF         uint16
+	FeeHistoryEstimatorBlockCountF                  uint16
+	FeeHistoryEstimatorRewardPercentileF            uint16
 }
 
 func NewMockConfig() *MockConfig {
@@ -130,6 +135,14 @@ func (m *MockConfig) ChainType() config.ChainType {
 	return config.ChainType(m.ChainTypeF)
 }
 
+func (m *MockConfig) CompositeEstimatorMaxDeviationPercent() uint16 {
+	return m.CompositeEstimatorMaxDeviationPercentF
+}
+
+func (m *MockConfig) CompositeEstimatorModes() []string {
+	return m.CompositeEstimatorModesF
+}
+
 func (m *MockConfig) EvmEIP1559DynamicFees() bool {
 	return m.EvmEIP1559DynamicFeesF
 }
@@ -182,6 +195,18 @@ func (m *MockConfig) EvmMinGasPriceWei() *assets.Wei {
 	return m.EvmMinGasPriceWeiF
 }
 
+func (m *MockConfig) FeeHistoryEstimatorBaseFeeBufferBlocks() uint16 {
+	return m.FeeHistoryEstimatorBaseFeeBufferBlocksF
+}
+
+func (m *MockConfig) FeeHistoryEstimatorBlockCount() uint16 {
+	return m.FeeHistoryEstimatorBlockCountF
+}
+
+func (m *MockConfig) FeeHistoryEstimatorRewardPercentile() uint16 {
+	return m.FeeHistoryEstimatorRewardPercentileF
+}
+
 func (m *MockConfig) GasEstimatorMode() string {
 	panic("not implemented") // TODO: Implement
 }
@@ -43,6 +43,8 @@ func NewEstimator(lggr logger.Logger, ethClient evmclient.Client, cfg Config) Ev
 		"blockHistorySize", cfg.BlockHistoryEstimatorBlockHistorySize(),
 		"eip1559FeeCapBufferBlocks", cfg.BlockHistoryEstimatorEIP1559FeeCapBufferBlocks(),
 		"transactionPercentile", cfg.BlockHistoryEstimatorTransactionPercentile(),
+		"feeHistoryBlockCount", cfg.FeeHistoryEstimatorBlockCount(),
+		"feeHistoryRewardPercentile", cfg.FeeHistoryEstimatorRewardPercentile(),
 		"eip1559DynamicFees", cfg.EvmEIP1559DynamicFees(),
 		"gasBumpPercent", cfg.EvmGasBumpPercent(),
 		"gasBumpThreshold", cfg.EvmGasBumpThreshold(),
@@ -55,18 +57,33 @@ func NewEstimator(lggr logger.Logger, ethClient evmclient.Client, cfg Config) Ev
 		"maxGasPriceWei", cfg.EvmMaxGasPriceWei(),
 		"minGasPriceWei", cfg.EvmMinGasPriceWei(),
 	)
-	switch s {
+	if s == "Composite" {
+		var sources []CompositeSource
+		for _, mode := range cfg.CompositeEstimatorModes() {
+			sources = append(sources, CompositeSource{Name: mode, Estimator: newEvmEstimator(lggr, ethClient, cfg, mode)})
+		}
+		lggr.Infow("Composite gas estimator sources", "modes", cfg.CompositeEstimatorModes(),
+			"maxDeviationPercent", cfg.CompositeEstimatorMaxDeviationPercent())
+		return NewWrappedEvmEstimator(NewCompositeEstimator(lggr, cfg, *ethClient.ConfiguredChainID(), sources), cfg)
+	}
+	return NewWrappedEvmEstimator(newEvmEstimator(lggr, ethClient, cfg, s), cfg)
+}
+
+func newEvmEstimator(lggr logger.Logger, ethClient evmclient.Client, cfg Config, mode string) EvmEstimator {
+	switch mode {
 	case "Arbitrum":
-		return NewWrappedEvmEstimator(NewArbitrumEstimator(lggr, cfg, ethClient, ethClient), cfg)
+		return NewArbitrumEstimator(lggr, cfg, ethClient, ethClient)
 	case "BlockHistory":
-		return NewWrappedEvmEstimator(NewBlockHistoryEstimator(lggr, ethClient, cfg, *ethClient.ConfiguredChainID()), cfg)
+		return NewBlockHistoryEstimator(lggr, ethClient, cfg, *ethClient.ConfiguredChainID())
+	case "FeeHistory":
+		return NewFeeHistoryEstimator(lggr, ethClient, cfg)
 	case "FixedPrice":
-		return NewWrappedEvmEstimator(NewFixedPriceEstimator(cfg, lggr), cfg)
+		return NewFixedPriceEstimator(cfg, lggr)
 	case "Optimism2", "L2Suggested":
-		return NewWrappedEvmEstimator(NewL2SuggestedPriceEstimator(lggr, ethClient), cfg)
+		return NewL2SuggestedPriceEstimator(lggr, ethClient)
 	default:
-		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
-		return NewWrappedEvmEstimator(NewFixedPriceEstimator(cfg, lggr), cfg)
+		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", mode)
+		return NewFixedPriceEstimator(cfg, lggr)
 	}
 }
 
@@ -227,6 +244,8 @@ type Config interface {
 	BlockHistoryEstimatorEIP1559FeeCapBufferBlocks() uint16
 	BlockHistoryEstimatorTransactionPercentile() uint16
 	ChainType() config.ChainType
+	CompositeEstimatorMaxDeviationPercent() uint16
+	CompositeEstimatorModes() []string
 	EvmEIP1559DynamicFees() bool
 	EvmFinalityDepth() uint32
 	EvmGasBumpPercent() uint16
@@ -240,6 +259,9 @@ type Config interface {
 	EvmGasTipCapMinimum() *assets.Wei
 	EvmMaxGasPriceWei() *assets.Wei
 	EvmMinGasPriceWei() *assets.Wei
+	FeeHistoryEstimatorBaseFeeBufferBlocks() uint16
+	FeeHistoryEstimatorBlockCount() uint16
+	FeeHistoryEstimatorRewardPercentile() uint16
 	GasEstimatorMode() string
 }
 
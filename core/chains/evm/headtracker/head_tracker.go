@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -27,6 +28,11 @@ var (
 		Help: "The highest seen head number",
 	}, []string{"evmChainID"})
 
+	promFinalizedHead = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_tracker_finalized_head",
+		Help: "The latest finalized head number, if the finality tag is enabled",
+	}, []string{"evmChainID"})
+
 	promOldHead = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "head_tracker_very_old_head",
 		Help: "Counter is incremented every time we get a head that is much lower than the highest seen head ('much lower' is defined as a block that is EVM.FinalityDepth or greater below the highest seen head)",
@@ -165,6 +171,14 @@ func (ht *headTracker) LatestChain() *evmtypes.Head {
 	return ht.headSaver.LatestChain()
 }
 
+func (ht *headTracker) LatestFinalizedBlock() *evmtypes.Head {
+	latest := ht.headSaver.LatestChain()
+	if latest == nil {
+		return nil
+	}
+	return latest.LatestFinalizedHead
+}
+
 func (ht *headTracker) getInitialHead(ctx context.Context) (*evmtypes.Head, error) {
 	head, err := ht.ethClient.HeadByNumber(ctx, nil)
 	if err != nil {
@@ -187,6 +201,17 @@ func (ht *headTracker) handleNewHead(ctx context.Context, head *evmtypes.Head) e
 		"parentHeadHash", head.ParentHash,
 	)
 
+	isNewHighest := prevHead == nil || head.Number > prevHead.Number
+	if ht.config.EvmFinalityTagEnabled() {
+		if isNewHighest {
+			ht.fetchFinality(ctx, head, prevHead)
+		} else {
+			// A head at the same height may become the latest chain, so it
+			// must not lose the finalized and safe blocks seen so far
+			carryFinality(head, prevHead)
+		}
+	}
+
 	err := ht.headSaver.Save(ctx, head)
 	if ctx.Err() != nil {
 		return nil
@@ -194,8 +219,11 @@ func (ht *headTracker) handleNewHead(ctx context.Context, head *evmtypes.Head) e
 		return errors.Wrapf(err, "failed to save head: %#v", head)
 	}
 
-	if prevHead == nil || head.Number > prevHead.Number {
+	if isNewHighest {
 		promCurrentHead.WithLabelValues(ht.chainID.String()).Set(float64(head.Number))
+		if head.LatestFinalizedHead != nil {
+			promFinalizedHead.WithLabelValues(ht.chainID.String()).Set(float64(head.LatestFinalizedHead.Number))
+		}
 
 		headWithChain := ht.headSaver.Chain(head.Hash)
 		if headWithChain == nil {
@@ -220,6 +248,53 @@ func (ht *headTracker) handleNewHead(ctx context.Context, head *evmtypes.Head) e
 	return nil
 }
 
+// fetchFinality sets the latest finalized and safe blocks of head. The blocks of
+// prevHead are carried over if they cannot be fetched, and the blocks never go
+// backwards nor beyond head, in case the RPC nodes disagree.
+func (ht *headTracker) fetchFinality(ctx context.Context, head *evmtypes.Head, prevHead *evmtypes.Head) {
+	carryFinality(head, prevHead)
+
+	fetch := func(tag rpc.BlockNumber, latest **evmtypes.Head) {
+		tagged, err := ht.ethClient.HeadByNumber(ctx, big.NewInt(tag.Int64()))
+		if err != nil {
+			if ctx.Err() == nil {
+				ht.log.Warnw("Failed to fetch tagged head", "tag", tag, "err", err)
+			}
+			return
+		} else if tagged == nil {
+			ht.log.Warnw("Got nil tagged head", "tag", tag)
+			return
+		}
+		if tagged.Number > head.Number {
+			ht.log.Debugw("Ignoring tagged head beyond the latest head", "tag", tag, "blockNumber", tagged.Number, "latestBlockNumber", head.Number)
+			return
+		}
+		if *latest == nil || tagged.Number >= (*latest).Number {
+			*latest = tagged
+		}
+	}
+	fetch(rpc.FinalizedBlockNumber, &head.LatestFinalizedHead)
+	fetch(rpc.SafeBlockNumber, &head.LatestSafeHead)
+}
+
+// carryFinality copies the latest finalized and safe blocks of prevHead onto
+// head, unless head already has later ones or they are beyond head.
+func carryFinality(head *evmtypes.Head, prevHead *evmtypes.Head) {
+	if prevHead == nil {
+		return
+	}
+	carry := func(prev *evmtypes.Head, latest **evmtypes.Head) {
+		if prev == nil || prev.Number > head.Number {
+			return
+		}
+		if *latest == nil || prev.Number > (*latest).Number {
+			*latest = prev
+		}
+	}
+	carry(prevHead.LatestFinalizedHead, &head.LatestFinalizedHead)
+	carry(prevHead.LatestSafeHead, &head.LatestSafeHead)
+}
+
 func (ht *headTracker) broadcastLoop() {
 	defer ht.wgDone.Done()
 
@@ -276,7 +351,7 @@ func (ht *headTracker) backfillLoop() {
 					break
 				}
 				{
-					err := ht.Backfill(ctx, head, uint(ht.config.EvmFinalityDepth()))
+					err := ht.Backfill(ctx, head, ht.backfillDepth(head))
 					if err != nil {
 						ht.log.Warnw("Unexpected error while backfilling heads", "err", err)
 					} else if ctx.Err() != nil {
@@ -288,6 +363,21 @@ func (ht *headTracker) backfillLoop() {
 	}
 }
 
+// backfillDepth returns the number of heads to keep in the chain of head. It is
+// the number of heads down to the latest finalized block, if it is known, or
+// else EVM.FinalityDepth. The depth is limited to the history depth.
+func (ht *headTracker) backfillDepth(head *evmtypes.Head) uint {
+	finalized := head.LatestFinalizedHead
+	if finalized == nil || finalized.Number > head.Number {
+		return uint(ht.config.EvmFinalityDepth())
+	}
+	depth := uint(head.Number-finalized.Number) + 1
+	if historyDepth := uint(ht.config.EvmHeadTrackerHistoryDepth()); depth > historyDepth {
+		depth = historyDepth
+	}
+	return depth
+}
+
 // backfill fetches all missing heads up until the base height
 func (ht *headTracker) backfill(ctx context.Context, head *evmtypes.Head, baseHeight int64) (err error) {
 	if head.Number <= baseHeight {
@@ -358,4 +448,5 @@ func (*nullTracker) SetLogLevel(zapcore.Level)      {}
 func (*nullTracker) Backfill(ctx context.Context, headWithChain *evmtypes.Head, depth uint) (err error) {
 	return nil
 }
-func (*nullTracker) LatestChain() *evmtypes.Head { return nil }
+func (*nullTracker) LatestChain() *evmtypes.Head          { return nil }
+func (*nullTracker) LatestFinalizedBlock() *evmtypes.Head { return nil }
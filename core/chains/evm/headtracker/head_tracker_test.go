@@ -19,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
@@ -262,6 +263,126 @@ func TestHeadTracker_CallsHeadTrackableCallbacks(t *testing.T) {
 	assert.Equal(t, int32(1), checker.OnNewLongestChainCount())
 }
 
+func TestHeadTracker_LatestFinalizedBlock(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewWithT(t)
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+	config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].FinalityTagEnabled = ptr(true)
+	})
+	orm := headtracker.NewORM(db, logger, config, *config.DefaultChainID())
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	chchHeaders := make(chan evmtest.RawSub[*evmtypes.Head], 1)
+	mockEth := &evmtest.MockEth{EthClient: ethClient}
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).
+		Return(
+			func(ctx context.Context, ch chan<- *evmtypes.Head) ethereum.Subscription {
+				sub := mockEth.NewSub(t)
+				chchHeaders <- evmtest.NewRawSub(ch, sub.Err())
+				return sub
+			},
+			func(ctx context.Context, ch chan<- *evmtypes.Head) error { return nil },
+		)
+	finalizedNum := big.NewInt(rpc.FinalizedBlockNumber.Int64())
+	safeNum := big.NewInt(rpc.SafeBlockNumber.Int64())
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(cltest.Head(0), nil)
+	ethClient.On("HeadByNumber", mock.Anything, finalizedNum).Return(cltest.Head(8), nil).Once()
+	ethClient.On("HeadByNumber", mock.Anything, finalizedNum).Return(nil, errors.New("unsupported block tag"))
+	ethClient.On("HeadByNumber", mock.Anything, safeNum).Return(cltest.Head(9), nil).Once()
+	ethClient.On("HeadByNumber", mock.Anything, safeNum).Return(cltest.Head(20), nil)
+	// Backfill
+	ethClient.On("HeadByNumber", mock.Anything, mock.Anything).Return(cltest.Head(0), nil).Maybe()
+
+	checker := &cltest.MockHeadTrackable{}
+	ht := createHeadTrackerWithChecker(t, ethClient, evmtest.NewChainScopedConfig(t, config), orm, checker)
+
+	ht.Start(t)
+	assert.Nil(t, ht.headTracker.LatestFinalizedBlock())
+
+	headers := <-chchHeaders
+	headers.TrySend(&evmtypes.Head{Number: 10, Hash: utils.NewHash(), EVMChainID: utils.NewBig(&cltest.FixtureChainID)})
+	g.Eventually(checker.OnNewLongestChainCount).Should(gomega.Equal(int32(1)))
+
+	finalized := ht.headTracker.LatestFinalizedBlock()
+	require.NotNil(t, finalized)
+	assert.Equal(t, int64(8), finalized.Number)
+	latest := ht.headTracker.LatestChain()
+	require.NotNil(t, latest.LatestSafeHead)
+	assert.Equal(t, int64(9), latest.LatestSafeHead.Number)
+
+	// The finalized block is carried over if it cannot be fetched, and a safe
+	// block beyond the latest head is ignored
+	headers.TrySend(&evmtypes.Head{Number: 11, Hash: utils.NewHash(), EVMChainID: utils.NewBig(&cltest.FixtureChainID)})
+	g.Eventually(checker.OnNewLongestChainCount).Should(gomega.Equal(int32(2)))
+
+	latest = ht.headTracker.LatestChain()
+	assert.Equal(t, int64(11), latest.Number)
+	require.NotNil(t, latest.LatestFinalizedHead)
+	assert.Equal(t, int64(8), latest.LatestFinalizedHead.Number)
+	require.NotNil(t, latest.LatestSafeHead)
+	assert.Equal(t, int64(9), latest.LatestSafeHead.Number)
+}
+
+func TestHeadTracker_LatestFinalizedBlock_SameHeight(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewWithT(t)
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+	config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].FinalityTagEnabled = ptr(true)
+	})
+	orm := headtracker.NewORM(db, logger, config, *config.DefaultChainID())
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	chchHeaders := make(chan evmtest.RawSub[*evmtypes.Head], 1)
+	mockEth := &evmtest.MockEth{EthClient: ethClient}
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).
+		Return(
+			func(ctx context.Context, ch chan<- *evmtypes.Head) ethereum.Subscription {
+				sub := mockEth.NewSub(t)
+				chchHeaders <- evmtest.NewRawSub(ch, sub.Err())
+				return sub
+			},
+			func(ctx context.Context, ch chan<- *evmtypes.Head) error { return nil },
+		)
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(cltest.Head(0), nil)
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(rpc.FinalizedBlockNumber.Int64())).Return(cltest.Head(8), nil).Once()
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(rpc.SafeBlockNumber.Int64())).Return(cltest.Head(9), nil).Once()
+	// Backfill
+	ethClient.On("HeadByNumber", mock.Anything, mock.Anything).Return(cltest.Head(0), nil).Maybe()
+
+	checker := &cltest.MockHeadTrackable{}
+	ht := createHeadTrackerWithChecker(t, ethClient, evmtest.NewChainScopedConfig(t, config), orm, checker)
+
+	ht.Start(t)
+
+	headers := <-chchHeaders
+	headers.TrySend(&evmtypes.Head{Number: 10, Hash: utils.NewHash(), EVMChainID: utils.NewBig(&cltest.FixtureChainID)})
+	g.Eventually(checker.OnNewLongestChainCount).Should(gomega.Equal(int32(1)))
+
+	// A sibling at the same height may become the latest chain, so it must
+	// carry the finalized and safe blocks without fetching them again
+	sibling := &evmtypes.Head{Number: 10, Hash: utils.NewHash(), EVMChainID: utils.NewBig(&cltest.FixtureChainID)}
+	headers.TrySend(sibling)
+	g.Eventually(func() *evmtypes.Head { return ht.headSaver.Chain(sibling.Hash) }).ShouldNot(gomega.BeNil())
+
+	saved := ht.headSaver.Chain(sibling.Hash)
+	require.NotNil(t, saved.LatestFinalizedHead)
+	assert.Equal(t, int64(8), saved.LatestFinalizedHead.Number)
+	require.NotNil(t, saved.LatestSafeHead)
+	assert.Equal(t, int64(9), saved.LatestSafeHead.Number)
+
+	finalized := ht.headTracker.LatestFinalizedBlock()
+	require.NotNil(t, finalized)
+	assert.Equal(t, int64(8), finalized.Number)
+}
+
 func TestHeadTracker_ReconnectOnError(t *testing.T) {
 	t.Parallel()
 	g := gomega.NewWithT(t)
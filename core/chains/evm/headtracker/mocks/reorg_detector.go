@@ -0,0 +1,79 @@
+// Code generated by mockery v2.22.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+)
+
+// ReorgDetector is an autogenerated mock type for the ReorgDetector type
+type ReorgDetector struct {
+	mock.Mock
+}
+
+// LatestReorgs provides a mock function with given fields: ctx
+func (_m *ReorgDetector) LatestReorgs(ctx context.Context) ([]types.Reorg, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []types.Reorg
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.Reorg, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.Reorg); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Reorg)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OnNewLongestChain provides a mock function with given fields: ctx, head
+func (_m *ReorgDetector) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	_m.Called(ctx, head)
+}
+
+// Subscribe provides a mock function with given fields: subscriber
+func (_m *ReorgDetector) Subscribe(subscriber types.ReorgSubscriber) func() {
+	ret := _m.Called(subscriber)
+
+	var r0 func()
+	if rf, ok := ret.Get(0).(func(types.ReorgSubscriber) func()); ok {
+		r0 = rf(subscriber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func())
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewReorgDetector interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewReorgDetector creates a new instance of ReorgDetector. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewReorgDetector(t mockConstructorTestingTNewReorgDetector) *ReorgDetector {
+	mock := &ReorgDetector{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/smartcontractkit/sqlx"
 
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
@@ -28,6 +29,12 @@ type ORM interface {
 	LatestHeads(ctx context.Context, limit uint) (heads []*evmtypes.Head, err error)
 	// HeadByHash fetches the head with the given hash from the db, returns nil if none exists
 	HeadByHash(ctx context.Context, hash common.Hash) (head *evmtypes.Head, err error)
+	// InsertReorg records a reorg in the reorg history
+	InsertReorg(ctx context.Context, reorg *httypes.Reorg) error
+	// TrimOldReorgs deletes reorgs such that only the latest N remain
+	TrimOldReorgs(ctx context.Context, n uint) error
+	// LatestReorgs returns the latest reorgs up to given limit
+	LatestReorgs(ctx context.Context, limit uint) (reorgs []httypes.Reorg, err error)
 }
 
 type orm struct {
@@ -92,3 +99,34 @@ func (orm *orm) HeadByHash(ctx context.Context, hash common.Hash) (head *evmtype
 	}
 	return head, err
 }
+
+func (orm *orm) InsertReorg(ctx context.Context, reorg *httypes.Reorg) error {
+	reorg.EVMChainID = orm.chainID
+	q := orm.q.WithOpts(pg.WithParentCtx(ctx))
+	query := `
+	INSERT INTO evm_reorgs (evm_chain_id, common_ancestor_number, common_ancestor_hash, old_heads, new_heads, depth, detected_at) VALUES (
+	:evm_chain_id, :common_ancestor_number, :common_ancestor_hash, :old_heads, :new_heads, :depth, :detected_at)
+	RETURNING id`
+	err := q.GetNamed(query, &reorg.ID, reorg)
+	return errors.Wrap(err, "InsertReorg failed to insert reorg")
+}
+
+func (orm *orm) TrimOldReorgs(ctx context.Context, n uint) error {
+	q := orm.q.WithOpts(pg.WithParentCtx(ctx))
+	return q.ExecQ(`
+	DELETE FROM evm_reorgs
+	WHERE evm_chain_id = $1 AND id NOT IN (
+		SELECT id
+		FROM evm_reorgs
+		WHERE evm_chain_id = $1
+		ORDER BY id DESC
+		LIMIT $2
+	)`, orm.chainID, n)
+}
+
+func (orm *orm) LatestReorgs(ctx context.Context, limit uint) (reorgs []httypes.Reorg, err error) {
+	q := orm.q.WithOpts(pg.WithParentCtx(ctx))
+	err = q.Select(&reorgs, `SELECT * FROM evm_reorgs WHERE evm_chain_id = $1 ORDER BY id DESC LIMIT $2`, orm.chainID, limit)
+	err = errors.Wrap(err, "LatestReorgs failed")
+	return
+}
@@ -2,6 +2,7 @@ package headtracker_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	configtest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest/v2"
@@ -12,9 +13,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestORM_IdempotentInsertHead(t *testing.T) {
@@ -119,3 +122,38 @@ func TestORM_LatestHeads_NoRows(t *testing.T) {
 	require.Zero(t, len(heads))
 	require.NoError(t, err)
 }
+
+func TestORM_Reorgs(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	orm := headtracker.NewORM(db, logger, cfg, cltest.FixtureChainID)
+
+	ancestor := cltest.Head(9)
+	for depth := int64(1); depth <= 5; depth++ {
+		reorg := &httypes.Reorg{
+			CommonAncestorNumber: &ancestor.Number,
+			CommonAncestorHash:   &ancestor.Hash,
+			OldHeads:             httypes.ReorgHeads{{Number: 10, Hash: utils.NewHash()}},
+			NewHeads:             httypes.ReorgHeads{{Number: 11, Hash: utils.NewHash()}, {Number: 10, Hash: utils.NewHash()}},
+			Depth:                depth,
+			DetectedAt:           time.Now(),
+		}
+		require.NoError(t, orm.InsertReorg(testutils.Context(t), reorg))
+		assert.NotZero(t, reorg.ID)
+	}
+
+	require.NoError(t, orm.TrimOldReorgs(testutils.Context(t), 3))
+
+	reorgs, err := orm.LatestReorgs(testutils.Context(t), 10)
+	require.NoError(t, err)
+	require.Len(t, reorgs, 3)
+	assert.Equal(t, []int64{5, 4, 3}, []int64{reorgs[0].Depth, reorgs[1].Depth, reorgs[2].Depth})
+	assert.Equal(t, cltest.FixtureChainID.String(), reorgs[0].EVMChainID.String())
+	require.NotNil(t, reorgs[0].CommonAncestorHash)
+	assert.Equal(t, ancestor.Hash, *reorgs[0].CommonAncestorHash)
+	assert.Len(t, reorgs[0].NewHeads, 2)
+	assert.Equal(t, int64(11), reorgs[0].NewHeads[0].Number)
+}
@@ -0,0 +1,181 @@
+package headtracker
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ReorgHistoryLimit is the number of reorgs kept in the reorg history of each chain.
+const ReorgHistoryLimit = 100
+
+var promReorgDepth = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "head_tracker_reorg_depth",
+	Help:    "The number of heads replaced by each reorg of the longest chain",
+	Buckets: []float64{1, 2, 3, 5, 10, 20, 50, 100, 200, 500},
+}, []string{"evmChainID"})
+
+type reorgSubscriberSet map[int]httypes.ReorgSubscriber
+
+type reorgDetector struct {
+	orm     ORM
+	chainID big.Int
+	logger  logger.Logger
+
+	mu               sync.Mutex
+	latest           *evmtypes.Head
+	subscribers      reorgSubscriberSet
+	lastSubscriberID int
+}
+
+var _ httypes.ReorgDetector = (*reorgDetector)(nil)
+
+// NewReorgDetector creates a new ReorgDetector, which must be subscribed to
+// the HeadBroadcaster of the chain.
+func NewReorgDetector(lggr logger.Logger, orm ORM, chainID big.Int) httypes.ReorgDetector {
+	return &reorgDetector{
+		orm:         orm,
+		chainID:     chainID,
+		logger:      lggr.Named("ReorgDetector"),
+		subscribers: make(reorgSubscriberSet),
+	}
+}
+
+func (rd *reorgDetector) Subscribe(subscriber httypes.ReorgSubscriber) (unsubscribe func()) {
+	rd.mu.Lock()
+	defer rd.mu.Unlock()
+
+	rd.lastSubscriberID++
+	subscriberID := rd.lastSubscriberID
+	rd.subscribers[subscriberID] = subscriber
+	return func() {
+		rd.mu.Lock()
+		defer rd.mu.Unlock()
+		delete(rd.subscribers, subscriberID)
+	}
+}
+
+func (rd *reorgDetector) LatestReorgs(ctx context.Context) ([]httypes.Reorg, error) {
+	return rd.orm.LatestReorgs(ctx, ReorgHistoryLimit)
+}
+
+func (rd *reorgDetector) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	rd.mu.Lock()
+	prev := rd.latest
+	rd.latest = head
+	var subscribers []httypes.ReorgSubscriber
+	for _, s := range rd.subscribers {
+		subscribers = append(subscribers, s)
+	}
+	rd.mu.Unlock()
+
+	event, ok := findReorg(prev, head)
+	if !ok {
+		return
+	}
+	event.DetectedAt = time.Now()
+
+	promReorgDepth.WithLabelValues(rd.chainID.String()).Observe(float64(event.Depth))
+	lggr := rd.logger.With("depth", event.Depth, "oldHeadNumber", prev.Number, "oldHeadHash", prev.Hash,
+		"newHeadNumber", head.Number, "newHeadHash", head.Hash)
+	if event.CommonAncestor != nil {
+		lggr = lggr.With("commonAncestorNumber", event.CommonAncestor.Number, "commonAncestorHash", event.CommonAncestor.Hash)
+	}
+	lggr.Warn("Detected reorg of the longest chain")
+
+	rd.record(ctx, event)
+
+	var wg sync.WaitGroup
+	wg.Add(len(subscribers))
+	for _, s := range subscribers {
+		go func(s httypes.ReorgSubscriber) {
+			defer wg.Done()
+			s.OnReorg(ctx, event)
+		}(s)
+	}
+	wg.Wait()
+}
+
+// record saves the reorg in the reorg history
+func (rd *reorgDetector) record(ctx context.Context, event httypes.ReorgEvent) {
+	reorg := httypes.Reorg{
+		OldHeads:   httypes.NewReorgHeads(event.OldHeads),
+		NewHeads:   httypes.NewReorgHeads(event.NewHeads),
+		Depth:      event.Depth,
+		DetectedAt: event.DetectedAt,
+	}
+	if a := event.CommonAncestor; a != nil {
+		reorg.CommonAncestorNumber = &a.Number
+		reorg.CommonAncestorHash = &a.Hash
+	}
+	if err := rd.orm.InsertReorg(ctx, &reorg); err != nil {
+		rd.logger.Errorw("Failed to record reorg", "err", err)
+		return
+	}
+	if err := rd.orm.TrimOldReorgs(ctx, ReorgHistoryLimit); err != nil {
+		rd.logger.Errorw("Failed to trim reorg history", "err", err)
+	}
+}
+
+// findReorg returns the reorg in which head replaced prev as the latest head of
+// the longest chain. No reorg is found if head is lower than prev or its chain
+// does not reach the height of prev, since it is not known whether it includes
+// prev.
+func findReorg(prev, head *evmtypes.Head) (event httypes.ReorgEvent, found bool) {
+	if prev == nil || head == nil || head.Number < prev.Number {
+		return event, false
+	}
+
+	n := head
+	for n != nil && n.Number > prev.Number {
+		n = n.Parent
+	}
+	if n == nil || (n.Number == prev.Number && n.Hash == prev.Hash) {
+		return event, false
+	}
+
+	// Walk both chains down to the latest head they have in common
+	o := prev
+	for o != nil && n != nil {
+		if o.Number > n.Number {
+			event.OldHeads = append(event.OldHeads, o)
+			o = o.Parent
+		} else if n.Number > o.Number {
+			n = n.Parent
+		} else if o.Hash == n.Hash {
+			event.CommonAncestor = o
+			break
+		} else {
+			event.OldHeads = append(event.OldHeads, o)
+			o, n = o.Parent, n.Parent
+		}
+	}
+	if len(event.OldHeads) == 0 {
+		return event, false
+	}
+
+	earliestOld := event.OldHeads[len(event.OldHeads)-1]
+	for n = head; n != nil && n.Number >= earliestOld.Number; n = n.Parent {
+		event.NewHeads = append(event.NewHeads, n)
+	}
+	event.Depth = int64(len(event.OldHeads))
+	return event, true
+}
+
+var NullReorgDetector httypes.ReorgDetector = &nullReorgDetector{}
+
+type nullReorgDetector struct{}
+
+func (*nullReorgDetector) OnNewLongestChain(context.Context, *evmtypes.Head) {}
+func (*nullReorgDetector) Subscribe(httypes.ReorgSubscriber) (unsubscribe func()) {
+	return func() {}
+}
+func (*nullReorgDetector) LatestReorgs(context.Context) ([]httypes.Reorg, error) { return nil, nil }
@@ -0,0 +1,97 @@
+package headtracker_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	configtest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+type reorgRecorder struct {
+	mu     sync.Mutex
+	events []httypes.ReorgEvent
+}
+
+func (r *reorgRecorder) OnReorg(ctx context.Context, event httypes.ReorgEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+// headChain returns the latest head of a chain from number from to to, on top
+// of parent.
+func headChain(parent *evmtypes.Head, from, to int64) *evmtypes.Head {
+	h := parent
+	for n := from; n <= to; n++ {
+		h = &evmtypes.Head{Number: n, Hash: utils.NewHash(), Parent: h}
+		if h.Parent != nil {
+			h.ParentHash = h.Parent.Hash
+		}
+	}
+	return h
+}
+
+func TestReorgDetector(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	orm := headtracker.NewORM(db, lggr, cfg, cltest.FixtureChainID)
+	rd := headtracker.NewReorgDetector(lggr, orm, cltest.FixtureChainID)
+
+	recorder := &reorgRecorder{}
+	unsubscribe := rd.Subscribe(recorder)
+	ctx := testutils.Context(t)
+
+	ancestor := headChain(nil, 0, 5)
+	oldChain := headChain(ancestor, 6, 8)
+	rd.OnNewLongestChain(ctx, oldChain)
+	rd.OnNewLongestChain(ctx, headChain(oldChain, 9, 9))
+	assert.Empty(t, recorder.events)
+
+	// Heads 6 to 9 are replaced
+	newChain := headChain(ancestor, 6, 10)
+	rd.OnNewLongestChain(ctx, newChain)
+	require.Len(t, recorder.events, 1)
+	event := recorder.events[0]
+	assert.Equal(t, ancestor, event.CommonAncestor)
+	assert.Equal(t, int64(4), event.Depth)
+	require.Len(t, event.OldHeads, 4)
+	assert.Equal(t, int64(9), event.OldHeads[0].Number)
+	assert.Equal(t, int64(6), event.OldHeads[3].Number)
+	require.Len(t, event.NewHeads, 5)
+	assert.Equal(t, newChain, event.NewHeads[0])
+
+	// A lower head on another fork does not reach the previous head
+	rd.OnNewLongestChain(ctx, headChain(ancestor, 6, 8))
+	assert.Len(t, recorder.events, 1)
+
+	// The chain of a head without parents does not reach the previous head
+	rd.OnNewLongestChain(ctx, &evmtypes.Head{Number: 12, Hash: utils.NewHash()})
+	assert.Len(t, recorder.events, 1)
+
+	reorgs, err := rd.LatestReorgs(ctx)
+	require.NoError(t, err)
+	require.Len(t, reorgs, 1)
+	assert.Equal(t, int64(4), reorgs[0].Depth)
+	require.NotNil(t, reorgs[0].CommonAncestorNumber)
+	assert.Equal(t, int64(5), *reorgs[0].CommonAncestorNumber)
+	assert.Equal(t, httypes.NewReorgHeads(event.OldHeads), reorgs[0].OldHeads)
+
+	unsubscribe()
+	rd.OnNewLongestChain(ctx, headChain(ancestor, 6, 13))
+	assert.Len(t, recorder.events, 1)
+}
@@ -2,12 +2,17 @@ package types
 
 import (
 	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // HeadSaver maintains chains persisted in DB. All methods are thread-safe.
@@ -35,6 +40,10 @@ type HeadTracker interface {
 	// (used for testing)
 	Backfill(ctx context.Context, headWithChain *evmtypes.Head, depth uint) (err error)
 	LatestChain() *evmtypes.Head
+	// LatestFinalizedBlock returns the latest block which the RPC node reported
+	// as finalized, or nil if it is not known. It is only tracked if
+	// EVM.FinalityTagEnabled.
+	LatestFinalizedBlock() *evmtypes.Head
 }
 
 // HeadTrackable represents any object that wishes to respond to ethereum events,
@@ -70,3 +79,85 @@ type HeadListener interface {
 	// HealthReport returns report of errors within HeadListener
 	HealthReport() map[string]error
 }
+
+// ReorgEvent describes a reorg, in which the new longest chain replaced the
+// heads of the previous longest chain above their common ancestor.
+type ReorgEvent struct {
+	// CommonAncestor is the latest head which is in both chains, or nil if it
+	// is older than the heads kept in memory.
+	CommonAncestor *evmtypes.Head
+	// OldHeads are the replaced heads of the previous longest chain, latest
+	// first.
+	OldHeads []*evmtypes.Head
+	// NewHeads are the heads of the new longest chain above the common
+	// ancestor, latest first.
+	NewHeads []*evmtypes.Head
+	// Depth is the number of replaced heads. It is a lower bound if the common
+	// ancestor is not known.
+	Depth      int64
+	DetectedAt time.Time
+}
+
+// ReorgSubscriber represents any object that wishes to respond to reorgs,
+// after being subscribed to ReorgDetector
+type ReorgSubscriber interface {
+	OnReorg(ctx context.Context, event ReorgEvent)
+}
+
+// ReorgDetector detects reorgs of the longest chain broadcast by the
+// HeadBroadcaster, records them and relays them to subscribers.
+//
+//go:generate mockery --quiet --name ReorgDetector --output ../mocks/ --case=underscore
+type ReorgDetector interface {
+	HeadTrackable
+	// Subscribe subscribes to OnReorg until unsubscribe is called
+	Subscribe(subscriber ReorgSubscriber) (unsubscribe func())
+	// LatestReorgs returns the recorded reorgs, latest first.
+	LatestReorgs(ctx context.Context) ([]Reorg, error)
+}
+
+// ReorgHead identifies a head of a recorded Reorg.
+type ReorgHead struct {
+	Number int64       `json:"number"`
+	Hash   common.Hash `json:"hash"`
+}
+
+// ReorgHeads is a list of ReorgHead which is stored as JSON.
+type ReorgHeads []ReorgHead
+
+// NewReorgHeads returns the numbers and hashes of heads.
+func NewReorgHeads(heads []*evmtypes.Head) ReorgHeads {
+	rhs := ReorgHeads{}
+	for _, h := range heads {
+		rhs = append(rhs, ReorgHead{Number: h.Number, Hash: h.Hash})
+	}
+	return rhs
+}
+
+func (rhs ReorgHeads) Value() (driver.Value, error) {
+	if rhs == nil {
+		rhs = ReorgHeads{}
+	}
+	return json.Marshal(rhs)
+}
+
+func (rhs *ReorgHeads) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, rhs)
+}
+
+// Reorg is a ReorgEvent recorded in the reorg history.
+type Reorg struct {
+	ID                   int64
+	EVMChainID           utils.Big `db:"evm_chain_id"`
+	CommonAncestorNumber *int64
+	CommonAncestorHash   *common.Hash
+	OldHeads             ReorgHeads
+	NewHeads             ReorgHeads
+	Depth                int64
+	DetectedAt           time.Time
+}
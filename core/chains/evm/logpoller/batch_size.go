@@ -0,0 +1,88 @@
+package logpoller
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils/mathutil"
+)
+
+// batchSizeGrowthInterval is the number of consecutive successful requests
+// after which a reduced batch size is doubled again.
+const batchSizeGrowthInterval = 10
+
+// logsLimitErrors are the errors with which RPC providers reject an eth_getLogs
+// request because its block range or response is too large.
+var logsLimitErrors = []string{
+	"query returned more than",
+	"log response size exceeded",
+	"block range is too wide",
+	"exceed maximum block range",
+	"block range too large",
+	"range is too large",
+	"response size should not",
+	"block range limit exceeded",
+	"eth_getlogs is limited to",
+	"query timeout exceeded",
+	"too many logs in the response",
+}
+
+// isLogsLimitError returns true if err rejects an eth_getLogs request which
+// could succeed over a smaller block range.
+func isLogsLimitError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, s := range logsLimitErrors {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// logsBatchSize is the number of blocks requested by each eth_getLogs call when
+// backfilling. It is halved whenever the RPC rejects a range as too large, and
+// grows back towards the configured size after consecutive successful requests.
+type logsBatchSize struct {
+	mu        sync.Mutex
+	max       int64
+	current   int64
+	successes int
+}
+
+func newLogsBatchSize(max int64) *logsBatchSize {
+	return &logsBatchSize{max: max, current: max}
+}
+
+func (b *logsBatchSize) get() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.current
+}
+
+// shrink halves the batch size below the size of the rejected range, and
+// returns the new batch size.
+func (b *logsBatchSize) shrink(rejected int64) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.current = mathutil.Max(1, mathutil.Min(b.current, rejected)/2)
+	b.successes = 0
+	return b.current
+}
+
+// succeeded records a successful request, growing the batch size after enough
+// of them.
+func (b *logsBatchSize) succeeded() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.current >= b.max {
+		return
+	}
+	b.successes++
+	if b.successes >= batchSizeGrowthInterval {
+		b.current = mathutil.Min(b.max, b.current*2)
+		b.successes = 0
+	}
+}
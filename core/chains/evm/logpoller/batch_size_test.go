@@ -0,0 +1,61 @@
+package logpoller
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsLogsLimitError(t *testing.T) {
+	assert.False(t, isLogsLimitError(nil))
+	assert.False(t, isLogsLimitError(errors.New("connection refused")))
+	assert.True(t, isLogsLimitError(errors.New("query returned more than 10000 results")))
+	assert.True(t, isLogsLimitError(errors.Wrap(errors.New("Log response size exceeded. You can make eth_getLogs requests with up to a 2K block range"), "rpc")))
+	assert.True(t, isLogsLimitError(errors.New("exceed maximum block range: 5000")))
+	assert.True(t, isLogsLimitError(errors.New("eth_getLogs is limited to a 10,000 range")))
+
+	// Rate limits are not solved by a smaller block range
+	assert.False(t, isLogsLimitError(errors.New("daily request count limit exceeded")))
+	assert.False(t, isLogsLimitError(errors.New("project ID request rate exceeded")))
+	assert.False(t, isLogsLimitError(errors.New("websocket: read limit exceeded")))
+}
+
+func TestLogsBatchSize(t *testing.T) {
+	b := newLogsBatchSize(100)
+	assert.Equal(t, int64(100), b.get())
+
+	// Successes at the configured size do not grow it
+	for i := 0; i < 2*batchSizeGrowthInterval; i++ {
+		b.succeeded()
+	}
+	assert.Equal(t, int64(100), b.get())
+
+	// Shrinks below the rejected range
+	assert.Equal(t, int64(50), b.shrink(100))
+	assert.Equal(t, int64(5), b.shrink(10))
+	assert.Equal(t, int64(2), b.shrink(5))
+	assert.Equal(t, int64(1), b.shrink(2))
+	assert.Equal(t, int64(1), b.shrink(1))
+
+	// Grows back after consecutive successes, up to the configured size
+	for i := 0; i < batchSizeGrowthInterval-1; i++ {
+		b.succeeded()
+	}
+	assert.Equal(t, int64(1), b.get())
+	b.succeeded()
+	assert.Equal(t, int64(2), b.get())
+
+	// A rejection restarts the count
+	for i := 0; i < batchSizeGrowthInterval-1; i++ {
+		b.succeeded()
+	}
+	b.shrink(2)
+	b.succeeded()
+	assert.Equal(t, int64(1), b.get())
+
+	for i := 0; i < 10*batchSizeGrowthInterval; i++ {
+		b.succeeded()
+	}
+	assert.Equal(t, int64(100), b.get())
+}
@@ -85,3 +85,11 @@ func (disabled) LogsDataWordGreaterThan(eventSig common.Hash, address common.Add
 func (d disabled) IndexedLogsWithSigsExcluding(address common.Address, eventSigA, eventSigB common.Hash, topicIndex int, fromBlock, toBlock int64, confs int, qopts ...pg.QOpt) ([]Log, error) {
 	return nil, ErrDisabled
 }
+
+func (disabled) LogsByQuery(query *LogQuery, qopts ...pg.QOpt) ([]Log, error) {
+	return nil, ErrDisabled
+}
+
+func (disabled) Subscribe(params SubscriptionParams) (*Subscription, error) {
+	return nil, ErrDisabled
+}
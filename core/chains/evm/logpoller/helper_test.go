@@ -53,6 +53,7 @@ func SetupTH(t testing.TB, finalityDepth, backfillBatchSize, rpcBatchSize int64)
 	require.NoError(t, utils.JustError(db.Exec(`SET CONSTRAINTS evm_log_poller_blocks_evm_chain_id_fkey DEFERRED`)))
 	require.NoError(t, utils.JustError(db.Exec(`SET CONSTRAINTS evm_log_poller_filters_evm_chain_id_fkey DEFERRED`)))
 	require.NoError(t, utils.JustError(db.Exec(`SET CONSTRAINTS evm_logs_evm_chain_id_fkey DEFERRED`)))
+	require.NoError(t, utils.JustError(db.Exec(`SET CONSTRAINTS evm_log_poller_subscriptions_evm_chain_id_fkey DEFERRED`)))
 	o := logpoller.NewORM(chainID, db, lggr, pgtest.NewQConfig(true))
 	o2 := logpoller.NewORM(chainID2, db, lggr, pgtest.NewQConfig(true))
 	owner := testutils.MustNewSimTransactor(t)
@@ -52,6 +52,12 @@ type LogPoller interface {
 	IndexedLogsWithSigsExcluding(address common.Address, eventSigA, eventSigB common.Hash, topicIndex int, fromBlock, toBlock int64, confs int, qopts ...pg.QOpt) ([]Log, error)
 	LogsDataWordRange(eventSig common.Hash, address common.Address, wordIndex int, wordValueMin, wordValueMax common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error)
 	LogsDataWordGreaterThan(eventSig common.Hash, address common.Address, wordIndex int, wordValueMin common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error)
+
+	// LogsByQuery returns the logs matching an arbitrary LogQuery
+	LogsByQuery(query *LogQuery, qopts ...pg.QOpt) ([]Log, error)
+
+	// Subscribe delivers the logs matching params as soon as they are saved
+	Subscribe(params SubscriptionParams) (*Subscription, error)
 }
 
 type LogPollerTest interface {
@@ -82,11 +88,11 @@ type logPoller struct {
 	ec                    Client
 	orm                   *ORM
 	lggr                  logger.Logger
-	pollPeriod            time.Duration // poll period set by block production rate
-	finalityDepth         int64         // finality depth is taken to mean that block (head - finality) is finalized
-	keepBlocksDepth       int64         // the number of blocks behind the head for which we keep the blocks. Must be greater than finality depth + 1.
-	backfillBatchSize     int64         // batch size to use when backfilling finalized logs
-	rpcBatchSize          int64         // batch size to use for fallback RPC calls made in GetBlocks
+	pollPeriod            time.Duration  // poll period set by block production rate
+	finalityDepth         int64          // finality depth is taken to mean that block (head - finality) is finalized
+	keepBlocksDepth       int64          // the number of blocks behind the head for which we keep the blocks. Must be greater than finality depth + 1.
+	backfillBatchSize     *logsBatchSize // batch size to use when backfilling finalized logs, reduced while the RPC rejects it
+	rpcBatchSize          int64          // batch size to use for fallback RPC calls made in GetBlocks
 	backupPollerNextBlock int64
 
 	filterMu        sync.RWMutex
@@ -95,6 +101,9 @@ type logPoller struct {
 	cachedAddresses []common.Address
 	cachedEventSigs []common.Hash
 
+	subscriptionsMu sync.Mutex
+	subscriptions   map[*Subscription]struct{}
+
 	replayStart    chan int64
 	replayComplete chan error
 	ctx            context.Context
@@ -123,11 +132,12 @@ func NewLogPoller(orm *ORM, ec Client, lggr logger.Logger, pollPeriod time.Durat
 		replayComplete:    make(chan error),
 		pollPeriod:        pollPeriod,
 		finalityDepth:     finalityDepth,
-		backfillBatchSize: backfillBatchSize,
+		backfillBatchSize: newLogsBatchSize(backfillBatchSize),
 		rpcBatchSize:      rpcBatchSize,
 		keepBlocksDepth:   keepBlocksDepth,
 		filters:           make(map[string]Filter),
 		filterDirty:       true, // Always build Filter on first call to cache an empty filter if nothing registered yet.
+		subscriptions:     make(map[*Subscription]struct{}),
 	}
 }
 
@@ -375,6 +385,7 @@ func (lp *logPoller) Close() error {
 		}
 		lp.cancel()
 		lp.wg.Wait()
+		lp.closeSubscriptions()
 		return nil
 	})
 }
@@ -625,31 +636,47 @@ func (lp *logPoller) blocksFromLogs(ctx context.Context, logs []types.Log) (bloc
 // block range [start, end] and save them to the db.
 // Retries until ctx cancelled. Will return an error if cancelled
 // or if there is an error backfilling.
+// If the RPC rejects a batch as too large, it is split in half
+// and the smaller batch size is used until it is grown back.
 func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
-	for from := start; from <= end; from += lp.backfillBatchSize {
-		to := mathutil.Min(from+lp.backfillBatchSize-1, end)
+	for from := start; from <= end; {
+		to := mathutil.Min(from+lp.backfillBatchSize.get()-1, end)
 		gethLogs, err := lp.ec.FilterLogs(ctx, lp.Filter(big.NewInt(from), big.NewInt(to), nil))
 		if err != nil {
+			if isLogsLimitError(err) && to > from {
+				batchSize := lp.backfillBatchSize.shrink(to - from + 1)
+				lp.lggr.Warnw("Query for logs rejected as too large, retrying with a smaller range", "err", err, "from", from, "to", to, "batchSize", batchSize)
+				continue
+			}
 			lp.lggr.Warnw("Unable query for logs, retrying", "err", err, "from", from, "to", to)
 			return err
 		}
-		if len(gethLogs) == 0 {
-			continue
-		}
-		blocks, err := lp.blocksFromLogs(ctx, gethLogs)
-		if err != nil {
-			return err
+		lp.backfillBatchSize.succeeded()
+		if len(gethLogs) > 0 {
+			if err = lp.saveBackfilledLogs(ctx, from, to, gethLogs); err != nil {
+				return err
+			}
 		}
+		from = to + 1
+	}
+	return nil
+}
 
-		lp.lggr.Debugw("Backfill found logs", "from", from, "to", to, "logs", len(gethLogs), "blocks", blocks)
-		err = lp.orm.q.WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
-			return lp.orm.InsertLogs(convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID()), pg.WithQueryer(tx))
-		})
-		if err != nil {
-			lp.lggr.Warnw("Unable to insert logs, retrying", "err", err, "from", from, "to", to)
-			return err
-		}
+func (lp *logPoller) saveBackfilledLogs(ctx context.Context, from, to int64, gethLogs []types.Log) error {
+	blocks, err := lp.blocksFromLogs(ctx, gethLogs)
+	if err != nil {
+		return err
+	}
+
+	lp.lggr.Debugw("Backfill found logs", "from", from, "to", to, "logs", len(gethLogs), "blocks", blocks)
+	err = lp.orm.q.WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
+		return lp.orm.InsertLogs(convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID()), pg.WithQueryer(tx))
+	})
+	if err != nil {
+		lp.lggr.Warnw("Unable to insert logs, retrying", "err", err, "from", from, "to", to)
+		return err
 	}
+	lp.notifySubscriptions()
 	return nil
 }
 
@@ -726,6 +753,11 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 				lp.lggr.Warnw("Unable to clear reorged logs, retrying", "err", err3)
 				return err3
 			}
+			err3 = lp.orm.RewindSubscriptionCursors(blockAfterLCA.Number, pg.WithQueryer(tx))
+			if err3 != nil {
+				lp.lggr.Warnw("Unable to rewind subscription cursors, retrying", "err", err3)
+				return err3
+			}
 			return nil
 		})
 		if err2 != nil {
@@ -733,6 +765,7 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 			// We return an error here which will cause us to restart polling from lastBlockSaved + 1
 			return nil, err2
 		}
+		lp.notifySubscriptionsReorg(blockAfterLCA.Number)
 		return blockAfterLCA, nil
 	}
 	// No reorg, return current block.
@@ -828,6 +861,7 @@ func (lp *logPoller) PollAndSaveLogs(ctx context.Context, currentBlockNumber int
 			lp.lggr.Warnw("Unable to save logs resuming from last saved block + 1", "err", err, "block", currentBlockNumber)
 			return
 		}
+		lp.notifySubscriptions()
 		// Update current block.
 		// Same reorg detection on unfinalized blocks.
 		currentBlockNumber++
@@ -1098,6 +1132,11 @@ func (lp *logPoller) IndexedLogsWithSigsExcluding(address common.Address, eventS
 	return lp.orm.SelectIndexedLogsWithSigsExcluding(eventSigA, eventSigB, topicIndex, address, fromBlock, toBlock, confs, qopts...)
 }
 
+// LogsByQuery returns the logs matching query, see LogQuery for the available filters.
+func (lp *logPoller) LogsByQuery(query *LogQuery, qopts ...pg.QOpt) ([]Log, error) {
+	return lp.orm.SelectLogs(query, qopts...)
+}
+
 func EvmWord(i uint64) common.Hash {
 	var b = make([]byte, 8)
 	binary.BigEndian.PutUint64(b, i)
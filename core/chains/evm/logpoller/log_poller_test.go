@@ -941,3 +941,165 @@ func TestLogPoller_DBErrorHandling(t *testing.T) {
 	assert.Contains(t, logMsgs, "Error executing replay, could not get fromBlock")
 	assert.Contains(t, logMsgs, "backup log poller ran before filters loaded, skipping")
 }
+
+func receiveSubscriptionEvent(t *testing.T, sub *logpoller.Subscription) logpoller.SubscriptionEvent {
+	select {
+	case event, ok := <-sub.Events():
+		require.True(t, ok, "subscription closed")
+		return event
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for subscription event")
+	}
+	return logpoller.SubscriptionEvent{}
+}
+
+func TestLogPoller_Subscribe(t *testing.T) {
+	t.Parallel()
+	th := SetupTH(t, 2, 3, 2)
+	ctx := testutils.Context(t)
+
+	require.NoError(t, th.LogPoller.RegisterFilter(logpoller.Filter{
+		Name:      "Test Emitter 1",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses: []common.Address{th.EmitterAddress1},
+	}))
+	params := logpoller.SubscriptionParams{
+		Name:      "Test Subscription",
+		Addresses: []common.Address{th.EmitterAddress1},
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+		FromBlock: 1,
+	}
+
+	_, err := th.LogPoller.Subscribe(logpoller.SubscriptionParams{Name: "invalid", FromBlock: 1})
+	require.Error(t, err)
+
+	sub, err := th.LogPoller.Subscribe(params)
+	require.NoError(t, err)
+
+	// Chain gen <- 1 <- 2 (L1_1)
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(1)})
+	require.NoError(t, err)
+	th.Client.Commit()
+	newStart := th.PollAndSaveLogs(ctx, 1)
+	assert.Equal(t, int64(3), newStart)
+
+	event := receiveSubscriptionEvent(t, sub)
+	assert.Zero(t, event.RemovedFromBlock)
+	require.Len(t, event.Logs, 1)
+	assert.Equal(t, int64(2), event.Logs[0].BlockNumber)
+	assert.Equal(t, logpoller.EvmWord(1).Bytes(), event.Logs[0].Data)
+	assert.Equal(t, logpoller.NewLogCursor(event.Logs[0]), event.Cursor)
+	stale := event
+
+	// Reorg of block 2, which was delivered already
+	// Chain gen <- 1 <- 2 (L1_1)
+	//                \ 2'(L1_2) <- 3
+	lca, err := th.Client.BlockByNumber(ctx, big.NewInt(1))
+	require.NoError(t, err)
+	require.NoError(t, th.Client.Fork(ctx, lca.Hash()))
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(2)})
+	require.NoError(t, err)
+	th.Client.Commit()
+	th.Client.Commit()
+	newStart = th.PollAndSaveLogs(ctx, newStart)
+	assert.Equal(t, int64(4), newStart)
+
+	// The cursor of the removed log cannot be acknowledged anymore, whether
+	// or not the reorg was delivered yet
+	require.Error(t, sub.Ack(stale))
+
+	event = receiveSubscriptionEvent(t, sub)
+	assert.Equal(t, int64(2), event.RemovedFromBlock)
+	assert.Empty(t, event.Logs)
+	assert.Equal(t, logpoller.LogCursor{BlockNumber: 2, LogIndex: -1}, event.Cursor)
+	event = receiveSubscriptionEvent(t, sub)
+	require.Len(t, event.Logs, 1)
+	assert.Equal(t, logpoller.EvmWord(2).Bytes(), event.Logs[0].Data)
+
+	// A closed subscription resumes after the acknowledged cursor
+	require.Error(t, sub.Ack(stale))
+	require.NoError(t, sub.Ack(event))
+	sub.Close()
+	_, ok := <-sub.Events()
+	assert.False(t, ok)
+
+	// Chain gen <- 1 <- 2'(L1_2) <- 3 <- 4 (L1_3)
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(3)})
+	require.NoError(t, err)
+	th.Client.Commit()
+	newStart = th.PollAndSaveLogs(ctx, newStart)
+	assert.Equal(t, int64(5), newStart)
+
+	sub, err = th.LogPoller.Subscribe(params)
+	require.NoError(t, err)
+	t.Cleanup(sub.Close)
+	event = receiveSubscriptionEvent(t, sub)
+	require.Len(t, event.Logs, 1)
+	assert.Equal(t, int64(4), event.Logs[0].BlockNumber)
+	assert.Equal(t, logpoller.EvmWord(3).Bytes(), event.Logs[0].Data)
+}
+
+// limitedLogsClient rejects eth_getLogs requests over more than maxRange blocks,
+// like RPC providers which limit the size of responses.
+type limitedLogsClient struct {
+	*client.SimulatedBackendClient
+	maxRange int64
+	rejected int
+	accepted []int64
+}
+
+func (c *limitedLogsClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	if q.FromBlock != nil && q.ToBlock != nil {
+		n := q.ToBlock.Int64() - q.FromBlock.Int64() + 1
+		if n > c.maxRange {
+			c.rejected++
+			return nil, fmt.Errorf("query returned more than 10000 results")
+		}
+		c.accepted = append(c.accepted, n)
+	}
+	return c.SimulatedBackendClient.FilterLogs(ctx, q)
+}
+
+func TestLogPoller_BackfillSplitsRejectedRanges(t *testing.T) {
+	t.Parallel()
+	th := SetupTH(t, 2, 3, 2)
+	ctx := testutils.Context(t)
+
+	ec := &limitedLogsClient{
+		SimulatedBackendClient: client.NewSimulatedBackendClient(t, th.Client, th.ChainID),
+		maxRange:               3,
+	}
+	lp := logpoller.NewLogPoller(th.ORM, ec, th.Lggr, time.Hour, 2, 10, 2, 1000)
+	require.NoError(t, lp.RegisterFilter(logpoller.Filter{
+		Name:      "Test Emitter 1",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses: []common.Address{th.EmitterAddress1},
+	}))
+
+	// Chain gen <- 1 <- 2 (L1_1) <- ... <- 21 (L1_20)
+	for i := 1; i <= 20; i++ {
+		_, err := th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(int64(i))})
+		require.NoError(t, err)
+		th.Client.Commit()
+	}
+
+	// Blocks 1 through 18 are backfilled in batches of 2 blocks, after batches
+	// of 10 and 5 blocks are rejected.
+	lp.PollAndSaveLogs(ctx, 1)
+	latest, err := th.ORM.SelectLatestBlock()
+	require.NoError(t, err)
+	assert.Equal(t, int64(21), latest.BlockNumber)
+
+	lgs, err := th.ORM.SelectLogsByBlockRange(1, 21)
+	require.NoError(t, err)
+	require.Len(t, lgs, 20)
+	for i, lg := range lgs {
+		assert.Equal(t, int64(i+2), lg.BlockNumber)
+	}
+
+	assert.Equal(t, 2, ec.rejected)
+	require.Len(t, ec.accepted, 9)
+	for _, n := range ec.accepted {
+		assert.Equal(t, int64(2), n)
+	}
+}
@@ -376,6 +376,39 @@ func (_m *LogPoller) Logs(start int64, end int64, eventSig common.Hash, address
 	return r0, r1
 }
 
+// LogsByQuery provides a mock function with given fields: query, qopts
+func (_m *LogPoller) LogsByQuery(query *logpoller.LogQuery, qopts ...pg.QOpt) ([]logpoller.Log, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, query)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []logpoller.Log
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*logpoller.LogQuery, ...pg.QOpt) ([]logpoller.Log, error)); ok {
+		return rf(query, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(*logpoller.LogQuery, ...pg.QOpt) []logpoller.Log); ok {
+		r0 = rf(query, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]logpoller.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*logpoller.LogQuery, ...pg.QOpt) error); ok {
+		r1 = rf(query, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LogsDataWordGreaterThan provides a mock function with given fields: eventSig, address, wordIndex, wordValueMin, confs, qopts
 func (_m *LogPoller) LogsDataWordGreaterThan(eventSig common.Hash, address common.Address, wordIndex int, wordValueMin common.Hash, confs int, qopts ...pg.QOpt) ([]logpoller.Log, error) {
 	_va := make([]interface{}, len(qopts))
@@ -550,6 +583,32 @@ func (_m *LogPoller) Start(_a0 context.Context) error {
 	return r0
 }
 
+// Subscribe provides a mock function with given fields: params
+func (_m *LogPoller) Subscribe(params logpoller.SubscriptionParams) (*logpoller.Subscription, error) {
+	ret := _m.Called(params)
+
+	var r0 *logpoller.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(logpoller.SubscriptionParams) (*logpoller.Subscription, error)); ok {
+		return rf(params)
+	}
+	if rf, ok := ret.Get(0).(func(logpoller.SubscriptionParams) *logpoller.Subscription); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(logpoller.SubscriptionParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnregisterFilter provides a mock function with given fields: name, q
 func (_m *LogPoller) UnregisterFilter(name string, q pg.Queryer) error {
 	ret := _m.Called(name, q)
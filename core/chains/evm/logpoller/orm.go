@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/sqlx"
 
@@ -115,17 +114,19 @@ func (o *ORM) SelectLatestBlock(qopts ...pg.QOpt) (*LogPollerBlock, error) {
 }
 
 func (o *ORM) SelectLatestLogEventSigWithConfs(eventSig common.Hash, address common.Address, confs int, qopts ...pg.QOpt) (*Log, error) {
-	q := o.q.WithOpts(qopts...)
-	var l Log
-	if err := q.Get(&l, `SELECT * FROM evm_logs 
-         WHERE evm_chain_id = $1 
-            AND event_sig = $2 
-            AND address = $3 
-            AND (block_number + $4) <= (SELECT COALESCE(block_number, 0) FROM evm_log_poller_blocks WHERE evm_chain_id = $1 ORDER BY block_number DESC LIMIT 1)
-        ORDER BY (block_number, log_index) DESC LIMIT 1`, utils.NewBig(o.chainID), eventSig, address, confs); err != nil {
+	logs, err := o.SelectLogs(NewLogQuery().
+		WithAddresses(address).
+		WithEventSigs(eventSig).
+		WithConfs(confs).
+		OrderBy(Descending).
+		Limit(1), qopts...)
+	if err != nil {
 		return nil, err
 	}
-	return &l, nil
+	if len(logs) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &logs[0], nil
 }
 
 // DeleteBlocksAfter delete all blocks after and including start.
@@ -197,70 +198,24 @@ func (o *ORM) InsertLogs(logs []Log, qopts ...pg.QOpt) error {
 }
 
 func (o *ORM) SelectLogsByBlockRange(start, end int64) ([]Log, error) {
-	var logs []Log
-	err := o.q.Select(&logs, `
-        SELECT * FROM evm_logs 
-        WHERE block_number >= $1 AND block_number <= $2 AND evm_chain_id = $3
-        ORDER BY (block_number, log_index, created_at)`, start, end, utils.NewBig(o.chainID))
-	if err != nil {
-		return nil, err
-	}
-	return logs, nil
+	return o.SelectLogs(NewLogQuery().WithBlockRange(start, end))
 }
 
 // SelectLogsByBlockRangeFilter finds the logs in a given block range.
 func (o *ORM) SelectLogsByBlockRangeFilter(start, end int64, address common.Address, eventSig common.Hash, qopts ...pg.QOpt) ([]Log, error) {
-	var logs []Log
-	q := o.q.WithOpts(qopts...)
-	err := q.Select(&logs, `
-		SELECT * FROM evm_logs 
-			WHERE evm_logs.block_number >= $1 AND evm_logs.block_number <= $2 AND evm_logs.evm_chain_id = $3 
-			AND address = $4 AND event_sig = $5 
-			ORDER BY (evm_logs.block_number, evm_logs.log_index)`, start, end, utils.NewBig(o.chainID), address, eventSig.Bytes())
-	if err != nil {
-		return nil, err
-	}
-	return logs, nil
+	return o.SelectLogs(NewLogQuery().
+		WithAddresses(address).
+		WithEventSigs(eventSig).
+		WithBlockRange(start, end), qopts...)
 }
 
 // SelectLogsWithSigsByBlockRangeFilter finds the logs in the given block range with the given event signatures
 // emitted from the given address.
 func (o *ORM) SelectLogsWithSigsByBlockRangeFilter(start, end int64, address common.Address, eventSigs []common.Hash, qopts ...pg.QOpt) (logs []Log, err error) {
-	q := o.q.WithOpts(qopts...)
-	sigs := make([][]byte, 0, len(eventSigs))
-	for _, sig := range eventSigs {
-		sigs = append(sigs, sig.Bytes())
-	}
-	a := map[string]any{
-		"start":     start,
-		"end":       end,
-		"chainid":   utils.NewBig(o.chainID),
-		"address":   address,
-		"EventSigs": sigs,
-	}
-	query, args, err := sqlx.Named(
-		`
-SELECT
-	*
-FROM evm_logs
-WHERE evm_logs.block_number BETWEEN :start AND :end
-	AND evm_logs.evm_chain_id = :chainid
-	AND evm_logs.address = :address
-	AND evm_logs.event_sig IN (:EventSigs)
-ORDER BY (evm_logs.block_number, evm_logs.log_index)`, a)
-	if err != nil {
-		return nil, errors.Wrap(err, "sqlx Named")
-	}
-	query, args, err = sqlx.In(query, args...)
-	if err != nil {
-		return nil, errors.Wrap(err, "sqlx In")
-	}
-	query = q.Rebind(query)
-	err = q.Select(&logs, query, args...)
-	if errors.Is(err, sql.ErrNoRows) {
-		return nil, nil
-	}
-	return logs, err
+	return o.SelectLogs(NewLogQuery().
+		WithAddresses(address).
+		WithEventSigs(eventSigs...).
+		WithBlockRange(start, end), qopts...)
 }
 
 func (o *ORM) GetBlocksRange(start uint64, end uint64, qopts ...pg.QOpt) ([]LogPollerBlock, error) {
@@ -278,30 +233,12 @@ func (o *ORM) GetBlocksRange(start uint64, end uint64, qopts ...pg.QOpt) ([]LogP
 
 // SelectLatestLogEventSigsAddrsWithConfs finds the latest log by (address, event) combination that matches a list of Addresses and list of events
 func (o *ORM) SelectLatestLogEventSigsAddrsWithConfs(fromBlock int64, addresses []common.Address, eventSigs []common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error) {
-	var logs []Log
-
-	var sigs [][]byte
-	for _, sig := range eventSigs {
-		sigs = append(sigs, sig.Bytes())
-	}
-	var addrs [][]byte
-	for _, addr := range addresses {
-		addrs = append(addrs, addr.Bytes())
-	}
-
-	q := o.q.WithOpts(qopts...)
-	err := q.Select(&logs, `
-		SELECT * FROM evm_logs WHERE (block_number, address, event_sig) IN (
-			SELECT MAX(block_number), address, event_sig FROM evm_logs 
-				WHERE evm_chain_id = $1 AND
-				    event_sig = ANY($2) AND
-					address = ANY($3) AND
-		   			block_number > $4 AND
-					(block_number + $5) <= (SELECT COALESCE(block_number, 0) FROM evm_log_poller_blocks WHERE evm_chain_id = $1 ORDER BY block_number DESC LIMIT 1)
-			GROUP BY event_sig, address
-		)
-		ORDER BY block_number ASC
-	`, o.chainID.Int64(), pq.ByteaArray(sigs), pq.ByteaArray(addrs), fromBlock, confs)
+	logs, err := o.SelectLogs(NewLogQuery().
+		WithAddresses(addresses...).
+		WithEventSigs(eventSigs...).
+		FromBlock(fromBlock+1).
+		WithConfs(confs).
+		LatestPerEventSig(), qopts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to execute query")
 	}
@@ -309,126 +246,52 @@ func (o *ORM) SelectLatestLogEventSigsAddrsWithConfs(fromBlock int64, addresses
 }
 
 func (o *ORM) SelectDataWordRange(address common.Address, eventSig common.Hash, wordIndex int, wordValueMin, wordValueMax common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error) {
-	var logs []Log
-	q := o.q.WithOpts(qopts...)
-	err := q.Select(&logs,
-		`SELECT * FROM evm_logs 
-			WHERE evm_logs.evm_chain_id = $1
-			AND address = $2 AND event_sig = $3
-			AND substring(data from 32*$4+1 for 32) >= $5
-			AND substring(data from 32*$4+1 for 32) <= $6
-			AND (block_number + $7) <= (SELECT COALESCE(block_number, 0) FROM evm_log_poller_blocks WHERE evm_chain_id = $1 ORDER BY block_number DESC LIMIT 1)
-			ORDER BY (evm_logs.block_number, evm_logs.log_index)`, utils.NewBig(o.chainID), address, eventSig.Bytes(), wordIndex, wordValueMin.Bytes(), wordValueMax.Bytes(), confs)
-	if err != nil {
-		return nil, err
-	}
-	return logs, nil
+	return o.SelectLogs(NewLogQuery().
+		WithAddresses(address).
+		WithEventSigs(eventSig).
+		WithDataWordRange(wordIndex, wordValueMin, wordValueMax).
+		WithConfs(confs), qopts...)
 }
 
 func (o *ORM) SelectDataWordGreaterThan(address common.Address, eventSig common.Hash, wordIndex int, wordValueMin common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error) {
-	var logs []Log
-	q := o.q.WithOpts(qopts...)
-	err := q.Select(&logs,
-		`SELECT * FROM evm_logs 
-			WHERE evm_logs.evm_chain_id = $1
-			AND address = $2 AND event_sig = $3
-			AND substring(data from 32*$4+1 for 32) >= $5
-			AND (block_number + $6) <= (SELECT COALESCE(block_number, 0) FROM evm_log_poller_blocks WHERE evm_chain_id = $1 ORDER BY block_number DESC LIMIT 1)
-			ORDER BY (evm_logs.block_number, evm_logs.log_index)`, utils.NewBig(o.chainID), address, eventSig.Bytes(), wordIndex, wordValueMin.Bytes(), confs)
-	if err != nil {
-		return nil, err
-	}
-	return logs, nil
+	return o.SelectLogs(NewLogQuery().
+		WithAddresses(address).
+		WithEventSigs(eventSig).
+		WithDataWordGreaterThan(wordIndex, wordValueMin).
+		WithConfs(confs), qopts...)
 }
 
 func (o *ORM) SelectIndexLogsTopicGreaterThan(address common.Address, eventSig common.Hash, topicIndex int, topicValueMin common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error) {
-	if err := validateTopicIndex(topicIndex); err != nil {
-		return nil, err
-	}
-
-	var logs []Log
-	q := o.q.WithOpts(qopts...)
-	err := q.Select(&logs,
-		`SELECT * FROM evm_logs 
-			WHERE evm_logs.evm_chain_id = $1
-			AND address = $2 AND event_sig = $3
-			AND topics[$4] >= $5
-			AND (block_number + $6) <= (SELECT COALESCE(block_number, 0) FROM evm_log_poller_blocks WHERE evm_chain_id = $1 ORDER BY block_number DESC LIMIT 1)
-			ORDER BY (evm_logs.block_number, evm_logs.log_index)`, utils.NewBig(o.chainID), address, eventSig.Bytes(), topicIndex+1, topicValueMin.Bytes(), confs)
-	if err != nil {
-		return nil, err
-	}
-	return logs, nil
+	return o.SelectLogs(NewLogQuery().
+		WithAddresses(address).
+		WithEventSigs(eventSig).
+		WithTopicGreaterThan(topicIndex, topicValueMin).
+		WithConfs(confs), qopts...)
 }
 
 func (o *ORM) SelectIndexLogsTopicRange(address common.Address, eventSig common.Hash, topicIndex int, topicValueMin, topicValueMax common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error) {
-	if err := validateTopicIndex(topicIndex); err != nil {
-		return nil, err
-	}
-
-	var logs []Log
-	q := o.q.WithOpts(qopts...)
-	err := q.Select(&logs,
-		`SELECT * FROM evm_logs 
-			WHERE evm_logs.evm_chain_id = $1
-			AND address = $2 AND event_sig = $3
-			AND topics[$4] >= $5
-			AND topics[$4] <= $6
-			AND (block_number + $7) <= (SELECT COALESCE(block_number, 0) FROM evm_log_poller_blocks WHERE evm_chain_id = $1 ORDER BY block_number DESC LIMIT 1)
-			ORDER BY (evm_logs.block_number, evm_logs.log_index)`, utils.NewBig(o.chainID), address, eventSig.Bytes(), topicIndex+1, topicValueMin.Bytes(), topicValueMax.Bytes(), confs)
-	if err != nil {
-		return nil, err
-	}
-	return logs, nil
+	return o.SelectLogs(NewLogQuery().
+		WithAddresses(address).
+		WithEventSigs(eventSig).
+		WithTopicRange(topicIndex, topicValueMin, topicValueMax).
+		WithConfs(confs), qopts...)
 }
 
 func (o *ORM) SelectIndexedLogs(address common.Address, eventSig common.Hash, topicIndex int, topicValues []common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error) {
-	if err := validateTopicIndex(topicIndex); err != nil {
-		return nil, err
-	}
-
-	q := o.q.WithOpts(qopts...)
-	var logs []Log
-	var topicValuesBytes [][]byte
-	for _, topicValue := range topicValues {
-		topicValuesBytes = append(topicValuesBytes, topicValue.Bytes())
-	}
-	// Add 1 since postgresql arrays are 1-indexed.
-	err := q.Select(&logs, `
-		SELECT * FROM evm_logs 
-			WHERE evm_logs.evm_chain_id = $1
-			AND address = $2 AND event_sig = $3
-			AND topics[$4] = ANY($5)
-			AND (block_number + $6) <= (SELECT COALESCE(block_number, 0) FROM evm_log_poller_blocks WHERE evm_chain_id = $1 ORDER BY block_number DESC LIMIT 1)
-			ORDER BY (evm_logs.block_number, evm_logs.log_index)`, utils.NewBig(o.chainID), address, eventSig.Bytes(), topicIndex+1, pq.ByteaArray(topicValuesBytes), confs)
-	if err != nil {
-		return nil, err
-	}
-	return logs, nil
+	return o.SelectLogs(NewLogQuery().
+		WithAddresses(address).
+		WithEventSigs(eventSig).
+		WithTopicValues(topicIndex, topicValues...).
+		WithConfs(confs), qopts...)
 }
 
 // SelectIndexedLogsByBlockRangeFilter finds the indexed logs in a given block range.
 func (o *ORM) SelectIndexedLogsByBlockRangeFilter(start, end int64, address common.Address, eventSig common.Hash, topicIndex int, topicValues []common.Hash, qopts ...pg.QOpt) ([]Log, error) {
-	if err := validateTopicIndex(topicIndex); err != nil {
-		return nil, err
-	}
-
-	var logs []Log
-	var topicValuesBytes [][]byte
-	for _, topicValue := range topicValues {
-		topicValuesBytes = append(topicValuesBytes, topicValue.Bytes())
-	}
-	q := o.q.WithOpts(qopts...)
-	err := q.Select(&logs, `
-		SELECT * FROM evm_logs 
-			WHERE evm_logs.block_number >= $1 AND evm_logs.block_number <= $2 AND evm_logs.evm_chain_id = $3 
-			AND address = $4 AND event_sig = $5
-			AND topics[$6] = ANY($7)
-			ORDER BY (evm_logs.block_number, evm_logs.log_index)`, start, end, utils.NewBig(o.chainID), address, eventSig.Bytes(), topicIndex+1, pq.ByteaArray(topicValuesBytes))
-	if err != nil {
-		return nil, err
-	}
-	return logs, nil
+	return o.SelectLogs(NewLogQuery().
+		WithAddresses(address).
+		WithEventSigs(eventSig).
+		WithTopicValues(topicIndex, topicValues...).
+		WithBlockRange(start, end), qopts...)
 }
 
 func validateTopicIndex(index int) error {
@@ -441,40 +304,51 @@ func validateTopicIndex(index int) error {
 
 // SelectIndexedLogsWithSigsExcluding query's for logs that have signature A and exclude logs that have a corresponding signature B, matching is done based on the topic index both logs should be inside the block range and have the minimum number of confirmations
 func (o *ORM) SelectIndexedLogsWithSigsExcluding(sigA, sigB common.Hash, topicIndex int, address common.Address, startBlock, endBlock int64, confs int, qopts ...pg.QOpt) ([]Log, error) {
-	if err := validateTopicIndex(topicIndex); err != nil {
+	return o.SelectLogs(NewLogQuery().
+		WithAddresses(address).
+		WithEventSigs(sigA).
+		WithBlockRange(startBlock, endBlock).
+		WithConfs(confs).
+		Excluding(sigB, topicIndex), qopts...)
+}
+
+// SelectLogs finds the logs matching query.
+func (o *ORM) SelectLogs(query *LogQuery, qopts ...pg.QOpt) ([]Log, error) {
+	stmt, args, err := query.toSQL(o.chainID)
+	if err != nil {
 		return nil, err
 	}
-
-	q := o.q.WithOpts(qopts...)
 	var logs []Log
-
-	err := q.Select(&logs, `
-		SELECT *
-		FROM   evm_logs
-		WHERE  evm_chain_id = $1
-		AND    address = $2
-		AND    event_sig = $3
-		AND block_number BETWEEN $6 AND $7
-		AND (block_number + $8) <= (SELECT COALESCE(block_number, 0) FROM evm_log_poller_blocks WHERE evm_chain_id = $1 ORDER BY block_number DESC LIMIT 1)
-		
-		EXCEPT
-		
-		SELECT     a.*
-		FROM       evm_logs AS a
-		INNER JOIN evm_logs B
-		ON         a.evm_chain_id = b.evm_chain_id
-		AND        a.address = b.address
-		AND        a.topics[$5] = b.topics[$5]
-		AND        a.event_sig = $3
-		AND        b.event_sig = $4
-	    AND 	   b.block_number BETWEEN $6 AND $7
-		AND (b.block_number + $8) <= (SELECT COALESCE(block_number, 0) FROM evm_log_poller_blocks WHERE evm_chain_id = $1 ORDER BY block_number DESC LIMIT 1)
-
-		ORDER BY block_number,log_index ASC
-			`, utils.NewBig(o.chainID), address, sigA.Bytes(), sigB.Bytes(), topicIndex+1, startBlock, endBlock, confs)
-	if err != nil {
+	q := o.q.WithOpts(qopts...)
+	if err = q.Select(&logs, stmt, args...); err != nil {
 		return nil, err
 	}
 	return logs, nil
+}
+
+// SelectSubscriptionCursor returns the cursor saved for the named subscription.
+func (o *ORM) SelectSubscriptionCursor(name string, qopts ...pg.QOpt) (*LogCursor, error) {
+	q := o.q.WithOpts(qopts...)
+	var c LogCursor
+	if err := q.Get(&c, `SELECT block_number, log_index FROM evm_log_poller_subscriptions WHERE name = $1 AND evm_chain_id = $2`, name, utils.NewBig(o.chainID)); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// UpsertSubscriptionCursor saves the cursor of the named subscription.
+func (o *ORM) UpsertSubscriptionCursor(name string, cursor LogCursor, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	return q.ExecQ(`INSERT INTO evm_log_poller_subscriptions (name, evm_chain_id, block_number, log_index, updated_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (evm_chain_id, name) DO UPDATE SET block_number = $3, log_index = $4, updated_at = NOW()`,
+		name, utils.NewBig(o.chainID), cursor.BlockNumber, cursor.LogIndex)
+}
 
+// RewindSubscriptionCursors moves the cursors past the logs which are deleted
+// from block start on back to the beginning of block start.
+func (o *ORM) RewindSubscriptionCursors(start int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	return q.ExecQ(`UPDATE evm_log_poller_subscriptions SET block_number = $1, log_index = -1, updated_at = NOW()
+		WHERE evm_chain_id = $2 AND (block_number, log_index) > ($1, -1)`, start, utils.NewBig(o.chainID))
 }
@@ -1001,3 +1001,74 @@ func TestSelectLogsWithSigsExcluding(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, logs, 0)
 }
+
+func TestORM_SelectLogs(t *testing.T) {
+	th := SetupTH(t, 2, 3, 2)
+	o1 := th.ORM
+	eventSig := common.HexToHash("0x1599")
+	addr1, addr2 := common.HexToAddress("0x1234"), common.HexToAddress("0x1235")
+	start := time.Unix(1000, 0)
+
+	var lgs []logpoller.Log
+	for i := int64(1); i <= 5; i++ {
+		for j, addr := range []common.Address{addr1, addr2} {
+			lgs = append(lgs, logpoller.Log{
+				EvmChainId:     utils.NewBig(th.ChainID),
+				LogIndex:       int64(j),
+				BlockHash:      common.BigToHash(big.NewInt(i)),
+				BlockNumber:    i,
+				BlockTimestamp: start.Add(time.Duration(i) * time.Minute),
+				EventSig:       eventSig,
+				Topics:         [][]byte{eventSig[:], logpoller.EvmWord(uint64(i)).Bytes()},
+				Address:        addr,
+				TxHash:         common.HexToHash("0x1888"),
+				Data:           logpoller.EvmWord(uint64(i * 10)).Bytes(),
+			})
+		}
+	}
+	require.NoError(t, o1.InsertLogs(lgs))
+	require.NoError(t, o1.InsertBlock(common.BigToHash(big.NewInt(5)), 5, start.Add(5*time.Minute)))
+
+	// Combined topic and data word predicates
+	logs, err := o1.SelectLogs(logpoller.NewLogQuery().
+		WithAddresses(addr1).
+		WithEventSigs(eventSig).
+		WithTopicGreaterThan(1, logpoller.EvmWord(2)).
+		WithDataWordLessThan(0, logpoller.EvmWord(30)))
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	assert.Equal(t, int64(2), logs[0].BlockNumber)
+	assert.Equal(t, int64(3), logs[1].BlockNumber)
+
+	// Timestamp range and confirmations
+	logs, err = o1.SelectLogs(logpoller.NewLogQuery().
+		WithAddresses(addr2).
+		FromTimestamp(start.Add(3 * time.Minute)).
+		WithConfs(1))
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	assert.Equal(t, int64(3), logs[0].BlockNumber)
+	assert.Equal(t, int64(4), logs[1].BlockNumber)
+
+	// Page through the logs in descending order
+	query := logpoller.NewLogQuery().WithEventSigs(eventSig).OrderBy(logpoller.Descending).Limit(3)
+	var pages [][]logpoller.Log
+	for {
+		logs, err = o1.SelectLogs(query)
+		require.NoError(t, err)
+		if len(logs) == 0 {
+			break
+		}
+		pages = append(pages, logs)
+		query.After(logpoller.NewLogCursor(logs[len(logs)-1]))
+	}
+	require.Len(t, pages, 4)
+	assert.Equal(t, logpoller.LogCursor{BlockNumber: 5, LogIndex: 1}, logpoller.NewLogCursor(pages[0][0]))
+	assert.Equal(t, logpoller.LogCursor{BlockNumber: 4, LogIndex: 0}, logpoller.NewLogCursor(pages[1][0]))
+	require.Len(t, pages[3], 1)
+	assert.Equal(t, logpoller.LogCursor{BlockNumber: 1, LogIndex: 0}, logpoller.NewLogCursor(pages[3][0]))
+
+	// Invalid options are reported
+	_, err = o1.SelectLogs(logpoller.NewLogQuery().WithTopicValues(4, eventSig))
+	require.EqualError(t, err, "invalid index for topic: 4")
+}
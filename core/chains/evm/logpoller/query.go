@@ -0,0 +1,407 @@
+package logpoller
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// SortOrder is the order in which a LogQuery returns logs.
+type SortOrder int
+
+const (
+	// Ascending returns the oldest logs first.
+	Ascending SortOrder = iota
+	// Descending returns the latest logs first.
+	Descending
+)
+
+// LogCursor is the position of a log in the chain, used to page through the
+// results of a LogQuery.
+type LogCursor struct {
+	BlockNumber int64
+	LogIndex    int64
+}
+
+// NewLogCursor returns the position of l, so that a query may resume after it.
+func NewLogCursor(l Log) LogCursor {
+	return LogCursor{BlockNumber: l.BlockNumber, LogIndex: l.LogIndex}
+}
+
+type valueFilter struct {
+	index    int
+	values   []common.Hash
+	min, max *common.Hash
+}
+
+type exclusion struct {
+	eventSig   common.Hash
+	topicIndex int
+}
+
+// LogQuery is a filter expression over the logs saved by the log poller, which
+// the ORM compiles into a single parameterized query. The zero value matches
+// every log of the chain and each option narrows the match, for example:
+//
+//	NewLogQuery().
+//		WithAddresses(address).
+//		WithEventSigs(eventSig).
+//		WithTopicValues(1, topicValues...).
+//		WithConfs(confs)
+//
+// Options which take a topic index accept 1 through 3, topic 0 being the event
+// signature. Data words are indexed from 0. An invalid option is reported when
+// the query is run.
+type LogQuery struct {
+	addresses         []common.Address
+	eventSigs         []common.Hash
+	topics            []valueFilter
+	dataWords         []valueFilter
+	confs             *int
+	fromBlock         *int64
+	toBlock           *int64
+	fromTimestamp     *time.Time
+	toTimestamp       *time.Time
+	excluding         []exclusion
+	latestPerEventSig bool
+	order             SortOrder
+	limit             int
+	after             *LogCursor
+
+	err error
+}
+
+// NewLogQuery returns a query which matches every log of the chain.
+func NewLogQuery() *LogQuery {
+	return &LogQuery{}
+}
+
+func (q *LogQuery) setErr(err error) *LogQuery {
+	if q.err == nil {
+		q.err = err
+	}
+	return q
+}
+
+// WithAddresses matches logs emitted from any of the addresses. Repeated calls
+// extend the set, which matches no logs if it is left empty.
+func (q *LogQuery) WithAddresses(addresses ...common.Address) *LogQuery {
+	if q.addresses == nil {
+		q.addresses = []common.Address{}
+	}
+	q.addresses = append(q.addresses, addresses...)
+	return q
+}
+
+// WithEventSigs matches logs with any of the event signatures. Repeated calls
+// extend the set, which matches no logs if it is left empty.
+func (q *LogQuery) WithEventSigs(eventSigs ...common.Hash) *LogQuery {
+	if q.eventSigs == nil {
+		q.eventSigs = []common.Hash{}
+	}
+	q.eventSigs = append(q.eventSigs, eventSigs...)
+	return q
+}
+
+// WithTopicValues matches logs whose topic at topicIndex equals any of the
+// values, and no logs if there are none.
+func (q *LogQuery) WithTopicValues(topicIndex int, values ...common.Hash) *LogQuery {
+	if err := validateTopicIndex(topicIndex); err != nil {
+		return q.setErr(err)
+	}
+	q.topics = append(q.topics, valueFilter{index: topicIndex, values: append([]common.Hash{}, values...)})
+	return q
+}
+
+// WithTopicRange matches logs whose topic at topicIndex is within [min, max].
+func (q *LogQuery) WithTopicRange(topicIndex int, min, max common.Hash) *LogQuery {
+	if err := validateTopicIndex(topicIndex); err != nil {
+		return q.setErr(err)
+	}
+	q.topics = append(q.topics, valueFilter{index: topicIndex, min: &min, max: &max})
+	return q
+}
+
+// WithTopicGreaterThan matches logs whose topic at topicIndex is at least min.
+func (q *LogQuery) WithTopicGreaterThan(topicIndex int, min common.Hash) *LogQuery {
+	if err := validateTopicIndex(topicIndex); err != nil {
+		return q.setErr(err)
+	}
+	q.topics = append(q.topics, valueFilter{index: topicIndex, min: &min})
+	return q
+}
+
+// WithTopicLessThan matches logs whose topic at topicIndex is at most max.
+func (q *LogQuery) WithTopicLessThan(topicIndex int, max common.Hash) *LogQuery {
+	if err := validateTopicIndex(topicIndex); err != nil {
+		return q.setErr(err)
+	}
+	q.topics = append(q.topics, valueFilter{index: topicIndex, max: &max})
+	return q
+}
+
+// WithDataWordValues matches logs whose data word at wordIndex equals any of
+// the values, and no logs if there are none.
+func (q *LogQuery) WithDataWordValues(wordIndex int, values ...common.Hash) *LogQuery {
+	if err := validateWordIndex(wordIndex); err != nil {
+		return q.setErr(err)
+	}
+	q.dataWords = append(q.dataWords, valueFilter{index: wordIndex, values: append([]common.Hash{}, values...)})
+	return q
+}
+
+// WithDataWordRange matches logs whose data word at wordIndex is within [min, max].
+func (q *LogQuery) WithDataWordRange(wordIndex int, min, max common.Hash) *LogQuery {
+	if err := validateWordIndex(wordIndex); err != nil {
+		return q.setErr(err)
+	}
+	q.dataWords = append(q.dataWords, valueFilter{index: wordIndex, min: &min, max: &max})
+	return q
+}
+
+// WithDataWordGreaterThan matches logs whose data word at wordIndex is at least min.
+func (q *LogQuery) WithDataWordGreaterThan(wordIndex int, min common.Hash) *LogQuery {
+	if err := validateWordIndex(wordIndex); err != nil {
+		return q.setErr(err)
+	}
+	q.dataWords = append(q.dataWords, valueFilter{index: wordIndex, min: &min})
+	return q
+}
+
+// WithDataWordLessThan matches logs whose data word at wordIndex is at most max.
+func (q *LogQuery) WithDataWordLessThan(wordIndex int, max common.Hash) *LogQuery {
+	if err := validateWordIndex(wordIndex); err != nil {
+		return q.setErr(err)
+	}
+	q.dataWords = append(q.dataWords, valueFilter{index: wordIndex, max: &max})
+	return q
+}
+
+// WithConfs matches logs with at least confs confirmations on top of the latest
+// block saved by the log poller.
+func (q *LogQuery) WithConfs(confs int) *LogQuery {
+	if confs < 0 {
+		return q.setErr(errors.Errorf("invalid confirmations: %d", confs))
+	}
+	q.confs = &confs
+	return q
+}
+
+// WithBlockRange matches logs in blocks start through end, inclusive.
+func (q *LogQuery) WithBlockRange(start, end int64) *LogQuery {
+	return q.FromBlock(start).ToBlock(end)
+}
+
+// FromBlock matches logs in block start or later.
+func (q *LogQuery) FromBlock(start int64) *LogQuery {
+	q.fromBlock = &start
+	return q
+}
+
+// ToBlock matches logs in block end or earlier.
+func (q *LogQuery) ToBlock(end int64) *LogQuery {
+	q.toBlock = &end
+	return q
+}
+
+// WithTimestampRange matches logs in blocks with a timestamp within [start, end].
+func (q *LogQuery) WithTimestampRange(start, end time.Time) *LogQuery {
+	return q.FromTimestamp(start).ToTimestamp(end)
+}
+
+// FromTimestamp matches logs in blocks with a timestamp at or after start.
+func (q *LogQuery) FromTimestamp(start time.Time) *LogQuery {
+	q.fromTimestamp = &start
+	return q
+}
+
+// ToTimestamp matches logs in blocks with a timestamp at or before end.
+func (q *LogQuery) ToTimestamp(end time.Time) *LogQuery {
+	q.toTimestamp = &end
+	return q
+}
+
+// Excluding drops the logs for which a log from the same address with
+// eventSig has an equal topic at topicIndex, for example requests which have
+// been fulfilled. The excluding log must itself be within the block and
+// timestamp range of the query and have the required confirmations.
+func (q *LogQuery) Excluding(eventSig common.Hash, topicIndex int) *LogQuery {
+	if err := validateTopicIndex(topicIndex); err != nil {
+		return q.setErr(err)
+	}
+	q.excluding = append(q.excluding, exclusion{eventSig: eventSig, topicIndex: topicIndex})
+	return q
+}
+
+// LatestPerEventSig matches only the logs in the latest matching block of each
+// address and event signature pair.
+func (q *LogQuery) LatestPerEventSig() *LogQuery {
+	q.latestPerEventSig = true
+	return q
+}
+
+// OrderBy sets the order of the logs by block number and log index, ascending
+// by default.
+func (q *LogQuery) OrderBy(order SortOrder) *LogQuery {
+	q.order = order
+	return q
+}
+
+// Limit returns at most n logs, zero for no limit.
+func (q *LogQuery) Limit(n int) *LogQuery {
+	if n < 0 {
+		return q.setErr(errors.Errorf("invalid limit: %d", n))
+	}
+	q.limit = n
+	return q
+}
+
+// After matches logs after the cursor in the order of the query, so that a
+// query may be paged through by passing the cursor of the last log returned.
+func (q *LogQuery) After(cursor LogCursor) *LogQuery {
+	q.after = &cursor
+	return q
+}
+
+func validateWordIndex(index int) error {
+	if index < 0 {
+		return errors.Errorf("invalid index for data word: %d", index)
+	}
+	return nil
+}
+
+// queryArgs collects the arguments of a query and returns their placeholders.
+type queryArgs []interface{}
+
+func (a *queryArgs) add(arg interface{}) string {
+	*a = append(*a, arg)
+	return fmt.Sprintf("$%d", len(*a))
+}
+
+func hashesToBytea(hashes []common.Hash) pq.ByteaArray {
+	b := make(pq.ByteaArray, 0, len(hashes))
+	for _, h := range hashes {
+		b = append(b, h.Bytes())
+	}
+	return b
+}
+
+func addressesToBytea(addresses []common.Address) pq.ByteaArray {
+	b := make(pq.ByteaArray, 0, len(addresses))
+	for _, a := range addresses {
+		b = append(b, a.Bytes())
+	}
+	return b
+}
+
+// conditions returns the predicates on a single value, which is the column
+// expression at the given placeholder index.
+func (f valueFilter) conditions(column func(index string) string, args *queryArgs) []string {
+	col := column(args.add(f.index))
+	var conds []string
+	if f.values != nil {
+		conds = append(conds, fmt.Sprintf("%s = ANY(%s)", col, args.add(hashesToBytea(f.values))))
+	}
+	if f.min != nil {
+		conds = append(conds, fmt.Sprintf("%s >= %s", col, args.add(f.min.Bytes())))
+	}
+	if f.max != nil {
+		conds = append(conds, fmt.Sprintf("%s <= %s", col, args.add(f.max.Bytes())))
+	}
+	return conds
+}
+
+// windowConditions returns the block, timestamp and confirmation predicates on
+// the logs of table.
+func (q *LogQuery) windowConditions(table string, chainID string, args *queryArgs) []string {
+	var conds []string
+	if q.fromBlock != nil {
+		conds = append(conds, fmt.Sprintf("%s.block_number >= %s", table, args.add(*q.fromBlock)))
+	}
+	if q.toBlock != nil {
+		conds = append(conds, fmt.Sprintf("%s.block_number <= %s", table, args.add(*q.toBlock)))
+	}
+	if q.fromTimestamp != nil {
+		conds = append(conds, fmt.Sprintf("%s.block_timestamp >= %s", table, args.add(*q.fromTimestamp)))
+	}
+	if q.toTimestamp != nil {
+		conds = append(conds, fmt.Sprintf("%s.block_timestamp <= %s", table, args.add(*q.toTimestamp)))
+	}
+	if q.confs != nil {
+		conds = append(conds, fmt.Sprintf("(%s.block_number + %s) <= (SELECT COALESCE(block_number, 0) FROM evm_log_poller_blocks WHERE evm_chain_id = %s ORDER BY block_number DESC LIMIT 1)",
+			table, args.add(*q.confs), chainID))
+	}
+	return conds
+}
+
+// toSQL compiles the query for the logs of chainID.
+func (q *LogQuery) toSQL(chainID *big.Int) (string, []interface{}, error) {
+	if q.err != nil {
+		return "", nil, q.err
+	}
+
+	var args queryArgs
+	chain := args.add(utils.NewBig(chainID))
+	where := []string{"evm_logs.evm_chain_id = " + chain}
+	if q.addresses != nil {
+		where = append(where, fmt.Sprintf("evm_logs.address = ANY(%s)", args.add(addressesToBytea(q.addresses))))
+	}
+	if q.eventSigs != nil {
+		where = append(where, fmt.Sprintf("evm_logs.event_sig = ANY(%s)", args.add(hashesToBytea(q.eventSigs))))
+	}
+	for _, f := range q.topics {
+		// Add 1 since postgresql arrays are 1-indexed.
+		where = append(where, f.conditions(func(i string) string {
+			return fmt.Sprintf("evm_logs.topics[%s+1]", i)
+		}, &args)...)
+	}
+	for _, f := range q.dataWords {
+		where = append(where, f.conditions(func(i string) string {
+			return fmt.Sprintf("substring(evm_logs.data from 32*%s+1 for 32)", i)
+		}, &args)...)
+	}
+	where = append(where, q.windowConditions("evm_logs", chain, &args)...)
+	for _, e := range q.excluding {
+		excluded := []string{
+			"excluded.evm_chain_id = evm_logs.evm_chain_id",
+			"excluded.address = evm_logs.address",
+			fmt.Sprintf("excluded.event_sig = %s", args.add(e.eventSig.Bytes())),
+		}
+		i := args.add(e.topicIndex)
+		excluded = append(excluded, fmt.Sprintf("excluded.topics[%s+1] = evm_logs.topics[%s+1]", i, i))
+		excluded = append(excluded, q.windowConditions("excluded", chain, &args)...)
+		where = append(where, fmt.Sprintf("NOT EXISTS (SELECT 1 FROM evm_logs AS excluded WHERE %s)", strings.Join(excluded, " AND ")))
+	}
+	if q.latestPerEventSig {
+		where = []string{
+			"evm_logs.evm_chain_id = " + chain,
+			fmt.Sprintf("(evm_logs.block_number, evm_logs.address, evm_logs.event_sig) IN (SELECT MAX(evm_logs.block_number), evm_logs.address, evm_logs.event_sig FROM evm_logs WHERE %s GROUP BY evm_logs.event_sig, evm_logs.address)",
+				strings.Join(where, " AND ")),
+		}
+	}
+
+	direction, cmp := "ASC", ">"
+	if q.order == Descending {
+		direction, cmp = "DESC", "<"
+	}
+	if q.after != nil {
+		where = append(where, fmt.Sprintf("(evm_logs.block_number, evm_logs.log_index) %s (%s, %s)",
+			cmp, args.add(q.after.BlockNumber), args.add(q.after.LogIndex)))
+	}
+
+	var sb strings.Builder
+	sb.WriteString("SELECT * FROM evm_logs WHERE ")
+	sb.WriteString(strings.Join(where, " AND "))
+	fmt.Fprintf(&sb, " ORDER BY evm_logs.block_number %s, evm_logs.log_index %s", direction, direction)
+	if q.limit > 0 {
+		fmt.Fprintf(&sb, " LIMIT %s", args.add(q.limit))
+	}
+	return sb.String(), args, nil
+}
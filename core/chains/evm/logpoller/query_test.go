@@ -0,0 +1,139 @@
+package logpoller
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestLogQuery_toSQL(t *testing.T) {
+	chainID := big.NewInt(137)
+	addr := common.HexToAddress("0x1234")
+	sig := common.HexToHash("0x1599")
+	confs := "(SELECT COALESCE(block_number, 0) FROM evm_log_poller_blocks WHERE evm_chain_id = $1 ORDER BY block_number DESC LIMIT 1)"
+
+	t.Run("empty", func(t *testing.T) {
+		stmt, args, err := NewLogQuery().toSQL(chainID)
+		require.NoError(t, err)
+		assert.Equal(t, "SELECT * FROM evm_logs WHERE evm_logs.evm_chain_id = $1 ORDER BY evm_logs.block_number ASC, evm_logs.log_index ASC", stmt)
+		assert.Equal(t, []interface{}{utils.NewBig(chainID)}, args)
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		stmt, args, err := NewLogQuery().
+			WithAddresses(addr).
+			WithEventSigs(sig).
+			WithTopicValues(1, EvmWord(1), EvmWord(2)).
+			WithDataWordRange(0, EvmWord(3), EvmWord(4)).
+			WithConfs(2).
+			WithBlockRange(10, 20).
+			toSQL(chainID)
+		require.NoError(t, err)
+		assert.Equal(t, "SELECT * FROM evm_logs WHERE evm_logs.evm_chain_id = $1"+
+			" AND evm_logs.address = ANY($2)"+
+			" AND evm_logs.event_sig = ANY($3)"+
+			" AND evm_logs.topics[$4+1] = ANY($5)"+
+			" AND substring(evm_logs.data from 32*$6+1 for 32) >= $7"+
+			" AND substring(evm_logs.data from 32*$6+1 for 32) <= $8"+
+			" AND evm_logs.block_number >= $9"+
+			" AND evm_logs.block_number <= $10"+
+			" AND (evm_logs.block_number + $11) <= "+confs+
+			" ORDER BY evm_logs.block_number ASC, evm_logs.log_index ASC", stmt)
+		assert.Equal(t, []interface{}{
+			utils.NewBig(chainID),
+			pq.ByteaArray{addr.Bytes()},
+			pq.ByteaArray{sig.Bytes()},
+			1,
+			pq.ByteaArray{EvmWord(1).Bytes(), EvmWord(2).Bytes()},
+			0,
+			EvmWord(3).Bytes(),
+			EvmWord(4).Bytes(),
+			int64(10),
+			int64(20),
+			2,
+		}, args)
+	})
+
+	t.Run("timestamps, cursor and limit", func(t *testing.T) {
+		from, to := time.Unix(100, 0), time.Unix(200, 0)
+		stmt, args, err := NewLogQuery().
+			WithTimestampRange(from, to).
+			OrderBy(Descending).
+			After(LogCursor{BlockNumber: 5, LogIndex: 3}).
+			Limit(10).
+			toSQL(chainID)
+		require.NoError(t, err)
+		assert.Equal(t, "SELECT * FROM evm_logs WHERE evm_logs.evm_chain_id = $1"+
+			" AND evm_logs.block_timestamp >= $2"+
+			" AND evm_logs.block_timestamp <= $3"+
+			" AND (evm_logs.block_number, evm_logs.log_index) < ($4, $5)"+
+			" ORDER BY evm_logs.block_number DESC, evm_logs.log_index DESC LIMIT $6", stmt)
+		assert.Equal(t, []interface{}{utils.NewBig(chainID), from, to, int64(5), int64(3), 10}, args)
+	})
+
+	t.Run("excluding", func(t *testing.T) {
+		sigB := common.HexToHash("0x1600")
+		stmt, _, err := NewLogQuery().
+			WithEventSigs(sig).
+			WithConfs(1).
+			Excluding(sigB, 2).
+			toSQL(chainID)
+		require.NoError(t, err)
+		assert.Equal(t, "SELECT * FROM evm_logs WHERE evm_logs.evm_chain_id = $1"+
+			" AND evm_logs.event_sig = ANY($2)"+
+			" AND (evm_logs.block_number + $3) <= "+confs+
+			" AND NOT EXISTS (SELECT 1 FROM evm_logs AS excluded WHERE excluded.evm_chain_id = evm_logs.evm_chain_id"+
+			" AND excluded.address = evm_logs.address"+
+			" AND excluded.event_sig = $4"+
+			" AND excluded.topics[$5+1] = evm_logs.topics[$5+1]"+
+			" AND (excluded.block_number + $6) <= "+confs+")"+
+			" ORDER BY evm_logs.block_number ASC, evm_logs.log_index ASC", stmt)
+	})
+
+	t.Run("latest per event sig", func(t *testing.T) {
+		stmt, _, err := NewLogQuery().
+			WithEventSigs(sig).
+			LatestPerEventSig().
+			toSQL(chainID)
+		require.NoError(t, err)
+		assert.Equal(t, "SELECT * FROM evm_logs WHERE evm_logs.evm_chain_id = $1"+
+			" AND (evm_logs.block_number, evm_logs.address, evm_logs.event_sig) IN"+
+			" (SELECT MAX(evm_logs.block_number), evm_logs.address, evm_logs.event_sig FROM evm_logs"+
+			" WHERE evm_logs.evm_chain_id = $1 AND evm_logs.event_sig = ANY($2) GROUP BY evm_logs.event_sig, evm_logs.address)"+
+			" ORDER BY evm_logs.block_number ASC, evm_logs.log_index ASC", stmt)
+	})
+
+	t.Run("empty sets", func(t *testing.T) {
+		_, args, err := NewLogQuery().WithAddresses().WithTopicValues(1).toSQL(chainID)
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{utils.NewBig(chainID), pq.ByteaArray{}, 1, pq.ByteaArray{}}, args)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, tc := range []struct {
+			name  string
+			query *LogQuery
+			err   string
+		}{
+			{"topic 0", NewLogQuery().WithTopicValues(0, sig), "invalid index for topic: 0"},
+			{"topic 4", NewLogQuery().WithTopicRange(4, sig, sig), "invalid index for topic: 4"},
+			{"excluding topic", NewLogQuery().Excluding(sig, 0), "invalid index for topic: 0"},
+			{"data word", NewLogQuery().WithDataWordGreaterThan(-1, sig), "invalid index for data word: -1"},
+			{"confs", NewLogQuery().WithConfs(-1), "invalid confirmations: -1"},
+			{"limit", NewLogQuery().Limit(-1), "invalid limit: -1"},
+			{"first error", NewLogQuery().WithConfs(-1).Limit(-1), "invalid confirmations: -1"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				_, _, err := tc.query.toSQL(chainID)
+				require.EqualError(t, err, tc.err)
+			})
+		}
+	})
+}
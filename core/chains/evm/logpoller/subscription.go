@@ -0,0 +1,272 @@
+package logpoller
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// subscriptionBatchSize is the maximum number of logs in a SubscriptionEvent.
+const subscriptionBatchSize = 1000
+
+// SubscriptionParams describes the logs delivered to a Subscription. Like for
+// queries, only logs matching a registered Filter are saved by the log poller.
+type SubscriptionParams struct {
+	// Name identifies the subscription, whose cursor is saved across restarts.
+	Name      string
+	Addresses []common.Address
+	EventSigs []common.Hash
+	// Confs is the number of confirmations a log needs before it is delivered.
+	Confs int
+	// FromBlock is the first block delivered if no cursor is saved for Name.
+	FromBlock int64
+}
+
+// SubscriptionEvent is sent to a Subscription after the log poller saved new
+// logs or removed logs in a reorg.
+type SubscriptionEvent struct {
+	// RemovedFromBlock is set when a reorg removed the logs in this block and
+	// later, some of which may have been delivered already. The logs of the new
+	// chain are delivered by the following events.
+	RemovedFromBlock int64
+	// Logs are the logs newly saved with the required confirmations, ordered
+	// by block number and log index.
+	Logs []Log
+	// Cursor is the position of the subscription after the event. A consumer
+	// should Ack the event once it is processed, so that the subscription
+	// resumes after it.
+	Cursor LogCursor
+
+	// generation counts the reorgs which rewound the subscription before the
+	// event was sent.
+	generation uint64
+}
+
+// Subscription delivers logs to a consumer as soon as the log poller saves
+// them, instead of the consumer polling the database.
+type Subscription struct {
+	params SubscriptionParams
+	orm    *ORM
+	lggr   logger.Logger
+
+	events      chan SubscriptionEvent
+	chWake      chan struct{}
+	chStop      utils.StopChan
+	wg          sync.WaitGroup
+	closeOnce   sync.Once
+	unsubscribe func()
+
+	mu          sync.Mutex
+	cursor      LogCursor
+	removedFrom *int64
+	generation  uint64
+}
+
+// Subscribe starts delivering the logs matching params, after the saved
+// cursor of params.Name if there is one.
+func (lp *logPoller) Subscribe(params SubscriptionParams) (*Subscription, error) {
+	if params.Name == "" {
+		return nil, errors.New("subscription name is required")
+	}
+	if len(params.Addresses) == 0 || len(params.EventSigs) == 0 {
+		return nil, errors.New("subscription must have at least one address and event signature")
+	}
+	if params.Confs < 0 {
+		return nil, errors.Errorf("invalid confirmations: %d", params.Confs)
+	}
+
+	cursor := LogCursor{BlockNumber: params.FromBlock, LogIndex: -1}
+	saved, err := lp.orm.SelectSubscriptionCursor(params.Name)
+	if err == nil {
+		cursor = *saved
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to load subscription cursor")
+	}
+
+	s := &Subscription{
+		params: params,
+		orm:    lp.orm,
+		lggr:   lp.lggr.Named("Subscription").With("name", params.Name),
+		events: make(chan SubscriptionEvent),
+		chWake: make(chan struct{}, 1),
+		chStop: make(chan struct{}),
+		cursor: cursor,
+	}
+
+	lp.subscriptionsMu.Lock()
+	lp.subscriptions[s] = struct{}{}
+	lp.subscriptionsMu.Unlock()
+	s.unsubscribe = func() {
+		lp.subscriptionsMu.Lock()
+		delete(lp.subscriptions, s)
+		lp.subscriptionsMu.Unlock()
+	}
+
+	s.wg.Add(1)
+	go s.run()
+	s.wake()
+	return s, nil
+}
+
+// notifySubscriptions wakes the subscriptions after logs or blocks were saved.
+func (lp *logPoller) notifySubscriptions() {
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	for s := range lp.subscriptions {
+		s.wake()
+	}
+}
+
+// notifySubscriptionsReorg tells the subscriptions that the logs from block
+// start on were removed.
+func (lp *logPoller) notifySubscriptionsReorg(start int64) {
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	for s := range lp.subscriptions {
+		s.reorg(start)
+	}
+}
+
+func (lp *logPoller) closeSubscriptions() {
+	lp.subscriptionsMu.Lock()
+	subscriptions := make([]*Subscription, 0, len(lp.subscriptions))
+	for s := range lp.subscriptions {
+		subscriptions = append(subscriptions, s)
+	}
+	lp.subscriptionsMu.Unlock()
+	for _, s := range subscriptions {
+		s.Close()
+	}
+}
+
+// Events returns the channel of events, which is closed when the
+// subscription is closed.
+func (s *Subscription) Events() <-chan SubscriptionEvent {
+	return s.events
+}
+
+// Ack saves the cursor of event as the position from which the subscription
+// resumes if it is subscribed again, for example after a restart. An event
+// sent before a reorg removed some of its logs is refused, since its cursor
+// would skip the logs of the new chain.
+func (s *Subscription) Ack(event SubscriptionEvent, qopts ...pg.QOpt) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if event.generation != s.generation || (s.removedFrom != nil && removes(*s.removedFrom, event.Cursor)) {
+		return errors.Errorf("cannot acknowledge cursor %+v: logs were removed by a reorg after the event", event.Cursor)
+	}
+	return s.orm.UpsertSubscriptionCursor(s.params.Name, event.Cursor, qopts...)
+}
+
+// Close stops the delivery of events. The saved cursor is kept.
+func (s *Subscription) Close() {
+	s.closeOnce.Do(func() {
+		s.unsubscribe()
+		close(s.chStop)
+		s.wg.Wait()
+		close(s.events)
+	})
+}
+
+func (s *Subscription) wake() {
+	select {
+	case s.chWake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Subscription) reorg(start int64) {
+	s.mu.Lock()
+	if s.removedFrom == nil || start < *s.removedFrom {
+		s.removedFrom = &start
+	}
+	s.mu.Unlock()
+	s.wake()
+}
+
+func (s *Subscription) run() {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-s.chStop:
+			return
+		case <-s.chWake:
+		}
+		for s.deliver() {
+		}
+	}
+}
+
+// deliver sends the pending reorg notice and the next batch of logs, and
+// reports whether more logs may be pending.
+func (s *Subscription) deliver() bool {
+	ctx, cancel := s.chStop.NewCtx()
+	defer cancel()
+
+	s.mu.Lock()
+	removedFrom := s.removedFrom
+	s.removedFrom = nil
+	cursor := s.cursor
+	if removedFrom != nil && removes(*removedFrom, cursor) {
+		cursor = LogCursor{BlockNumber: *removedFrom, LogIndex: -1}
+		s.cursor = cursor
+		s.generation++
+	} else {
+		removedFrom = nil
+	}
+	generation := s.generation
+	s.mu.Unlock()
+
+	if removedFrom != nil {
+		s.lggr.Debugw("Delivering reorg", "removedFromBlock", *removedFrom)
+		if !s.send(SubscriptionEvent{RemovedFromBlock: *removedFrom, Cursor: cursor, generation: generation}) {
+			return false
+		}
+	}
+
+	logs, err := s.orm.SelectLogs(NewLogQuery().
+		WithAddresses(s.params.Addresses...).
+		WithEventSigs(s.params.EventSigs...).
+		WithConfs(s.params.Confs).
+		After(cursor).
+		Limit(subscriptionBatchSize), pg.WithParentCtx(ctx))
+	if err != nil {
+		if ctx.Err() == nil {
+			s.lggr.Warnw("Unable to query logs, retrying after the next poll", "err", err)
+		}
+		return false
+	}
+	if len(logs) == 0 {
+		return false
+	}
+
+	event := SubscriptionEvent{Logs: logs, Cursor: NewLogCursor(logs[len(logs)-1]), generation: generation}
+	if !s.send(event) {
+		return false
+	}
+	s.mu.Lock()
+	s.cursor = event.Cursor
+	s.mu.Unlock()
+	return len(logs) == subscriptionBatchSize
+}
+
+// removes reports whether cursor is past the start of block removedFrom, so
+// that a reorg removing the block may have removed logs delivered up to cursor.
+func removes(removedFrom int64, cursor LogCursor) bool {
+	return cursor.BlockNumber > removedFrom || (cursor.BlockNumber == removedFrom && cursor.LogIndex >= 0)
+}
+
+func (s *Subscription) send(event SubscriptionEvent) bool {
+	select {
+	case s.events <- event:
+		return true
+	case <-s.chStop:
+		return false
+	}
+}
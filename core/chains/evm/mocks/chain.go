@@ -254,6 +254,22 @@ func (_m *Chain) Ready() error {
 	return r0
 }
 
+// ReorgDetector provides a mock function with given fields:
+func (_m *Chain) ReorgDetector() types.ReorgDetector {
+	ret := _m.Called()
+
+	var r0 types.ReorgDetector
+	if rf, ok := ret.Get(0).(func() types.ReorgDetector); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.ReorgDetector)
+		}
+	}
+
+	return r0
+}
+
 // SendTx provides a mock function with given fields: ctx, from, to, amount, balanceCheck
 func (_m *Chain) SendTx(ctx context.Context, from string, to string, amount *big.Int, balanceCheck bool) error {
 	ret := _m.Called(ctx, from, to, amount, balanceCheck)
@@ -52,6 +52,10 @@ var (
 			float64(2 * time.Minute),
 		},
 	}, []string{"evmChainID"})
+	promNumUnstartedTxs = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_num_unstarted_txes",
+		Help: "Number of unstarted transactions queued for broadcast, by sending address and priority",
+	}, []string{"evmChainID", "fromAddress", "priority"})
 )
 
 var errEthTxRemoved = errors.New("eth_tx removed")
@@ -405,6 +409,18 @@ func (eb *EthBroadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE,
 	return eb.processUnstartedEthTxs(ctx, addr)
 }
 
+// reportUnstartedQueueDepth updates the unstarted queue depth metric for each priority class
+func (eb *EthBroadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) reportUnstartedQueueDepth(fromAddress ADDR) {
+	counts, err := eb.txStore.CountUnstartedTransactionsByPriority(fromAddress, eb.chainID)
+	if err != nil {
+		eb.logger.Errorw("Failed to count unstarted transactions", "address", fromAddress, "err", err)
+		return
+	}
+	for _, p := range []txmgrtypes.TxPriority{txmgrtypes.TxPriorityLow, txmgrtypes.TxPriorityNormal, txmgrtypes.TxPriorityHigh} {
+		promNumUnstartedTxs.WithLabelValues(eb.chainID.String(), fromAddress.String(), p.String()).Set(float64(counts[p]))
+	}
+}
+
 // NOTE: This MUST NOT be run concurrently for the same address or it could
 // result in undefined state or deadlocks.
 // First handle any in_progress transactions left over from last time.
@@ -412,9 +428,11 @@ func (eb *EthBroadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE,
 func (eb *EthBroadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) processUnstartedEthTxs(ctx context.Context, fromAddress ADDR) (retryable bool, err error) {
 	var n uint
 	mark := time.Now()
+	eb.reportUnstartedQueueDepth(fromAddress)
 	defer func() {
 		if n > 0 {
 			eb.logger.Debugw("Finished processUnstartedEthTxs", "address", fromAddress, "time", time.Since(mark), "n", n, "id", "eth_broadcaster")
+			eb.reportUnstartedQueueDepth(fromAddress)
 		}
 	}()
 
@@ -3,6 +3,7 @@ package txmgr
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"sort"
 	"strconv"
 	"sync"
@@ -16,6 +17,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
 
 	clienttypes "github.com/smartcontractkit/chainlink/v2/common/chains/client"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
@@ -48,6 +50,10 @@ var (
 	// This most likely happened because an external wallet used the account for this nonce
 	ErrCouldNotGetReceipt = "could not get receipt"
 
+	// ErrTxCancelled is the error string we save on unstarted transactions that were cancelled by the node operator,
+	// and the error passed to any pipeline run waiting on a cancelled transaction
+	ErrTxCancelled = "cancelled by node operator"
+
 	promNumGasBumps = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "tx_manager_num_gas_bumps",
 		Help: "Number of gas bumps",
@@ -1146,6 +1152,134 @@ func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, A
 	return tx.Hash().String(), nil
 }
 
+// CancelTransaction prevents the given transaction from being mined with its original payload.
+// Unstarted transactions are marked as fatally errored. Unconfirmed transactions are replaced by
+// a zero-value self-send with the same sequence, priced as a fee bump over the highest existing attempt.
+// Any pipeline run waiting on the transaction is resumed with an error.
+// Must not be called while the EthConfirmer is running.
+func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) CancelTransaction(ctx context.Context, etxID int64) error {
+	etx, err := ec.findTxForReplacement(etxID)
+	if err != nil {
+		return errors.Wrap(err, "CancelTransaction failed")
+	}
+	lggr := etx.GetLogger(ec.lggr)
+
+	switch etx.State {
+	case EthTxUnstarted:
+		etx.Error = null.StringFrom(ErrTxCancelled)
+		if err = ec.txStore.UpdateEthTxUnstartedToCancelled(&etx, pg.WithParentCtx(ctx)); err != nil {
+			return errors.Wrap(err, "CancelTransaction failed")
+		}
+		lggr.Infow("Cancelled unstarted transaction")
+		ec.resumeCancelledTaskRun(lggr, etx)
+		return nil
+	case EthTxUnconfirmed:
+	default:
+		return errors.Errorf("cannot cancel eth_tx %d in state %s", etx.ID, etx.State)
+	}
+
+	pipelineTaskRunID := etx.PipelineTaskRunID
+	etx.ToAddress = etx.FromAddress
+	etx.EncodedPayload = []byte{}
+	etx.Value = *big.NewInt(0)
+	attempt, err := ec.bumpGas(ctx, etx, etx.TxAttempts)
+	if err != nil {
+		return errors.Wrap(err, "CancelTransaction failed to build cancellation attempt")
+	}
+	if err = ec.txStore.SaveCancellationInProgressAttempt(&etx, &attempt, pg.WithParentCtx(ctx)); err != nil {
+		return errors.Wrap(err, "CancelTransaction failed")
+	}
+	lggr.Infow("Cancelling transaction with zero-value self-send", "txHash", attempt.Hash, "fee", attempt.Fee())
+
+	etx.PipelineTaskRunID = pipelineTaskRunID
+	ec.resumeCancelledTaskRun(lggr, etx)
+
+	return ec.handleInProgressAttempt(ctx, lggr, etx, attempt, 0)
+}
+
+// ReplaceTransaction rebroadcasts the given unconfirmed transaction with the same payload and
+// sequence at the given fee. The fee must exceed the highest existing attempt by at least FeeBumpPercent.
+// Must not be called while the EthConfirmer is running.
+func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) ReplaceTransaction(ctx context.Context, etxID int64, fee FEE) error {
+	etx, err := ec.findTxForReplacement(etxID)
+	if err != nil {
+		return errors.Wrap(err, "ReplaceTransaction failed")
+	}
+	if etx.State != EthTxUnconfirmed {
+		return errors.Errorf("cannot replace eth_tx %d in state %s", etx.ID, etx.State)
+	}
+	lggr := etx.GetLogger(ec.lggr)
+
+	previousAttempt := etx.TxAttempts[0]
+	if err = validateReplacementFee(previousAttempt.Fee(), fee, ec.config.FeeBumpPercent()); err != nil {
+		return errors.Wrap(err, "ReplaceTransaction failed")
+	}
+	attempt, _, err := ec.NewCustomTxAttempt(etx, fee, etx.FeeLimit, previousAttempt.TxType, lggr)
+	if err != nil {
+		return errors.Wrap(err, "ReplaceTransaction failed to build replacement attempt")
+	}
+	if err = ec.txStore.SaveInProgressAttempt(&attempt); err != nil {
+		return errors.Wrap(err, "ReplaceTransaction failed")
+	}
+	lggr.Infow("Replacing transaction", "txHash", attempt.Hash, "fee", attempt.Fee(), "previousFee", previousAttempt.Fee())
+
+	return ec.handleInProgressAttempt(ctx, lggr, etx, attempt, 0)
+}
+
+func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) findTxForReplacement(etxID int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD], err error) {
+	etx, err = ec.txStore.FindEthTxWithAttempts(etxID)
+	if err != nil {
+		return etx, err
+	}
+	if etx.ChainID.String() != ec.chainID.String() {
+		return etx, errors.Errorf("eth_tx %d belongs to chain %s, not %s", etx.ID, etx.ChainID.String(), ec.chainID.String())
+	}
+	if etx.State == EthTxUnconfirmed && len(etx.TxAttempts) == 0 {
+		return etx, errors.Errorf("invariant violation: eth_tx %d was unconfirmed but didn't have any attempts", etx.ID)
+	}
+	return etx, nil
+}
+
+func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) resumeCancelledTaskRun(lggr logger.Logger, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD]) {
+	if !etx.PipelineTaskRunID.Valid || ec.resumeCallback == nil {
+		return
+	}
+	if err := ec.resumeCallback(etx.PipelineTaskRunID.UUID, nil, errors.New(ErrTxCancelled)); err != nil {
+		lggr.Errorw("Failed to resume pipeline run for cancelled transaction", "pipelineTaskRunID", etx.PipelineTaskRunID.UUID, "err", err)
+	}
+}
+
+// validateReplacementFee checks that a manually supplied fee is high enough to replace the previous attempt in the mempool
+func validateReplacementFee[FEE txmgrtypes.Fee](previous, replacement FEE, feeBumpPercent uint16) error {
+	prevFee, err := ToGethFees(previous)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert previous fee")
+	}
+	newFee, err := ToGethFees(replacement)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert replacement fee")
+	}
+	if prevFee.Legacy != nil {
+		if newFee.Legacy == nil {
+			return errors.New("replacement fee must be a legacy gas price")
+		}
+		if minPrice := prevFee.Legacy.AddPercentage(feeBumpPercent); newFee.Legacy.Cmp(minPrice) < 0 {
+			return errors.Errorf("replacement gas price %s must be at least %s", newFee.Legacy, minPrice)
+		}
+		return nil
+	}
+	if !newFee.ValidDynamic() {
+		return errors.New("replacement fee must be a dynamic fee")
+	}
+	if minTipCap := prevFee.DynamicTipCap.AddPercentage(feeBumpPercent); newFee.DynamicTipCap.Cmp(minTipCap) < 0 {
+		return errors.Errorf("replacement tip cap %s must be at least %s", newFee.DynamicTipCap, minTipCap)
+	}
+	if minFeeCap := prevFee.DynamicFeeCap.AddPercentage(feeBumpPercent); newFee.DynamicFeeCap.Cmp(minFeeCap) < 0 {
+		return errors.Errorf("replacement fee cap %s must be at least %s", newFee.DynamicFeeCap, minFeeCap)
+	}
+	return nil
+}
+
 // ResumePendingTaskRuns issues callbacks to task runs that are pending waiting for receipts
 func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) ResumePendingTaskRuns(ctx context.Context, head txmgrtypes.Head) error {
 
@@ -2895,6 +2895,132 @@ func TestEthConfirmer_ForceRebroadcast(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_CancelTransaction(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	txStore := cltest.NewTxStore(t, db, cfg)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	config := newTestChainScopedConfig(t)
+
+	t.Run("marks unstarted eth_tx as fatally errored", func(t *testing.T) {
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		ec, err := cltest.NewEthConfirmer(t, txStore, ethClient, config, ethKeyStore, nil)
+		require.NoError(t, err)
+
+		etx := cltest.NewEthTx(t, fromAddress)
+		require.NoError(t, txStore.InsertEthTx(&etx))
+
+		require.NoError(t, ec.CancelTransaction(testutils.Context(t), etx.ID))
+
+		etx, err = txStore.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxFatalError, etx.State)
+		assert.Equal(t, txmgr.ErrTxCancelled, etx.Error.String)
+	})
+
+	t.Run("replaces unconfirmed eth_tx with zero-value self-send at the same nonce", func(t *testing.T) {
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		ec, err := cltest.NewEthConfirmer(t, txStore, ethClient, config, ethKeyStore, nil)
+		require.NoError(t, err)
+
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress)
+		previousGasPrice := etx.TxAttempts[0].TxFee.Legacy
+
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == uint64(*etx.Sequence) &&
+				tx.GasPrice().Cmp(previousGasPrice.ToInt()) > 0 &&
+				tx.Value().Sign() == 0 &&
+				len(tx.Data()) == 0 &&
+				tx.To().String() == fromAddress.String()
+		}), fromAddress).Return(clienttypes.Successful, nil).Once()
+
+		require.NoError(t, ec.CancelTransaction(testutils.Context(t), etx.ID))
+
+		etx, err = txStore.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+		assert.Equal(t, fromAddress, etx.ToAddress)
+		assert.Empty(t, etx.EncodedPayload)
+		assert.Equal(t, int64(0), etx.Value.Int64())
+		require.Len(t, etx.TxAttempts, 2)
+		assert.Equal(t, txmgrtypes.TxAttemptBroadcast, etx.TxAttempts[0].State)
+		assert.True(t, etx.TxAttempts[0].TxFee.Legacy.Cmp(previousGasPrice) > 0)
+	})
+
+	t.Run("refuses to cancel confirmed eth_tx", func(t *testing.T) {
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		ec, err := cltest.NewEthConfirmer(t, txStore, ethClient, config, ethKeyStore, nil)
+		require.NoError(t, err)
+
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 1, 1, fromAddress)
+
+		err = ec.CancelTransaction(testutils.Context(t), etx.ID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot cancel")
+	})
+}
+
+func TestEthConfirmer_ReplaceTransaction(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	txStore := cltest.NewTxStore(t, db, cfg)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	config := newTestChainScopedConfig(t)
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress)
+
+	t.Run("rejects fee below the minimum bump", func(t *testing.T) {
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		ec, err := cltest.NewEthConfirmer(t, txStore, ethClient, config, ethKeyStore, nil)
+		require.NoError(t, err)
+
+		err = ec.ReplaceTransaction(testutils.Context(t), etx.ID, gas.EvmFee{Legacy: etx.TxAttempts[0].TxFee.Legacy})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "replacement gas price")
+	})
+
+	t.Run("rejects dynamic fee for legacy transaction", func(t *testing.T) {
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		ec, err := cltest.NewEthConfirmer(t, txStore, ethClient, config, ethKeyStore, nil)
+		require.NoError(t, err)
+
+		err = ec.ReplaceTransaction(testutils.Context(t), etx.ID, gas.EvmFee{DynamicTipCap: assets.GWei(1), DynamicFeeCap: assets.GWei(100)})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be a legacy gas price")
+	})
+
+	t.Run("rebroadcasts the same payload at the given fee", func(t *testing.T) {
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		ec, err := cltest.NewEthConfirmer(t, txStore, ethClient, config, ethKeyStore, nil)
+		require.NoError(t, err)
+
+		fee := gas.EvmFee{Legacy: assets.GWei(30)}
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == uint64(*etx.Sequence) &&
+				tx.GasPrice().Cmp(fee.Legacy.ToInt()) == 0 &&
+				reflect.DeepEqual(tx.Data(), etx.EncodedPayload) &&
+				tx.To().String() == etx.ToAddress.String()
+		}), fromAddress).Return(clienttypes.Successful, nil).Once()
+
+		require.NoError(t, ec.ReplaceTransaction(testutils.Context(t), etx.ID, fee))
+
+		etx, err = txStore.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.TxAttempts, 2)
+		assert.Equal(t, fee.Legacy.String(), etx.TxAttempts[0].TxFee.Legacy.String())
+		assert.Equal(t, txmgrtypes.TxAttemptBroadcast, etx.TxAttempts[0].State)
+	})
+}
+
 func TestEthConfirmer_ResumePendingRuns(t *testing.T) {
 	t.Parallel()
 
@@ -143,6 +143,7 @@ type DbEthTx struct {
 	// at send time.
 	Meta              *datatypes.JSON
 	Subject           uuid.NullUUID
+	Priority          txmgrtypes.TxPriority
 	PipelineTaskRunID uuid.NullUUID
 	MinConfirmations  null.Uint32
 	EVMChainID        utils.Big
@@ -170,6 +171,7 @@ func DbEthTxFromEthTx(ethTx *EvmTx) DbEthTx {
 		State:              ethTx.State,
 		Meta:               ethTx.Meta,
 		Subject:            ethTx.Subject,
+		Priority:           ethTx.Priority,
 		PipelineTaskRunID:  ethTx.PipelineTaskRunID,
 		MinConfirmations:   ethTx.MinConfirmations,
 		AccessList:         ethTx.AdditionalParameters,
@@ -197,6 +199,7 @@ func DbEthTxToEthTx(dbEthTx DbEthTx, evmEthTx *EvmTx) {
 	evmEthTx.State = dbEthTx.State
 	evmEthTx.Meta = dbEthTx.Meta
 	evmEthTx.Subject = dbEthTx.Subject
+	evmEthTx.Priority = dbEthTx.Priority
 	evmEthTx.PipelineTaskRunID = dbEthTx.PipelineTaskRunID
 	evmEthTx.MinConfirmations = dbEthTx.MinConfirmations
 	evmEthTx.ChainID = dbEthTx.EVMChainID.ToInt()
@@ -463,8 +466,8 @@ func (o *evmTxStore) InsertEthTx(etx *EvmTx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO eth_txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, access_list, transmit_checker) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :access_list, :transmit_checker
+	const insertEthTxSQL = `INSERT INTO eth_txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, priority, pipeline_task_run_id, min_confirmations, evm_chain_id, access_list, transmit_checker) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :priority, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :access_list, :transmit_checker
 ) RETURNING *`
 	dbTx := DbEthTxFromEthTx(etx)
 	err := o.q.GetNamed(insertEthTxSQL, &dbTx, &dbTx)
@@ -1214,11 +1217,59 @@ func (o *evmTxStore) SaveReplacementInProgressAttempt(oldAttempt EvmTxAttempt, r
 	})
 }
 
-// Finds earliest saved transaction that has yet to be broadcast from the given address
+// SaveCancellationInProgressAttempt rewrites an unconfirmed eth_tx as a zero-value self-send and
+// inserts the in_progress attempt that will replace it on-chain.
+// The pipeline_task_run_id is cleared since the cancelled run must not be resumed on confirmation.
+func (o *evmTxStore) SaveCancellationInProgressAttempt(etx *EvmTx, attempt *EvmTxAttempt, qopts ...pg.QOpt) error {
+	qq := o.q.WithOpts(qopts...)
+	if etx.State != EthTxUnconfirmed {
+		return pkgerrors.Errorf("can only cancel unconfirmed transactions, transaction is currently %s", etx.State)
+	}
+	if attempt.State != txmgrtypes.TxAttemptInProgress {
+		return errors.New("SaveCancellationInProgressAttempt failed: attempt state must be in_progress")
+	}
+	return qq.Transaction(func(tx pg.Queryer) error {
+		dbEtx := DbEthTxFromEthTx(etx)
+		if err := tx.Get(&dbEtx, `UPDATE eth_txes SET to_address=$1, value=$2, encoded_payload=$3, pipeline_task_run_id=NULL WHERE id=$4 AND state='unconfirmed' RETURNING *`, dbEtx.ToAddress, dbEtx.Value, dbEtx.EncodedPayload, dbEtx.ID); err != nil {
+			return pkgerrors.Wrap(err, "SaveCancellationInProgressAttempt failed to update eth_tx")
+		}
+		DbEthTxToEthTx(dbEtx, etx)
+		dbAttempt := DbEthTxAttemptFromEthTxAttempt(attempt)
+		query, args, e := tx.BindNamed(insertIntoEthTxAttemptsQuery, &dbAttempt)
+		if e != nil {
+			return pkgerrors.Wrap(e, "SaveCancellationInProgressAttempt failed to BindNamed")
+		}
+		e = tx.Get(&dbAttempt, query, args...)
+		DbEthTxAttemptToEthTxAttempt(dbAttempt, attempt)
+		return pkgerrors.Wrap(e, "SaveCancellationInProgressAttempt failed to insert cancellation attempt")
+	})
+}
+
+// UpdateEthTxUnstartedToCancelled moves an unstarted eth_tx straight to fatal_error with the error set on etx
+func (o *evmTxStore) UpdateEthTxUnstartedToCancelled(etx *EvmTx, qopts ...pg.QOpt) error {
+	qq := o.q.WithOpts(qopts...)
+	if etx.State != EthTxUnstarted {
+		return pkgerrors.Errorf("can only cancel unstarted transactions, transaction is currently %s", etx.State)
+	}
+	if !etx.Error.Valid {
+		return errors.New("expected error field to be set")
+	}
+	var dbEtx DbEthTx
+	err := qq.Get(&dbEtx, `UPDATE eth_txes SET state='fatal_error', error=$1 WHERE id=$2 AND state='unstarted' RETURNING *`, etx.Error, etx.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return pkgerrors.Errorf("eth_tx %d is no longer unstarted", etx.ID)
+	} else if err != nil {
+		return pkgerrors.Wrap(err, "UpdateEthTxUnstartedToCancelled failed")
+	}
+	DbEthTxToEthTx(dbEtx, etx)
+	return nil
+}
+
+// Finds highest priority, earliest saved transaction that has yet to be broadcast from the given address
 func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(etx *EvmTx, fromAddress common.Address, chainID *big.Int, qopts ...pg.QOpt) error {
 	qq := o.q.WithOpts(qopts...)
 	var dbEtx DbEthTx
-	err := qq.Get(&dbEtx, `SELECT * FROM eth_txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
+	err := qq.Get(&dbEtx, `SELECT * FROM eth_txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY priority DESC, value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
 	DbEthTxToEthTx(dbEtx, etx)
 	return pkgerrors.Wrap(err, "failed to FindNextUnstartedTransactionFromAddress")
 }
@@ -1445,6 +1496,25 @@ func (o *evmTxStore) CountUnstartedTransactions(fromAddress common.Address, chai
 	return o.countTransactionsWithState(fromAddress, EthTxUnstarted, chainID, qopts...)
 }
 
+// CountUnstartedTransactionsByPriority returns the number of unstarted transactions for each priority class
+func (o *evmTxStore) CountUnstartedTransactionsByPriority(fromAddress common.Address, chainID *big.Int, qopts ...pg.QOpt) (counts map[txmgrtypes.TxPriority]uint32, err error) {
+	qq := o.q.WithOpts(qopts...)
+	var rows []struct {
+		Priority txmgrtypes.TxPriority
+		Count    uint32
+	}
+	err = qq.Select(&rows, `SELECT priority, count(*) FROM eth_txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 GROUP BY priority`,
+		fromAddress, chainID.String())
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to CountUnstartedTransactionsByPriority")
+	}
+	counts = make(map[txmgrtypes.TxPriority]uint32, len(rows))
+	for _, r := range rows {
+		counts[r.Priority] = r.Count
+	}
+	return counts, nil
+}
+
 func (o *evmTxStore) CheckEthTxQueueCapacity(fromAddress common.Address, maxQueuedTransactions uint64, chainID *big.Int, qopts ...pg.QOpt) (err error) {
 	qq := o.q.WithOpts(qopts...)
 	if maxQueuedTransactions == 0 {
@@ -1481,12 +1551,12 @@ func (o *evmTxStore) CreateEthTransaction(newTx EvmNewTx, chainID *big.Int, qopt
 			}
 		}
 		err = tx.Get(&dbEtx, `
-INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker)
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, priority, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12
 )
 RETURNING "eth_txes".*
-`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.FeeLimit, newTx.Meta, newTx.Strategy.Subject(), chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Checker)
+`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.FeeLimit, newTx.Meta, newTx.Strategy.Subject(), newTx.Strategy.Priority(), chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Checker)
 		if err != nil {
 			return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert eth_tx")
 		}
@@ -1504,6 +1574,28 @@ RETURNING "eth_txes".*
 	return etx, err
 }
 
+// EvictLowerPriorityUnstartedTransaction deletes the oldest of the lowest priority unstarted
+// transactions from the given address, provided it has a lower priority than the given one.
+// Transactions created by a pipeline task run are never evicted, since the suspended run would
+// otherwise wait for a transaction that no longer exists.
+// It returns the number of transactions deleted, which is either 0 or 1.
+func (o *evmTxStore) EvictLowerPriorityUnstartedTransaction(fromAddress common.Address, priority txmgrtypes.TxPriority, chainID *big.Int, qopts ...pg.QOpt) (n int64, err error) {
+	qq := o.q.WithOpts(qopts...)
+	res, err := qq.Exec(`
+DELETE FROM eth_txes
+WHERE id = (
+	SELECT id FROM eth_txes
+	WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 AND priority < $3 AND pipeline_task_run_id IS NULL
+	ORDER BY priority ASC, created_at ASC, id ASC
+	LIMIT 1
+	FOR UPDATE SKIP LOCKED
+)`, fromAddress, chainID.String(), priority)
+	if err != nil {
+		return 0, pkgerrors.Wrap(err, "EvictLowerPriorityUnstartedTransaction failed")
+	}
+	return res.RowsAffected()
+}
+
 func (o *evmTxStore) PruneUnstartedTxQueue(queueSize uint32, subject uuid.UUID, qopts ...pg.QOpt) (n int64, err error) {
 	qq := o.q.WithOpts(qopts...)
 	err = qq.Transaction(func(tx pg.Queryer) error {
@@ -1145,6 +1145,18 @@ func TestORM_FindNextUnstartedTransactionFromAddress(t *testing.T) {
 		err := txStore.FindNextUnstartedTransactionFromAddress(resultEtx, fromAddress, ethClient.ConfiguredChainID())
 		require.NoError(t, err)
 	})
+
+	t.Run("finds highest priority unstarted tx first", func(t *testing.T) {
+		cltest.MustInsertUnstartedEthTx(t, txStore, fromAddress, txmgrtypes.TxPriorityLow)
+		etxHigh := cltest.MustInsertUnstartedEthTx(t, txStore, fromAddress, txmgrtypes.TxPriorityHigh)
+		cltest.MustInsertUnstartedEthTx(t, txStore, fromAddress, txmgrtypes.TxPriorityHigh)
+
+		resultEtx := new(txmgr.EvmTx)
+		err := txStore.FindNextUnstartedTransactionFromAddress(resultEtx, fromAddress, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		assert.Equal(t, etxHigh.ID, resultEtx.ID)
+		assert.Equal(t, txmgrtypes.TxPriorityHigh, resultEtx.Priority)
+	})
 }
 
 func TestORM_UpdateEthTxFatalError(t *testing.T) {
@@ -1405,6 +1417,94 @@ func TestORM_CountUnstartedTransactions(t *testing.T) {
 	assert.Equal(t, int(count), 2)
 }
 
+func TestORM_CountUnstartedTransactionsByPriority(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	txStore := cltest.NewTxStore(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	_, otherAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	cltest.MustInsertUnstartedEthTx(t, txStore, fromAddress)
+	cltest.MustInsertUnstartedEthTx(t, txStore, fromAddress, txmgrtypes.TxPriorityHigh)
+	cltest.MustInsertUnstartedEthTx(t, txStore, fromAddress, txmgrtypes.TxPriorityHigh)
+	cltest.MustInsertUnstartedEthTx(t, txStore, otherAddress, txmgrtypes.TxPriorityLow)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 2, fromAddress)
+
+	counts, err := txStore.CountUnstartedTransactionsByPriority(fromAddress, &cltest.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, map[txmgrtypes.TxPriority]uint32{
+		txmgrtypes.TxPriorityNormal: 1,
+		txmgrtypes.TxPriorityHigh:   2,
+	}, counts)
+}
+
+func TestORM_EvictLowerPriorityUnstartedTransaction(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	txStore := cltest.NewTxStore(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	_, otherAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+
+	etxNormal := cltest.MustInsertUnstartedEthTx(t, txStore, fromAddress)
+	etxLow1 := cltest.MustInsertUnstartedEthTx(t, txStore, fromAddress, txmgrtypes.TxPriorityLow)
+	etxLow2 := cltest.MustInsertUnstartedEthTx(t, txStore, fromAddress, txmgrtypes.TxPriorityLow)
+	cltest.MustInsertUnstartedEthTx(t, txStore, otherAddress, txmgrtypes.TxPriorityLow)
+
+	t.Run("does not evict transactions of equal priority", func(t *testing.T) {
+		n, err := txStore.EvictLowerPriorityUnstartedTransaction(fromAddress, txmgrtypes.TxPriorityLow, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), n)
+		cltest.AssertCount(t, db, "eth_txes", 4)
+	})
+
+	t.Run("evicts the oldest of the lowest priority transactions", func(t *testing.T) {
+		n, err := txStore.EvictLowerPriorityUnstartedTransaction(fromAddress, txmgrtypes.TxPriorityHigh, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+
+		_, err = txStore.FindEthTxWithAttempts(etxLow1.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		_, err = txStore.FindEthTxWithAttempts(etxLow2.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("evicts normal priority transactions once there are no low priority ones left", func(t *testing.T) {
+		n, err := txStore.EvictLowerPriorityUnstartedTransaction(fromAddress, txmgrtypes.TxPriorityNormal, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+		n, err = txStore.EvictLowerPriorityUnstartedTransaction(fromAddress, txmgrtypes.TxPriorityNormal, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), n)
+
+		n, err = txStore.EvictLowerPriorityUnstartedTransaction(fromAddress, txmgrtypes.TxPriorityHigh, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+		_, err = txStore.FindEthTxWithAttempts(etxNormal.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("does not evict transactions of a pipeline task run", func(t *testing.T) {
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		etx := cltest.MustInsertUnstartedEthTx(t, txStore, fromAddress, txmgrtypes.TxPriorityLow)
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET pipeline_task_run_id = $1 WHERE id = $2`, &tr.ID, etx.ID)
+
+		n, err := txStore.EvictLowerPriorityUnstartedTransaction(fromAddress, txmgrtypes.TxPriorityHigh, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), n)
+		_, err = txStore.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+	})
+}
+
 func TestORM_CheckEthTxQueueCapacity(t *testing.T) {
 	t.Parallel()
 
@@ -1516,6 +1616,7 @@ func TestORM_CreateEthTransaction(t *testing.T) {
 		subject := uuid.New()
 		strategy := newMockTxStrategy(t)
 		strategy.On("Subject").Return(uuid.NullUUID{UUID: subject, Valid: true})
+		strategy.On("Priority").Return(txmgrtypes.TxPriorityNormal)
 		strategy.On("PruneQueue", mock.AnythingOfType("*txmgr.evmTxStore"), mock.AnythingOfType("pg.QOpt")).Return(int64(0), nil)
 		etx, err := txStore.CreateEthTransaction(txmgr.EvmNewTx{
 			FromAddress:    fromAddress,
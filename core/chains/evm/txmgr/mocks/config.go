@@ -130,6 +130,36 @@ func (_m *Config) ChainType() config.ChainType {
 	return r0
 }
 
+// CompositeEstimatorMaxDeviationPercent provides a mock function with given fields:
+func (_m *Config) CompositeEstimatorMaxDeviationPercent() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// CompositeEstimatorModes provides a mock function with given fields:
+func (_m *Config) CompositeEstimatorModes() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // DatabaseDefaultQueryTimeout provides a mock function with given fields:
 func (_m *Config) DatabaseDefaultQueryTimeout() time.Duration {
 	ret := _m.Called()
@@ -480,6 +510,48 @@ func (_m *Config) EvmUseForwarders() bool {
 	return r0
 }
 
+// FeeHistoryEstimatorBaseFeeBufferBlocks provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorBaseFeeBufferBlocks() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorBlockCount provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorBlockCount() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorRewardPercentile provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorRewardPercentile() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // GasEstimatorMode provides a mock function with given fields:
 func (_m *Config) GasEstimatorMode() string {
 	ret := _m.Called()
@@ -25,6 +25,20 @@ type TxManager[CHAIN_ID types.ID, HEAD types.Head, ADDR commontypes.Hashable, TX
 	mock.Mock
 }
 
+// CancelTransaction provides a mock function with given fields: etxID
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD]) CancelTransaction(etxID int64) error {
+	ret := _m.Called(etxID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(etxID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Close provides a mock function with given fields:
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD]) Close() error {
 	ret := _m.Called()
@@ -148,6 +162,20 @@ func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD]) Reg
 	_m.Called(fn)
 }
 
+// ReplaceTransaction provides a mock function with given fields: etxID, fee
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD]) ReplaceTransaction(etxID int64, fee FEE) error {
+	ret := _m.Called(etxID, fee)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, FEE) error); ok {
+		r0 = rf(etxID, fee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Reset provides a mock function with given fields: f, addr, abandon
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD]) Reset(f func(), addr ADDR, abandon bool) error {
 	ret := _m.Called(f, addr, abandon)
@@ -33,6 +33,9 @@ func NewSendEveryStrategy() txmgrtypes.TxStrategy {
 type SendEveryStrategy struct{}
 
 func (SendEveryStrategy) Subject() uuid.NullUUID { return uuid.NullUUID{} }
+func (SendEveryStrategy) Priority() txmgrtypes.TxPriority {
+	return txmgrtypes.TxPriorityNormal
+}
 func (SendEveryStrategy) PruneQueue(pruneService txmgrtypes.UnstartedTxQueuePruner, qopt pg.QOpt) (int64, error) {
 	return 0, nil
 }
@@ -57,6 +60,10 @@ func (s DropOldestStrategy) Subject() uuid.NullUUID {
 	return uuid.NullUUID{UUID: s.subject, Valid: true}
 }
 
+func (s DropOldestStrategy) Priority() txmgrtypes.TxPriority {
+	return txmgrtypes.TxPriorityNormal
+}
+
 func (s DropOldestStrategy) PruneQueue(pruneService txmgrtypes.UnstartedTxQueuePruner, qopt pg.QOpt) (n int64, err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), s.queryTimeout)
 
@@ -67,3 +74,27 @@ func (s DropOldestStrategy) PruneQueue(pruneService txmgrtypes.UnstartedTxQueueP
 	}
 	return
 }
+
+var _ txmgrtypes.TxStrategy = PriorityStrategy{}
+
+// PriorityStrategy assigns a priority class to transactions and otherwise
+// defers to the wrapped strategy. Higher priority transactions are broadcast
+// first, and may evict lower priority unstarted transactions from the same
+// address when the queue is full.
+type PriorityStrategy struct {
+	txmgrtypes.TxStrategy
+	priority txmgrtypes.TxPriority
+}
+
+// NewPriorityStrategy wraps the given TxStrategy, assigning the given priority
+// to its transactions. Normal priority returns the strategy unchanged.
+func NewPriorityStrategy(strategy txmgrtypes.TxStrategy, priority txmgrtypes.TxPriority) txmgrtypes.TxStrategy {
+	if priority == txmgrtypes.TxPriorityNormal {
+		return strategy
+	}
+	return PriorityStrategy{strategy, priority}
+}
+
+func (s PriorityStrategy) Priority() txmgrtypes.TxPriority {
+	return s.priority
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	configtest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest/v2"
@@ -20,6 +21,7 @@ func Test_SendEveryStrategy(t *testing.T) {
 	s := txmgr.SendEveryStrategy{}
 
 	assert.Equal(t, uuid.NullUUID{}, s.Subject())
+	assert.Equal(t, txmgrtypes.TxPriorityNormal, s.Priority())
 
 	n, err := s.PruneQueue(nil, nil)
 	assert.NoError(t, err)
@@ -35,6 +37,26 @@ func Test_DropOldestStrategy_Subject(t *testing.T) {
 
 	assert.True(t, s.Subject().Valid)
 	assert.Equal(t, subject, s.Subject().UUID)
+	assert.Equal(t, txmgrtypes.TxPriorityNormal, s.Priority())
+}
+
+func Test_PriorityStrategy(t *testing.T) {
+	t.Parallel()
+	cfg := configtest.NewGeneralConfig(t, nil)
+
+	subject := uuid.New()
+	inner := txmgr.NewDropOldestStrategy(subject, 1, cfg.DatabaseDefaultQueryTimeout())
+
+	t.Run("normal priority returns the wrapped strategy", func(t *testing.T) {
+		s := txmgr.NewPriorityStrategy(inner, txmgrtypes.TxPriorityNormal)
+		assert.Equal(t, inner, s)
+	})
+
+	t.Run("overrides priority and defers to the wrapped strategy", func(t *testing.T) {
+		s := txmgr.NewPriorityStrategy(inner, txmgrtypes.TxPriorityHigh)
+		assert.Equal(t, txmgrtypes.TxPriorityHigh, s.Priority())
+		assert.Equal(t, subject, s.Subject().UUID)
+	})
 }
 
 func Test_DropOldestStrategy_PruneQueue(t *testing.T) {
@@ -54,6 +54,8 @@ type TxManager[
 	RegisterResumeCallback(fn ResumeCallback)
 	SendEther(chainID *big.Int, from, to ADDR, value assets.Eth, gasLimit uint32) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD], err error)
 	Reset(f func(), addr ADDR, abandon bool) error
+	CancelTransaction(etxID int64) error
+	ReplaceTransaction(etxID int64, fee FEE) error
 }
 
 type reset struct {
@@ -226,6 +228,49 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) Reset
 	return err
 }
 
+// CancelTransaction stops EthBroadcaster/EthConfirmer, cancels the given
+// transaction, then starts them again.
+// An unstarted transaction is marked fatally errored. An unconfirmed
+// transaction is replaced on-chain by a zero-value self-send with the same
+// nonce, so that other pending transactions for the key are left untouched.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) CancelTransaction(etxID int64) error {
+	return b.runStopped(func(ctx context.Context) error {
+		return b.ethConfirmer.CancelTransaction(ctx, etxID)
+	})
+}
+
+// ReplaceTransaction stops EthBroadcaster/EthConfirmer, rebroadcasts the
+// given unconfirmed transaction at the given fee, then starts them again.
+// The fee must be at least FeeBumpPercent higher than the current highest
+// attempt for the replacement to be accepted by the mempool.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) ReplaceTransaction(etxID int64, fee FEE) error {
+	return b.runStopped(func(ctx context.Context) error {
+		return b.ethConfirmer.ReplaceTransaction(ctx, etxID, fee)
+	})
+}
+
+// runStopped executes fn between stopping and starting EthBroadcaster and
+// EthConfirmer, returning the error from fn
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) runStopped(fn func(ctx context.Context) error) (err error) {
+	ok := b.IfStarted(func() {
+		ctx, cancel := utils.StopChan(b.chStop).NewCtx()
+		defer cancel()
+		done := make(chan error)
+		f := func() {
+			err = fn(ctx)
+		}
+
+		b.reset <- reset{f, done}
+		if resetErr := <-done; resetErr != nil {
+			err = resetErr
+		}
+	})
+	if !ok {
+		return errors.New("not started")
+	}
+	return err
+}
+
 // abandon, scoped to the key of this txm:
 // - marks all pending and inflight transactions fatally errored (note: at this point all transactions are either confirmed or fatally errored)
 // this must not be run while EthBroadcaster or EthConfirmer are running
@@ -471,7 +516,12 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) Creat
 
 	err = b.txStore.CheckEthTxQueueCapacity(newTx.FromAddress, b.config.MaxQueuedTransactions(), b.chainID, qs...)
 	if err != nil {
-		return tx, errors.Wrap(err, "Txm#CreateEthTransaction")
+		// A full queue may still accept this tx if there is a lower priority one to make room
+		evicted, evictErr := b.txStore.EvictLowerPriorityUnstartedTransaction(newTx.FromAddress, newTx.Strategy.Priority(), b.chainID, qs...)
+		if evictErr != nil || evicted == 0 {
+			return tx, errors.Wrap(err, "Txm#CreateEthTransaction")
+		}
+		b.logger.Warnw("Transaction queue full; evicted lower priority unstarted transaction", "fromAddress", newTx.FromAddress, "priority", newTx.Strategy.Priority())
 	}
 
 	tx, err = b.txStore.CreateEthTransaction(newTx, b.chainID, qs...)
@@ -613,6 +663,16 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD])
 	return nil
 }
 
+// CancelTransaction does nothing, null functionality
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD]) CancelTransaction(etxID int64) error {
+	return errors.New(n.ErrMsg)
+}
+
+// ReplaceTransaction does nothing, null functionality
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD]) ReplaceTransaction(etxID int64, fee FEE) error {
+	return errors.New(n.ErrMsg)
+}
+
 // SendEther does nothing, null functionality
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD]) SendEther(chainID *big.Int, from, to ADDR, value assets.Eth, gasLimit uint32) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, FEE, ADD], err error) {
 	return etx, errors.New(n.ErrMsg)
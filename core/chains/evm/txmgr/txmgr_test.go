@@ -2,6 +2,7 @@ package txmgr_test
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/smartcontractkit/sqlx"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	commontxmmocks "github.com/smartcontractkit/chainlink/v2/common/txmgr/types/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/builder"
@@ -117,6 +119,7 @@ func TestTxm_CreateEthTransaction(t *testing.T) {
 		subject := uuid.New()
 		strategy := newMockTxStrategy(t)
 		strategy.On("Subject").Return(uuid.NullUUID{UUID: subject, Valid: true})
+		strategy.On("Priority").Return(txmgrtypes.TxPriorityNormal)
 		strategy.On("PruneQueue", mock.Anything, mock.AnythingOfType("pg.QOpt")).Return(int64(0), nil)
 		config.On("EvmMaxQueuedTransactions").Return(uint64(1)).Once()
 		etx, err := txm.CreateEthTransaction(txmgr.EvmNewTx{
@@ -371,6 +374,60 @@ func newMockConfig(t *testing.T) *txmmocks.Config {
 	return cfg
 }
 
+func TestTxm_CreateEthTransaction_Priority(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	txStore := cltest.NewTxStore(t, db, cfg)
+	kst := cltest.NewKeyStore(t, db, cfg)
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, kst.Eth(), 0)
+
+	config := newMockConfig(t)
+	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
+	config.On("EthTxReaperThreshold").Return(time.Duration(0))
+	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("LogSQL").Return(false)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	txm, err := makeTestEvmTxm(t, db, ethClient, config, kst.Eth(), nil)
+	require.NoError(t, err)
+
+	etxLow := cltest.MustInsertUnstartedEthTx(t, txStore, fromAddress, txmgrtypes.TxPriorityLow)
+
+	t.Run("with queue at capacity does not evict eth_tx of equal priority", func(t *testing.T) {
+		config.On("EvmMaxQueuedTransactions").Return(uint64(1)).Once()
+		_, err := txm.CreateEthTransaction(txmgr.EvmNewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			FeeLimit:       21000,
+			Strategy:       txmgr.NewPriorityStrategy(txmgr.NewSendEveryStrategy(), txmgrtypes.TxPriorityLow),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "too many unstarted transactions in the queue (1/1)")
+		cltest.AssertCount(t, db, "eth_txes", 1)
+	})
+
+	t.Run("with queue at capacity evicts lower priority eth_tx", func(t *testing.T) {
+		config.On("EvmMaxQueuedTransactions").Return(uint64(1)).Once()
+		etx, err := txm.CreateEthTransaction(txmgr.EvmNewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			FeeLimit:       21000,
+			Strategy:       txmgr.NewPriorityStrategy(txmgr.NewSendEveryStrategy(), txmgrtypes.TxPriorityHigh),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, txmgrtypes.TxPriorityHigh, etx.Priority)
+		cltest.AssertCount(t, db, "eth_txes", 1)
+
+		_, err = txStore.FindEthTxWithAttempts(etxLow.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
 func TestTxm_CreateEthTransaction_OutOfEth(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	cfg := configtest.NewGeneralConfig(t, nil)
@@ -402,6 +459,7 @@ func TestTxm_CreateEthTransaction_OutOfEth(t *testing.T) {
 		cltest.MustInsertUnconfirmedEthTxWithInsufficientEthAttempt(t, txStore, 0, otherKey.Address)
 		strategy := newMockTxStrategy(t)
 		strategy.On("Subject").Return(uuid.NullUUID{})
+		strategy.On("Priority").Return(txmgrtypes.TxPriorityNormal)
 		strategy.On("PruneQueue", mock.Anything, mock.AnythingOfType("pg.QOpt")).Return(int64(0), nil)
 
 		etx, err := txm.CreateEthTransaction(txmgr.EvmNewTx{
@@ -425,6 +483,7 @@ func TestTxm_CreateEthTransaction_OutOfEth(t *testing.T) {
 		cltest.MustInsertUnconfirmedEthTxWithInsufficientEthAttempt(t, txStore, 0, thisKey.Address)
 		strategy := newMockTxStrategy(t)
 		strategy.On("Subject").Return(uuid.NullUUID{})
+		strategy.On("Priority").Return(txmgrtypes.TxPriorityNormal)
 		strategy.On("PruneQueue", mock.Anything, mock.AnythingOfType("pg.QOpt")).Return(int64(0), nil)
 
 		etx, err := txm.CreateEthTransaction(txmgr.EvmNewTx{
@@ -446,6 +505,7 @@ func TestTxm_CreateEthTransaction_OutOfEth(t *testing.T) {
 		cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 42, thisKey.Address)
 		strategy := newMockTxStrategy(t)
 		strategy.On("Subject").Return(uuid.NullUUID{})
+		strategy.On("Priority").Return(txmgrtypes.TxPriorityNormal)
 		strategy.On("PruneQueue", mock.Anything, mock.AnythingOfType("pg.QOpt")).Return(int64(0), nil)
 
 		config.On("EvmMaxQueuedTransactions").Return(uint64(1))
@@ -40,6 +40,13 @@ type Head struct {
 	StateRoot        common.Hash
 	Difficulty       *utils.Big
 	TotalDifficulty  *utils.Big
+	// LatestFinalizedHead is the latest block which the RPC node reported as
+	// finalized when this head was received. It is only set if the chain has
+	// EVM.FinalityTagEnabled, and it is not persisted.
+	LatestFinalizedHead *Head
+	// LatestSafeHead is the latest block which the RPC node reported as safe,
+	// see LatestFinalizedHead.
+	LatestSafeHead *Head
 }
 
 var _ txmgrtypes.Head = &Head{}
@@ -18,6 +18,7 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -43,6 +44,29 @@ func initAdminSubCmds(client *Client) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:  "keystore",
+			Usage: "Manage the keystore",
+			Subcommands: cli.Commands{
+				{
+					Name:   "rotate-password",
+					Usage:  "Re-encrypt every key in the keystore with a new password",
+					Action: client.RotateKeystorePassword,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "old-password",
+							Usage:    "text file holding the current keystore password",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:     "new-password",
+							Usage:    "text file holding the new keystore password",
+							Required: true,
+						},
+					},
+				},
+			},
+		},
 		{
 			Name:   "logout",
 			Usage:  "Delete any local sessions",
@@ -294,6 +318,47 @@ func (cli *Client) DeleteUser(c *cli.Context) (err error) {
 	return cli.renderAPIResponse(response, &AdminUsersPresenter{}, "Successfully deleted API user")
 }
 
+// RotateKeystorePassword re-encrypts the keystore of the node with a new
+// password
+func (cli *Client) RotateKeystorePassword(c *cli.Context) (err error) {
+	oldPassword, err := utils.PasswordFromFile(c.String("old-password"))
+	if err != nil {
+		return cli.errorOut(fmt.Errorf("could not read old password file: %w", err))
+	}
+	newPassword, err := utils.PasswordFromFile(c.String("new-password"))
+	if err != nil {
+		return cli.errorOut(fmt.Errorf("could not read new password file: %w", err))
+	}
+
+	requestData, err := json.Marshal(web.RotateKeystorePasswordRequest{
+		OldPassword: oldPassword,
+		NewPassword: newPassword,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Patch("/v2/keystore/password", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusNoContent:
+		fmt.Println("Keystore password rotated. Update the keystore password in the secrets (Password.Keystore) before restarting the node.")
+	case http.StatusConflict:
+		return cli.errorOut(errors.New("old keystore password did not match"))
+	default:
+		return cli.printResponseBody(resp)
+	}
+	return nil
+}
+
 // Status will display the health of various services
 func (cli *Client) Status(c *cli.Context) error {
 	resp, err := cli.HTTP.Get("/health?full=1", nil)
@@ -5,6 +5,8 @@ import (
 	"flag"
 	"fmt"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -154,6 +156,29 @@ func TestClient_ListUsers(t *testing.T) {
 	assert.Contains(t, output, user.UpdatedAt.String())
 }
 
+func TestClient_RotateKeystorePassword(t *testing.T) {
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewClientAndRenderer()
+
+	newPasswordFile := filepath.Join(t.TempDir(), "new-password.txt")
+	require.NoError(t, os.WriteFile(newPasswordFile, []byte("n3wP4SsW0rD1!@#_\n"), 0600))
+
+	set := flag.NewFlagSet("test", 0)
+	cltest.FlagSetApplyFromAction(client.RotateKeystorePassword, set, "")
+	require.NoError(t, set.Set("old-password", "../internal/fixtures/correct_password.txt"))
+	require.NoError(t, set.Set("new-password", newPasswordFile))
+
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.RotateKeystorePassword(c))
+	require.NoError(t, app.KeyStore.RotatePassword("n3wP4SsW0rD1!@#_", cltest.Password))
+
+	require.NoError(t, set.Set("old-password", newPasswordFile))
+	assert.ErrorContains(t, client.RotateKeystorePassword(c), "old keystore password did not match")
+
+	require.NoError(t, set.Set("old-password", "does-not-exist.txt"))
+	assert.ErrorContains(t, client.RotateKeystorePassword(c), "could not read old password file")
+}
+
 func TestAdminUsersPresenter_RenderTable(t *testing.T) {
 	user := sessions.User{
 		Email:     "foo@bar.com",
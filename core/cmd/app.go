@@ -239,6 +239,16 @@ func NewApp(client *Client) *cli.App {
 			Usage:       "Commands for managing forwarder addresses.",
 			Subcommands: initFowardersSubCmds(client),
 		},
+		{
+			Name:        "functions",
+			Usage:       "Commands for inspecting Functions requests",
+			Subcommands: initFunctionsSubCmds(client),
+		},
+		{
+			Name:        "job-proposals",
+			Usage:       "Commands for inspecting job proposals from the Feeds Manager",
+			Subcommands: initJobProposalsSubCmds(client),
+		},
 	}...)
 	return app
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/urfave/cli"
 	clipkg "github.com/urfave/cli"
 	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
 func initBlocksSubCmds(client *Client) []cli.Command {
@@ -35,6 +37,18 @@ func initBlocksSubCmds(client *Client) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "reorgs",
+			Usage:  "Lists the latest reorgs of the longest chain",
+			Action: client.IndexReorgs,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:     "evm-chain-id",
+					Usage:    "Chain ID of the EVM-based blockchain",
+					Required: false,
+				},
+			},
+		},
 	}
 }
 
@@ -76,3 +90,68 @@ func (cli *Client) ReplayFromBlock(c *clipkg.Context) (err error) {
 	fmt.Println("Replay started")
 	return nil
 }
+
+// ReorgPresenter wraps the JSONAPI ReorgResource
+type ReorgPresenter struct {
+	JAID
+	presenters.ReorgResource
+}
+
+// ToRow presents the ReorgResource as a slice of strings.
+func (p *ReorgPresenter) ToRow() []string {
+	commonAncestor := ""
+	if p.CommonAncestorNumber != nil && p.CommonAncestorHash != nil {
+		commonAncestor = fmt.Sprintf("%d (%s)", *p.CommonAncestorNumber, p.CommonAncestorHash.Hex())
+	}
+	oldHead, newHead := "", ""
+	if len(p.OldHeads) > 0 {
+		oldHead = fmt.Sprintf("%d (%s)", p.OldHeads[0].Number, p.OldHeads[0].Hash.Hex())
+	}
+	if len(p.NewHeads) > 0 {
+		newHead = fmt.Sprintf("%d (%s)", p.NewHeads[0].Number, p.NewHeads[0].Hash.Hex())
+	}
+	return []string{
+		p.GetID(),
+		p.EVMChainID.String(),
+		strconv.FormatInt(p.Depth, 10),
+		commonAncestor,
+		oldHead,
+		newHead,
+		p.DetectedAt.String(),
+	}
+}
+
+var reorgHeaders = []string{"ID", "EVM Chain ID", "Depth", "Common Ancestor", "Replaced Head", "New Head", "Detected At"}
+
+// ReorgPresenters implements TableRenderer for a slice of ReorgPresenter.
+type ReorgPresenters []ReorgPresenter
+
+// RenderTable implements TableRenderer
+func (ps ReorgPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(reorgHeaders, rows, rt.Writer)
+	return nil
+}
+
+// IndexReorgs lists the reorg history of a chain, latest first
+func (cli *Client) IndexReorgs(c *clipkg.Context) (err error) {
+	v := url.Values{}
+	if c.IsSet("evm-chain-id") {
+		v.Add("evmChainID", fmt.Sprintf("%d", c.Int64("evm-chain-id")))
+	}
+
+	resp, err := cli.HTTP.Get(fmt.Sprintf("/v2/reorgs?%s", v.Encode()))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &ReorgPresenters{})
+}
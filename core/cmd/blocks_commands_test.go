@@ -1,16 +1,22 @@
 package cmd_test
 
 import (
+	"bytes"
 	"flag"
 	"math/big"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
 
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
 func Test_ReplayFromBlock(t *testing.T) {
@@ -42,3 +48,36 @@ func Test_ReplayFromBlock(t *testing.T) {
 	c = cli.NewContext(nil, set, nil)
 	require.NoError(t, client.ReplayFromBlock(c))
 }
+
+func TestReorgPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ancestorNumber int64 = 9
+		ancestorHash         = utils.NewHash()
+		oldHash              = utils.NewHash()
+		newHash              = utils.NewHash()
+		buffer               = bytes.NewBufferString("")
+		r                    = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.ReorgPresenter{
+		JAID: cmd.JAID{ID: "1"},
+		ReorgResource: presenters.ReorgResource{
+			EVMChainID:           *utils.NewBigI(5),
+			CommonAncestorNumber: &ancestorNumber,
+			CommonAncestorHash:   &ancestorHash,
+			OldHeads:             httypes.ReorgHeads{{Number: 10, Hash: oldHash}},
+			NewHeads:             httypes.ReorgHeads{{Number: 11, Hash: newHash}, {Number: 10, Hash: utils.NewHash()}},
+			Depth:                1,
+			DetectedAt:           time.Now(),
+		},
+	}
+
+	require.NoError(t, cmd.ReorgPresenters{p}.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, ancestorHash.Hex())
+	assert.Contains(t, output, "10 ("+oldHash.Hex()+")")
+	assert.Contains(t, output, "11 ("+newHash.Hex()+")")
+}
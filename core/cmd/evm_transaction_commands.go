@@ -61,6 +61,30 @@ func initEVMTxSubCmd(client *Client) cli.Command {
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: client.ShowTransaction,
 			},
+			{
+				Name:   "cancel",
+				Usage:  "Cancel the Ethereum Transaction with attempt hash <txHash>, or ID <id> if it was never broadcast, by replacing it with a zero-value self-send at the same nonce",
+				Action: client.CancelTransaction,
+			},
+			{
+				Name:   "replace",
+				Usage:  "Rebroadcast the unconfirmed Ethereum Transaction with attempt hash <txHash> or ID <id> at a higher fee",
+				Action: client.ReplaceTransaction,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "gas-price",
+						Usage: "gas price for legacy transactions, e.g. 30gwei",
+					},
+					cli.StringFlag{
+						Name:  "gas-tip-cap",
+						Usage: "gas tip cap for EIP-1559 transactions, e.g. 2gwei",
+					},
+					cli.StringFlag{
+						Name:  "gas-fee-cap",
+						Usage: "gas fee cap for EIP-1559 transactions, e.g. 100gwei",
+					},
+				},
+			},
 		},
 	}
 }
@@ -130,6 +154,68 @@ func (cli *Client) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+// CancelTransaction cancels the transaction with the given attempt hash or ID
+func (cli *Client) CancelTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the hash or ID of the transaction"))
+	}
+	hash := c.Args().First()
+	resp, err := cli.HTTP.Post("/v2/transactions/evm/"+hash+"/cancel", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = cli.renderAPIResponse(resp, &EthTxPresenter{})
+	return err
+}
+
+// ReplaceTransaction rebroadcasts the transaction with the given attempt hash or ID at a new fee
+func (cli *Client) ReplaceTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the hash or ID of the transaction"))
+	}
+	hash := c.Args().First()
+
+	var request models.ReplaceEthTxRequest
+	for flag, dst := range map[string]**assets.Wei{
+		"gas-price":   &request.GasPrice,
+		"gas-tip-cap": &request.GasTipCap,
+		"gas-fee-cap": &request.GasFeeCap,
+	} {
+		if !c.IsSet(flag) {
+			continue
+		}
+		w := new(assets.Wei)
+		if err = w.UnmarshalText([]byte(c.String(flag))); err != nil {
+			return cli.errorOut(multierr.Combine(fmt.Errorf("while parsing %s", flag), err))
+		}
+		*dst = w
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/transactions/evm/"+hash+"/replace", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = cli.renderAPIResponse(resp, &EthTxPresenter{})
+	return err
+}
+
 // SendEther transfers ETH from the node's account to a specified address.
 func (cli *Client) SendEther(c *cli.Context) (err error) {
 	if c.NArg() < 3 {
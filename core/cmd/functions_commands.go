@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initFunctionsSubCmds(client *Client) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "requests",
+			Usage:  "List the Functions requests, most recently received first",
+			Action: client.IndexFunctionsRequests,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "page",
+					Usage: "page of results to display",
+				},
+				cli.StringFlag{
+					Name:  "state",
+					Usage: "comma separated request states, e.g. InProgress,ResultReady",
+				},
+				cli.StringFlag{
+					Name:  "subscription-id",
+					Usage: "ID of the subscription which paid for the requests",
+				},
+				cli.StringFlag{
+					Name:  "contract-address",
+					Usage: "address of the oracle contract which received the requests",
+				},
+				cli.StringFlag{
+					Name:  "received-after",
+					Usage: "only list requests received at or after this RFC3339 timestamp",
+				},
+				cli.StringFlag{
+					Name:  "received-before",
+					Usage: "only list requests received before this RFC3339 timestamp",
+				},
+			},
+		},
+	}
+}
+
+// FunctionsRequestPresenter wraps the JSONAPI FunctionsRequestResource
+type FunctionsRequestPresenter struct {
+	JAID
+	presenters.FunctionsRequestResource
+}
+
+// ToRow presents the FunctionsRequestResource as a slice of strings.
+func (p *FunctionsRequestPresenter) ToRow() []string {
+	subscriptionID := ""
+	if p.SubscriptionID != nil {
+		subscriptionID = strconv.FormatUint(*p.SubscriptionID, 10)
+	}
+	latency := ""
+	if p.ConfirmedAt != nil {
+		latency = p.ConfirmedAt.Sub(p.ReceivedAt).String()
+	}
+	return []string{
+		p.GetID(),
+		p.ContractAddress.Hex(),
+		subscriptionID,
+		p.State,
+		p.ReceivedAt.String(),
+		timeOrEmpty(p.ResultReadyAt),
+		timeOrEmpty(p.FinalizedAt),
+		timeOrEmpty(p.ConfirmedAt),
+		timeOrEmpty(p.TimedOutAt),
+		latency,
+	}
+}
+
+func timeOrEmpty(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.String()
+}
+
+var functionsRequestHeaders = []string{"Request ID", "Contract", "Subscription ID", "State", "Received At", "Result Ready At", "Finalized At", "Confirmed At", "Timed Out At", "Fulfillment Latency"}
+
+// FunctionsRequestPresenters implements TableRenderer for a slice of FunctionsRequestPresenter.
+type FunctionsRequestPresenters []FunctionsRequestPresenter
+
+// RenderTable implements TableRenderer
+func (ps FunctionsRequestPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(functionsRequestHeaders, rows, rt.Writer)
+	return nil
+}
+
+// IndexFunctionsRequests lists the Functions requests, taking optional filter
+// and page parameters
+func (cli *Client) IndexFunctionsRequests(c *cli.Context) error {
+	q := url.Values{}
+	for flag, param := range map[string]string{
+		"state":            "state",
+		"subscription-id":  "subscriptionID",
+		"contract-address": "contractAddress",
+		"received-after":   "receivedAfter",
+		"received-before":  "receivedBefore",
+	} {
+		if v := c.String(flag); v != "" {
+			q.Set(param, v)
+		}
+	}
+	return cli.getPage(fmt.Sprintf("/v2/functions/requests?%s", q.Encode()), c.Int("page"), &FunctionsRequestPresenters{})
+}
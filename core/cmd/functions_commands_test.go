@@ -0,0 +1,45 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestFunctionsRequestPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		subscriptionID uint64 = 7
+		receivedAt            = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		confirmedAt           = receivedAt.Add(90 * time.Second)
+		buffer                = bytes.NewBufferString("")
+		r                     = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.FunctionsRequestPresenter{
+		JAID: cmd.JAID{ID: "0x01"},
+		FunctionsRequestResource: presenters.FunctionsRequestResource{
+			ContractAddress: common.HexToAddress("0x2"),
+			SubscriptionID:  &subscriptionID,
+			State:           "Confirmed",
+			ReceivedAt:      receivedAt,
+			ConfirmedAt:     &confirmedAt,
+		},
+	}
+
+	require.NoError(t, cmd.FunctionsRequestPresenters{p}.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "0x01")
+	assert.Contains(t, output, common.HexToAddress("0x2").Hex())
+	assert.Contains(t, output, "Confirmed")
+	assert.Contains(t, output, "1m30s")
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initJobProposalsSubCmds(client *Client) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "diff",
+			Usage:  "Show the changes a job proposal spec makes to the approved spec of its job proposal",
+			Action: client.DiffJobProposalSpec,
+		},
+		{
+			Name:   "dry-run",
+			Usage:  "Validate a job proposal spec for approval, without approving it",
+			Action: client.DryRunJobProposalSpec,
+		},
+	}
+}
+
+// JobProposalSpecDiffPresenter wraps the JSONAPI JobProposalSpecDiffResource
+type JobProposalSpecDiffPresenter struct {
+	JAID
+	presenters.JobProposalSpecDiffResource
+}
+
+var jobProposalSpecChangeHeaders = []string{"Path", "Change", "Old Value", "New Value"}
+
+// RenderTable implements TableRenderer
+func (p *JobProposalSpecDiffPresenter) RenderTable(rt RendererTable) error {
+	approved := "none"
+	if p.ApprovedSpecID != nil {
+		approved = strconv.FormatInt(*p.ApprovedSpecID, 10)
+	}
+	if _, err := fmt.Fprintf(rt, "Spec %s (version %d) compared to approved spec %s\n", p.GetID(), p.Version, approved); err != nil {
+		return err
+	}
+	if len(p.Changes) == 0 {
+		_, err := fmt.Fprintln(rt, "No changes")
+		return err
+	}
+
+	var rows [][]string
+	for _, c := range p.Changes {
+		rows = append(rows, []string{c.Path, string(c.Type), stringOrEmpty(c.OldValue), stringOrEmpty(c.NewValue)})
+	}
+	renderList(jobProposalSpecChangeHeaders, rows, rt.Writer)
+	return nil
+}
+
+// JobProposalSpecDryRunPresenter wraps the JSONAPI JobProposalSpecDryRunResource
+type JobProposalSpecDryRunPresenter struct {
+	JAID
+	presenters.JobProposalSpecDryRunResource
+}
+
+var jobProposalSpecDryRunHeaders = []string{"Spec ID", "Job Proposal ID", "Version", "Valid", "Errors", "Warnings"}
+
+// ToRow presents the JobProposalSpecDryRunResource as a slice of strings.
+func (p *JobProposalSpecDryRunPresenter) ToRow() []string {
+	return []string{
+		p.GetID(),
+		strconv.FormatInt(p.JobProposalID, 10),
+		strconv.FormatInt(int64(p.Version), 10),
+		strconv.FormatBool(p.Valid),
+		strings.Join(p.Errors, "\n"),
+		strings.Join(p.Warnings, "\n"),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *JobProposalSpecDryRunPresenter) RenderTable(rt RendererTable) error {
+	renderList(jobProposalSpecDryRunHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// DiffJobProposalSpec shows the changes a job proposal spec makes to the
+// approved spec of its job proposal.
+func (cli *Client) DiffJobProposalSpec(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the id of the job proposal spec"))
+	}
+	resp, err := cli.HTTP.Get(fmt.Sprintf("/v2/job_proposal_specs/%s/diff", c.Args().First()))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobProposalSpecDiffPresenter{})
+}
+
+// DryRunJobProposalSpec validates a job proposal spec for approval, without
+// approving it.
+func (cli *Client) DryRunJobProposalSpec(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the id of the job proposal spec"))
+	}
+	resp, err := cli.HTTP.Get(fmt.Sprintf("/v2/job_proposal_specs/%s/dry_run", c.Args().First()))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobProposalSpecDryRunPresenter{})
+}
@@ -0,0 +1,87 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestJobProposalSpecDiffPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer     = bytes.NewBufferString("")
+		r          = cmd.RendererTable{Writer: buffer}
+		approvedID = int64(1)
+		oldName    = "'old name'"
+		newName    = "'new name'"
+		gasLimit   = "1000"
+	)
+
+	p := cmd.JobProposalSpecDiffPresenter{
+		JAID: cmd.NewJAID("2"),
+		JobProposalSpecDiffResource: presenters.JobProposalSpecDiffResource{
+			JAID:           presenters.NewJAID("2"),
+			JobProposalID:  1,
+			Version:        2,
+			ApprovedSpecID: &approvedID,
+			Changes: []presenters.JobProposalSpecChange{
+				{Path: "name", Type: feeds.SpecChangeTypeModified, OldValue: &oldName, NewValue: &newName},
+				{Path: "gasLimit", Type: feeds.SpecChangeTypeAdded, NewValue: &gasLimit},
+			},
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "Spec 2 (version 2) compared to approved spec 1")
+	assert.Contains(t, output, "modified")
+	assert.Contains(t, output, oldName)
+	assert.Contains(t, output, newName)
+	assert.Contains(t, output, "gasLimit")
+	assert.Contains(t, output, "added")
+
+	buffer.Reset()
+	p.ApprovedSpecID = nil
+	p.Changes = nil
+	require.NoError(t, p.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, "compared to approved spec none")
+	assert.Contains(t, output, "No changes")
+}
+
+func TestJobProposalSpecDryRunPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.JobProposalSpecDryRunPresenter{
+		JAID: cmd.NewJAID("1"),
+		JobProposalSpecDryRunResource: presenters.JobProposalSpecDryRunResource{
+			JAID:          presenters.NewJAID("1"),
+			JobProposalID: 3,
+			Version:       1,
+			Valid:         false,
+			Errors:        []string{"bridge check failed: bridge 'foo' not found"},
+			Warnings:      []string{"job 4 for contract address 0x0 will be replaced; approval requires the force option"},
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "false")
+	assert.Contains(t, output, "bridge check failed: bridge 'foo' not found")
+	assert.Contains(t, output, "will be replaced")
+}
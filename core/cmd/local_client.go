@@ -40,6 +40,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/shutdown"
@@ -211,6 +212,30 @@ func initLocalSubCmds(client *Client, safe bool) []cli.Command {
 					Before: client.validateDB,
 					Flags:  []cli.Flag{},
 				},
+				{
+					Name:   "restore",
+					Usage:  "Validate and restore a backup taken by the node. WARNING: This will REPLACE ALL DATA in the database referred to by CL_DATABASE_URL env variable or by the Database.URL field in a secrets TOML config.",
+					Action: client.RestoreDatabase,
+					Before: client.validateDB,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "backup, b",
+							Usage: "name of the backup to restore, as listed in the backup manifest, or of a legacy cl_backup_<version>.dump file. Defaults to the latest backup in the manifest",
+						},
+						cli.StringFlag{
+							Name:  "password, p",
+							Usage: "text file holding the keystore password that was in use when an encrypted backup was taken. Defaults to the keystore password in the secrets TOML config",
+						},
+						cli.BoolFlag{
+							Name:  "validate-only",
+							Usage: "validate the backup without restoring it",
+						},
+						cli.BoolFlag{
+							Name:  "yes, y",
+							Usage: "skip the confirmation prompt",
+						},
+					},
+				},
 				{
 					Name:   "create-migration",
 					Usage:  "Create a new migration.",
@@ -878,6 +903,57 @@ func (cli *Client) StatusDatabase(c *clipkg.Context) error {
 	return nil
 }
 
+// RestoreDatabase validates a backup taken by the node and restores it into
+// the database specified by CL_DATABASE_URL or Database.URL in secrets TOML.
+func (cli *Client) RestoreDatabase(c *clipkg.Context) error {
+	cfg := cli.Config
+	parsed := cfg.DatabaseURL()
+	if parsed.String() == "" {
+		return cli.errorOut(errDBURLMissing)
+	}
+
+	password := cfg.KeystorePassword()
+	if c.IsSet("password") {
+		pwd, err := utils.PasswordFromFile(c.String("password"))
+		if err != nil {
+			return cli.errorOut(fmt.Errorf("error reading password: %+v", err))
+		}
+		password = pwd
+	}
+
+	dir, err := periodicbackup.OutputDir(cfg)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	dest := periodicbackup.NewLocalDestination(dir)
+	b, err := periodicbackup.FindBackup(dest, c.String("backup"))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	if c.Bool("validate-only") {
+		if err = periodicbackup.Validate(dest, b, password); err != nil {
+			return cli.errorOut(err)
+		}
+		cli.Logger.Infof("Backup %s is valid", b.Name)
+		return nil
+	}
+
+	if b.SHA256 == "" {
+		fmt.Printf("Restoring legacy backup %s (version %s) into database %s. It has no recorded checksum, so its integrity can't be verified\n", b.Name, b.Version, parsed.Redacted())
+	} else {
+		fmt.Printf("Restoring backup %s (version %s, mode %s, taken at %s) into database %s\n", b.Name, b.Version, b.Mode, b.CreatedAt.Format(time.RFC3339), parsed.Redacted())
+	}
+	if !confirmAction(c) {
+		return nil
+	}
+	if err = periodicbackup.Restore(dest, b, parsed, password, cli.Logger); err != nil {
+		return cli.errorOut(err)
+	}
+	cli.Logger.Infof("Restored backup %s. Run the node version %s to use the restored database", b.Name, b.Version)
+	return nil
+}
+
 // CreateMigration displays the database migration status
 func (cli *Client) CreateMigration(c *clipkg.Context) error {
 	if !c.Args().Present() {
@@ -422,3 +422,21 @@ func TestClient_RebroadcastTransactions_AddressCheck(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_RestoreDatabase_NoBackups(t *testing.T) {
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.Database.Backup.Dir = ptr(t.TempDir())
+	})
+	client := cmd.Client{
+		Config: cfg,
+		Logger: logger.TestLogger(t),
+	}
+
+	set := flag.NewFlagSet("test", 0)
+	cltest.FlagSetApplyFromAction(client.RestoreDatabase, set, "")
+	require.NoError(t, set.Set("validate-only", "true"))
+	c := cli.NewContext(nil, set, nil)
+
+	err := client.RestoreDatabase(c)
+	require.ErrorContains(t, err, "no backups found")
+}
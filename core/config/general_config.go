@@ -28,6 +28,7 @@ var (
 type FeatureFlags interface {
 	FeatureExternalInitiators() bool
 	FeatureFeedsManager() bool
+	FeatureFeedsManagerAutoApproval() bool
 	FeatureOffchainReporting() bool
 	FeatureOffchainReporting2() bool
 	FeatureUICSAKeys() bool
@@ -73,7 +74,10 @@ type BasicConfig interface {
 	BridgeCacheTTL() time.Duration
 	CertFile() string
 	DatabaseBackupDir() string
+	DatabaseBackupEncrypt() bool
 	DatabaseBackupFrequency() time.Duration
+	DatabaseBackupMaxAge() time.Duration
+	DatabaseBackupMaxCount() uint32
 	DatabaseBackupMode() DatabaseBackupMode
 	DatabaseBackupOnVersionUpgrade() bool
 	DatabaseBackupURL() *url.URL
@@ -100,6 +104,8 @@ type BasicConfig interface {
 	GetDatabaseDialectConfiguredOrDefault() dialects.DialectName
 	HTTPServerWriteTimeout() time.Duration
 	JSONConsole() bool
+	JobPipelineCircuitBreakerCooldown() time.Duration
+	JobPipelineCircuitBreakerFailureThreshold() uint32
 	JobPipelineMaxRunDuration() time.Duration
 	JobPipelineMaxSuccessfulRuns() uint64
 	JobPipelineReaperInterval() time.Duration
@@ -162,6 +168,17 @@ type BasicConfig interface {
 	TelemetryIngressSendInterval() time.Duration
 	TelemetryIngressSendTimeout() time.Duration
 	TelemetryIngressUseBatchSend() bool
+	TelemetryIngressSpoolEnabled() bool
+	TelemetryIngressSpoolMaxSize() utils.FileSize
+	TelemetryIngressSpoolSegmentSize() utils.FileSize
+	TelemetryOTLPEnabled() bool
+	TelemetryOTLPEndpoint() string
+	TelemetryOTLPProtocol() string
+	TelemetryOTLPInsecure() bool
+	TelemetryOTLPBufferSize() uint
+	TelemetryOTLPMaxBatchSize() uint
+	TelemetryOTLPSendInterval() time.Duration
+	TelemetryOTLPSendTimeout() time.Duration
 	TriggerFallbackDBPollInterval() time.Duration
 	UnAuthenticatedRateLimit() int64
 	UnAuthenticatedRateLimitPeriod() models.Duration
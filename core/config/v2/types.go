@@ -41,6 +41,7 @@ type Core struct {
 	Feature          Feature                 `toml:",omitempty"`
 	Database         Database                `toml:",omitempty"`
 	TelemetryIngress TelemetryIngress        `toml:",omitempty"`
+	TelemetryOTLP    TelemetryOTLP           `toml:",omitempty"`
 	AuditLogger      audit.AuditLoggerConfig `toml:",omitempty"`
 	Log              Log                     `toml:",omitempty"`
 	WebServer        WebServer               `toml:",omitempty"`
@@ -92,6 +93,7 @@ func (c *Core) SetFrom(f *Core) {
 	c.Feature.setFrom(&f.Feature)
 	c.Database.setFrom(&f.Database)
 	c.TelemetryIngress.setFrom(&f.TelemetryIngress)
+	c.TelemetryOTLP.setFrom(&f.TelemetryOTLP)
 	c.AuditLogger.SetFrom(&f.AuditLogger)
 	c.Log.setFrom(&f.Log)
 
@@ -173,15 +175,19 @@ type PrometheusSecrets struct {
 	AuthToken *models.Secret
 }
 type Feature struct {
-	FeedsManager *bool
-	LogPoller    *bool
-	UICSAKeys    *bool
+	FeedsManager             *bool
+	FeedsManagerAutoApproval *bool
+	LogPoller                *bool
+	UICSAKeys                *bool
 }
 
 func (f *Feature) setFrom(f2 *Feature) {
 	if v := f2.FeedsManager; v != nil {
 		f.FeedsManager = v
 	}
+	if v := f2.FeedsManagerAutoApproval; v != nil {
+		f.FeedsManagerAutoApproval = v
+	}
 	if v := f2.LogPoller; v != nil {
 		f.LogPoller = v
 	}
@@ -289,7 +295,10 @@ func (l *DatabaseLock) setFrom(f *DatabaseLock) {
 // Note: url is stored in Secrets.DatabaseBackupURL
 type DatabaseBackup struct {
 	Dir              *string
+	Encrypt          *bool
 	Frequency        *models.Duration
+	MaxAge           *models.Duration
+	MaxCount         *uint32
 	Mode             *config.DatabaseBackupMode
 	OnVersionUpgrade *bool
 }
@@ -298,9 +307,18 @@ func (d *DatabaseBackup) setFrom(f *DatabaseBackup) {
 	if v := f.Dir; v != nil {
 		d.Dir = v
 	}
+	if v := f.Encrypt; v != nil {
+		d.Encrypt = v
+	}
 	if v := f.Frequency; v != nil {
 		d.Frequency = v
 	}
+	if v := f.MaxAge; v != nil {
+		d.MaxAge = v
+	}
+	if v := f.MaxCount; v != nil {
+		d.MaxCount = v
+	}
 	if v := f.Mode; v != nil {
 		d.Mode = v
 	}
@@ -319,6 +337,8 @@ type TelemetryIngress struct {
 	SendInterval *models.Duration
 	SendTimeout  *models.Duration
 	UseBatchSend *bool
+
+	Spool TelemetryIngressSpool `toml:",omitempty"`
 }
 
 func (t *TelemetryIngress) setFrom(f *TelemetryIngress) {
@@ -349,6 +369,98 @@ func (t *TelemetryIngress) setFrom(f *TelemetryIngress) {
 	if v := f.UseBatchSend; v != nil {
 		t.UseBatchSend = v
 	}
+	t.Spool.setFrom(&f.Spool)
+}
+
+type TelemetryIngressSpool struct {
+	Enabled     *bool
+	MaxSize     *utils.FileSize
+	SegmentSize *utils.FileSize
+}
+
+func (s *TelemetryIngressSpool) ValidateConfig() (err error) {
+	if s.Enabled == nil || !*s.Enabled || s.MaxSize == nil || s.SegmentSize == nil {
+		return
+	}
+	if *s.MaxSize == 0 {
+		err = multierr.Append(err, ErrInvalid{Name: "MaxSize", Value: s.MaxSize.String(), Msg: "must be greater than zero"})
+	}
+	if *s.SegmentSize == 0 {
+		err = multierr.Append(err, ErrInvalid{Name: "SegmentSize", Value: s.SegmentSize.String(), Msg: "must be greater than zero"})
+	} else if *s.SegmentSize > *s.MaxSize {
+		err = multierr.Append(err, ErrInvalid{Name: "SegmentSize", Value: s.SegmentSize.String(),
+			Msg: fmt.Sprintf("must be less than or equal to MaxSize (%s)", s.MaxSize.String())})
+	}
+	return
+}
+
+func (s *TelemetryIngressSpool) setFrom(f *TelemetryIngressSpool) {
+	if v := f.Enabled; v != nil {
+		s.Enabled = v
+	}
+	if v := f.MaxSize; v != nil {
+		s.MaxSize = v
+	}
+	if v := f.SegmentSize; v != nil {
+		s.SegmentSize = v
+	}
+}
+
+type TelemetryOTLP struct {
+	Enabled      *bool
+	Endpoint     *string
+	Protocol     *string
+	Insecure     *bool
+	BufferSize   *uint16
+	MaxBatchSize *uint16
+	SendInterval *models.Duration
+	SendTimeout  *models.Duration
+}
+
+func (t *TelemetryOTLP) ValidateConfig() (err error) {
+	if t.Protocol != nil && *t.Protocol != "grpc" && *t.Protocol != "http" {
+		err = multierr.Append(err, ErrInvalid{Name: "Protocol", Value: *t.Protocol, Msg: "must be one of grpc or http"})
+	}
+	if t.Enabled == nil || !*t.Enabled {
+		return
+	}
+	if t.Endpoint == nil || *t.Endpoint == "" {
+		err = multierr.Append(err, ErrMissing{Name: "Endpoint", Msg: "must be provided and non-empty when enabled"})
+	}
+	if t.MaxBatchSize != nil && *t.MaxBatchSize == 0 {
+		err = multierr.Append(err, ErrInvalid{Name: "MaxBatchSize", Value: *t.MaxBatchSize, Msg: "must be greater than zero"})
+	}
+	if t.SendInterval != nil && t.SendInterval.Duration() <= 0 {
+		err = multierr.Append(err, ErrInvalid{Name: "SendInterval", Value: t.SendInterval.String(), Msg: "must be greater than zero"})
+	}
+	return
+}
+
+func (t *TelemetryOTLP) setFrom(f *TelemetryOTLP) {
+	if v := f.Enabled; v != nil {
+		t.Enabled = v
+	}
+	if v := f.Endpoint; v != nil {
+		t.Endpoint = v
+	}
+	if v := f.Protocol; v != nil {
+		t.Protocol = v
+	}
+	if v := f.Insecure; v != nil {
+		t.Insecure = v
+	}
+	if v := f.BufferSize; v != nil {
+		t.BufferSize = v
+	}
+	if v := f.MaxBatchSize; v != nil {
+		t.MaxBatchSize = v
+	}
+	if v := f.SendInterval; v != nil {
+		t.SendInterval = v
+	}
+	if v := f.SendTimeout; v != nil {
+		t.SendTimeout = v
+	}
 }
 
 // LogLevel replaces dpanic with crit/CRIT
@@ -542,7 +654,8 @@ type JobPipeline struct {
 	ReaperThreshold           *models.Duration
 	ResultWriteQueueDepth     *uint32
 
-	HTTPRequest JobPipelineHTTPRequest `toml:",omitempty"`
+	HTTPRequest    JobPipelineHTTPRequest    `toml:",omitempty"`
+	CircuitBreaker JobPipelineCircuitBreaker `toml:",omitempty"`
 }
 
 func (j *JobPipeline) setFrom(f *JobPipeline) {
@@ -565,6 +678,7 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 		j.ResultWriteQueueDepth = v
 	}
 	j.HTTPRequest.setFrom(&f.HTTPRequest)
+	j.CircuitBreaker.setFrom(&f.CircuitBreaker)
 
 }
 
@@ -582,6 +696,20 @@ func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
 	}
 }
 
+type JobPipelineCircuitBreaker struct {
+	FailureThreshold *uint32
+	Cooldown         *models.Duration
+}
+
+func (j *JobPipelineCircuitBreaker) setFrom(f *JobPipelineCircuitBreaker) {
+	if v := f.FailureThreshold; v != nil {
+		j.FailureThreshold = v
+	}
+	if v := f.Cooldown; v != nil {
+		j.Cooldown = v
+	}
+}
+
 type FluxMonitor struct {
 	DefaultTransactionQueueDepth *uint32
 	SimulateTransactions         *bool
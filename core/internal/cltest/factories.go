@@ -305,15 +305,19 @@ func MustInsertInProgressEthTxWithAttempt(t *testing.T, txStore txmgr.EvmTxStore
 
 func MustInsertUnstartedEthTx(t *testing.T, txStore txmgr.EvmTxStore, fromAddress common.Address, opts ...interface{}) txmgr.EvmTx {
 	var subject uuid.NullUUID
+	var priority txmgrtypes.TxPriority
 	for _, opt := range opts {
 		switch v := opt.(type) {
 		case uuid.UUID:
 			subject = uuid.NullUUID{UUID: v, Valid: true}
+		case txmgrtypes.TxPriority:
+			priority = v
 		}
 	}
 	etx := NewEthTx(t, fromAddress)
 	etx.State = txmgr.EthTxUnstarted
 	etx.Subject = subject
+	etx.Priority = priority
 	require.NoError(t, txStore.InsertEthTx(&etx))
 	return etx
 }
@@ -17,6 +17,8 @@ import (
 
 	feeds "github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 
+	functions "github.com/smartcontractkit/chainlink/v2/core/services/functions"
+
 	gas "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 
 	job "github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -115,6 +117,22 @@ func (_m *Application) EVMORM() types.Configs {
 	return r0
 }
 
+// FunctionsRequestsORM provides a mock function with given fields:
+func (_m *Application) FunctionsRequestsORM() functions.RequestsORM {
+	ret := _m.Called()
+
+	var r0 functions.RequestsORM
+	if rf, ok := ret.Get(0).(func() functions.RequestsORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(functions.RequestsORM)
+		}
+	}
+
+	return r0
+}
+
 // GetAuditLogger provides a mock function with given fields:
 func (_m *Application) GetAuditLogger() audit.AuditLogger {
 	ret := _m.Called()
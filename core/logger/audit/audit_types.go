@@ -23,10 +23,15 @@ const (
 	FeedsManCreated EventID = "FEEDS_MAN_CREATED"
 	FeedsManUpdated EventID = "FEEDS_MAN_UPDATED"
 
+	FeedsManAutoApprovalPolicyUpdated EventID = "FEEDS_MAN_AUTO_APPROVAL_POLICY_UPDATED"
+
 	FeedsManChainConfigCreated EventID = "FEEDS_MAN_CHAIN_CONFIG_CREATED"
 	FeedsManChainConfigUpdated EventID = "FEEDS_MAN_CHAIN_CONFIG_UPDATED"
 	FeedsManChainConfigDeleted EventID = "FEEDS_MAN_CHAIN_CONFIG_DELETED"
 
+	KeystorePasswordRotationAttemptFailedMismatch EventID = "KEYSTORE_PASSWORD_ROTATION_ATTEMPT_FAILED_MISMATCH"
+	KeystorePasswordRotated                       EventID = "KEYSTORE_PASSWORD_ROTATED"
+
 	CSAKeyCreated  EventID = "CSA_KEY_CREATED"
 	CSAKeyImported EventID = "CSA_KEY_IMPORTED"
 	CSAKeyExported EventID = "CSA_KEY_EXPORTED"
@@ -49,6 +54,8 @@ const (
 	KeyDeleted  EventID = "KEY_DELETED"
 
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
+	EthTransactionCancelled  EventID = "ETH_TRANSACTION_CANCELLED"
+	EthTransactionReplaced   EventID = "ETH_TRANSACTION_REPLACED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
@@ -77,6 +84,9 @@ const (
 	JobProposalSpecCanceled EventID = "JOB_PROPOSAL_SPEC_CANCELED"
 	JobProposalSpecRejected EventID = "JOB_PROPOSAL_SPEC_REJECTED"
 
+	JobProposalSpecAutoApproved       EventID = "JOB_PROPOSAL_SPEC_AUTO_APPROVED"
+	JobProposalSpecAutoApprovalDenied EventID = "JOB_PROPOSAL_SPEC_AUTO_APPROVAL_DENIED"
+
 	ConfigUpdated            EventID = "CONFIG_UPDATED"
 	ConfigSqlLoggingEnabled  EventID = "CONFIG_SQL_LOGGING_ENABLED"
 	ConfigSqlLoggingDisabled EventID = "CONFIG_SQL_LOGGING_DISABLED"
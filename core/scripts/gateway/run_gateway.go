@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pelletier/go-toml/v2"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+)
+
+func main() {
+	configFile := flag.String("config", "", "Path to TOML config file")
+	flag.Parse()
+
+	if *configFile == "" {
+		fmt.Println("Usage: go run run_gateway.go --config <config_file.toml>")
+		os.Exit(1)
+	}
+
+	rawConfig, err := os.ReadFile(*configFile)
+	if err != nil {
+		fmt.Println("error reading config:", err)
+		os.Exit(1)
+	}
+
+	var cfg config.GatewayConfig
+	if err = toml.Unmarshal(rawConfig, &cfg); err != nil {
+		fmt.Println("error parsing config:", err)
+		os.Exit(1)
+	}
+
+	lggr, closeLggr := logger.NewLogger()
+	defer func() { _ = closeLggr() }()
+
+	gw, err := gateway.NewGatewayFromConfig(&cfg, lggr)
+	if err != nil {
+		fmt.Println("error creating Gateway object:", err)
+		os.Exit(1)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if err = gw.Start(ctx); err != nil {
+		fmt.Println("error starting gateway:", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Gateway started: users on port %d, nodes on port %d\n", gw.GetUserPort(), gw.GetNodePort())
+
+	<-ctx.Done()
+	if err = gw.Close(); err != nil {
+		fmt.Println("error closing gateway:", err)
+	}
+}
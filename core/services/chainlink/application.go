@@ -5,6 +5,7 @@ import (
 	"context"
 	"math/big"
 	"net/http"
+	"path/filepath"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -38,6 +39,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
@@ -86,6 +88,7 @@ type Application interface {
 	PipelineORM() pipeline.ORM
 	BridgeORM() bridges.ORM
 	SessionORM() sessions.ORM
+	FunctionsRequestsORM() functions.RequestsORM
 	TxmStorageService() txmgr.EvmTxStore
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
@@ -119,6 +122,7 @@ type ChainlinkApplication struct {
 	pipelineRunner           pipeline.Runner
 	bridgeORM                bridges.ORM
 	sessionORM               sessions.ORM
+	functionsRequestsORM     functions.RequestsORM
 	txmStorageService        txmgr.EvmTxStore
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
@@ -238,20 +242,47 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	explorerClient := synchronization.ExplorerClient(&synchronization.NoopExplorerClient{})
 	monitoringEndpointGen := telemetry.MonitoringEndpointGenerator(&telemetry.NoopAgent{})
 
-	if cfg.ExplorerURL() != nil && cfg.TelemetryIngressURL() != nil {
+	// Use the OTLP exporter over Explorer and TelemetryIngress if it is enabled
+	if cfg.TelemetryOTLPEnabled() {
+		if cfg.ExplorerURL() != nil || cfg.TelemetryIngressURL() != nil {
+			globalLogger.Warn("TelemetryOTLP is enabled, ignoring ExplorerUrl and TelemetryIngress.Url")
+		}
+		otlpExporter, err := telemetry.NewOTLPExporter(telemetry.OTLPConfig{
+			Endpoint:     cfg.TelemetryOTLPEndpoint(),
+			Protocol:     cfg.TelemetryOTLPProtocol(),
+			Insecure:     cfg.TelemetryOTLPInsecure(),
+			BufferSize:   cfg.TelemetryOTLPBufferSize(),
+			MaxBatchSize: cfg.TelemetryOTLPMaxBatchSize(),
+			SendInterval: cfg.TelemetryOTLPSendInterval(),
+			SendTimeout:  cfg.TelemetryOTLPSendTimeout(),
+		}, globalLogger)
+		if err != nil {
+			return nil, errors.Wrap(err, "NewApplication: failed to initialize OTLP telemetry exporter")
+		}
+		monitoringEndpointGen = otlpExporter
+		srvcs = append(srvcs, otlpExporter)
+	}
+
+	if cfg.ExplorerURL() != nil && cfg.TelemetryIngressURL() != nil && !cfg.TelemetryOTLPEnabled() {
 		globalLogger.Warn("Both ExplorerUrl and TelemetryIngress.Url are set, defaulting to Explorer")
 	}
 
-	if cfg.ExplorerURL() != nil {
+	if cfg.ExplorerURL() != nil && !cfg.TelemetryOTLPEnabled() {
 		explorerClient = synchronization.NewExplorerClient(cfg.ExplorerURL(), cfg.ExplorerAccessKey(), cfg.ExplorerSecret(), globalLogger)
 		monitoringEndpointGen = telemetry.NewExplorerAgent(explorerClient)
 	}
 
 	// Use Explorer over TelemetryIngress if both URLs are set
-	if cfg.ExplorerURL() == nil && cfg.TelemetryIngressURL() != nil {
+	if cfg.ExplorerURL() == nil && cfg.TelemetryIngressURL() != nil && !cfg.TelemetryOTLPEnabled() {
 		if cfg.TelemetryIngressUseBatchSend() {
 			telemetryIngressBatchClient = synchronization.NewTelemetryIngressBatchClient(cfg.TelemetryIngressURL(),
-				cfg.TelemetryIngressServerPubKey(), keyStore.CSA(), cfg.TelemetryIngressLogging(), globalLogger, cfg.TelemetryIngressBufferSize(), cfg.TelemetryIngressMaxBatchSize(), cfg.TelemetryIngressSendInterval(), cfg.TelemetryIngressSendTimeout(), cfg.TelemetryIngressUniConn())
+				cfg.TelemetryIngressServerPubKey(), keyStore.CSA(), cfg.TelemetryIngressLogging(), globalLogger, cfg.TelemetryIngressBufferSize(), cfg.TelemetryIngressMaxBatchSize(), cfg.TelemetryIngressSendInterval(), cfg.TelemetryIngressSendTimeout(), cfg.TelemetryIngressUniConn(),
+				synchronization.TelemetrySpoolConfig{
+					Enabled:     cfg.TelemetryIngressSpoolEnabled(),
+					Dir:         filepath.Join(cfg.RootDir(), "telemetry-spool"),
+					MaxSize:     cfg.TelemetryIngressSpoolMaxSize(),
+					SegmentSize: cfg.TelemetryIngressSpoolSegmentSize(),
+				})
 			monitoringEndpointGen = telemetry.NewIngressAgentBatchWrapper(telemetryIngressBatchClient)
 
 		} else {
@@ -385,7 +416,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		relayers := make(map[relay.Network]func() (loop.Relayer, error))
 		if cfg.EVMEnabled() {
 			lggr := globalLogger.Named("EVM")
-			evmRelayer := evmrelay.NewRelayer(db, chains.EVM, lggr, cfg, keyStore)
+			evmRelayer := evmrelay.NewRelayer(db, chains.EVM, lggr, cfg, keyStore, monitoringEndpointGen)
 			relayer := relay.RelayerAdapter{Relayer: evmRelayer, RelayerExt: chains.EVM}
 			relayers[relay.EVM] = func() (loop.Relayer, error) { return &relayer, nil }
 		}
@@ -407,6 +438,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		delegates[job.OffchainReporting2] = ocr2.NewDelegate(
 			db,
 			jobORM,
+			bridgeORM,
 			pipelineRunner,
 			peerWrapper,
 			monitoringEndpointGen,
@@ -459,6 +491,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			cfg,
 			chains.EVM,
 			globalLogger,
+			auditLogger,
 			opts.Version,
 		)
 	} else {
@@ -474,6 +507,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		pipelineORM:              pipelineORM,
 		bridgeORM:                bridgeORM,
 		sessionORM:               sessionORM,
+		functionsRequestsORM:     functions.NewRequestsORM(db, globalLogger, cfg),
 		txmStorageService:        txmORM,
 		FeedsService:             feedsService,
 		Config:                   cfg,
@@ -665,6 +699,10 @@ func (app *ChainlinkApplication) SessionORM() sessions.ORM {
 	return app.sessionORM
 }
 
+func (app *ChainlinkApplication) FunctionsRequestsORM() functions.RequestsORM {
+	return app.functionsRequestsORM
+}
+
 func (app *ChainlinkApplication) EVMORM() evmtypes.Configs {
 	return app.Chains.EVM.Configs()
 }
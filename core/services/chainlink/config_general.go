@@ -263,6 +263,10 @@ func (g *generalConfig) FeatureFeedsManager() bool {
 	return *g.c.Feature.FeedsManager
 }
 
+func (g *generalConfig) FeatureFeedsManagerAutoApproval() bool {
+	return *g.c.Feature.FeedsManagerAutoApproval
+}
+
 func (g *generalConfig) FeatureOffchainReporting() bool {
 	return *g.c.OCR.Enabled
 }
@@ -491,10 +495,22 @@ func (g *generalConfig) DatabaseBackupDir() string {
 	return *g.c.Database.Backup.Dir
 }
 
+func (g *generalConfig) DatabaseBackupEncrypt() bool {
+	return *g.c.Database.Backup.Encrypt
+}
+
 func (g *generalConfig) DatabaseBackupFrequency() time.Duration {
 	return g.c.Database.Backup.Frequency.Duration()
 }
 
+func (g *generalConfig) DatabaseBackupMaxAge() time.Duration {
+	return g.c.Database.Backup.MaxAge.Duration()
+}
+
+func (g *generalConfig) DatabaseBackupMaxCount() uint32 {
+	return *g.c.Database.Backup.MaxCount
+}
+
 func (g *generalConfig) DatabaseBackupMode() coreconfig.DatabaseBackupMode {
 	return *g.c.Database.Backup.Mode
 }
@@ -579,6 +595,14 @@ func (g *generalConfig) JSONConsole() bool {
 	return *g.c.Log.JSONConsole
 }
 
+func (g *generalConfig) JobPipelineCircuitBreakerCooldown() time.Duration {
+	return g.c.JobPipeline.CircuitBreaker.Cooldown.Duration()
+}
+
+func (g *generalConfig) JobPipelineCircuitBreakerFailureThreshold() uint32 {
+	return *g.c.JobPipeline.CircuitBreaker.FailureThreshold
+}
+
 func (g *generalConfig) JobPipelineMaxRunDuration() time.Duration {
 	return g.c.JobPipeline.MaxRunDuration.Duration()
 }
@@ -1045,6 +1069,53 @@ func (g *generalConfig) TelemetryIngressUseBatchSend() bool {
 	return *g.c.TelemetryIngress.UseBatchSend
 }
 
+func (g *generalConfig) TelemetryIngressSpoolEnabled() bool {
+	return *g.c.TelemetryIngress.Spool.Enabled
+}
+
+func (g *generalConfig) TelemetryIngressSpoolMaxSize() utils.FileSize {
+	return *g.c.TelemetryIngress.Spool.MaxSize
+}
+
+func (g *generalConfig) TelemetryIngressSpoolSegmentSize() utils.FileSize {
+	return *g.c.TelemetryIngress.Spool.SegmentSize
+}
+
+func (g *generalConfig) TelemetryOTLPEnabled() bool {
+	return *g.c.TelemetryOTLP.Enabled
+}
+
+func (g *generalConfig) TelemetryOTLPEndpoint() string {
+	if g.c.TelemetryOTLP.Endpoint == nil {
+		return ""
+	}
+	return *g.c.TelemetryOTLP.Endpoint
+}
+
+func (g *generalConfig) TelemetryOTLPProtocol() string {
+	return *g.c.TelemetryOTLP.Protocol
+}
+
+func (g *generalConfig) TelemetryOTLPInsecure() bool {
+	return *g.c.TelemetryOTLP.Insecure
+}
+
+func (g *generalConfig) TelemetryOTLPBufferSize() uint {
+	return uint(*g.c.TelemetryOTLP.BufferSize)
+}
+
+func (g *generalConfig) TelemetryOTLPMaxBatchSize() uint {
+	return uint(*g.c.TelemetryOTLP.MaxBatchSize)
+}
+
+func (g *generalConfig) TelemetryOTLPSendInterval() time.Duration {
+	return g.c.TelemetryOTLP.SendInterval.Duration()
+}
+
+func (g *generalConfig) TelemetryOTLPSendTimeout() time.Duration {
+	return g.c.TelemetryOTLP.SendTimeout.Duration()
+}
+
 func (g *generalConfig) TriggerFallbackDBPollInterval() time.Duration {
 	return g.c.Database.Listener.FallbackPollInterval.Duration()
 }
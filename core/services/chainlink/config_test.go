@@ -239,9 +239,10 @@ func TestConfig_Marshal(t *testing.T) {
 	}
 
 	full.Feature = config.Feature{
-		FeedsManager: ptr(true),
-		LogPoller:    ptr(true),
-		UICSAKeys:    ptr(true),
+		FeedsManager:             ptr(true),
+		FeedsManagerAutoApproval: ptr(true),
+		LogPoller:                ptr(true),
+		UICSAKeys:                ptr(true),
 	}
 	full.Database = config.Database{
 		DefaultIdleInTxSessionTimeout: models.MustNewDuration(time.Minute),
@@ -263,7 +264,10 @@ func TestConfig_Marshal(t *testing.T) {
 		},
 		Backup: config.DatabaseBackup{
 			Dir:              ptr("test/backup/dir"),
+			Encrypt:          ptr(true),
 			Frequency:        &hour,
+			MaxAge:           models.MustNewDuration(30 * 24 * time.Hour),
+			MaxCount:         ptr[uint32](5),
 			Mode:             &legacy.DatabaseBackupModeFull,
 			OnVersionUpgrade: ptr(true),
 		},
@@ -278,6 +282,21 @@ func TestConfig_Marshal(t *testing.T) {
 		SendInterval: models.MustNewDuration(time.Minute),
 		SendTimeout:  models.MustNewDuration(5 * time.Second),
 		UseBatchSend: ptr(true),
+		Spool: config.TelemetryIngressSpool{
+			Enabled:     ptr(true),
+			MaxSize:     ptr[utils.FileSize](utils.GB),
+			SegmentSize: ptr[utils.FileSize](16 * utils.MB),
+		},
+	}
+	full.TelemetryOTLP = config.TelemetryOTLP{
+		Enabled:      ptr(true),
+		Endpoint:     ptr("collector.test:4317"),
+		Protocol:     ptr("http"),
+		Insecure:     ptr(true),
+		BufferSize:   ptr[uint16](321),
+		MaxBatchSize: ptr[uint16](123),
+		SendInterval: models.MustNewDuration(time.Second),
+		SendTimeout:  models.MustNewDuration(3 * time.Second),
 	}
 	full.Log = config.Log{
 		Level:       ptr(config.LogLevel(zapcore.DPanicLevel)),
@@ -328,6 +347,10 @@ func TestConfig_Marshal(t *testing.T) {
 			MaxSize:        ptr[utils.FileSize](100 * utils.MB),
 			DefaultTimeout: models.MustNewDuration(time.Minute),
 		},
+		CircuitBreaker: config.JobPipelineCircuitBreaker{
+			FailureThreshold: ptr[uint32](5),
+			Cooldown:         models.MustNewDuration(time.Minute),
+		},
 	}
 	full.FluxMonitor = config.FluxMonitor{
 		DefaultTransactionQueueDepth: ptr[uint32](100),
@@ -438,6 +461,7 @@ func TestConfig_Marshal(t *testing.T) {
 				BlockBackfillSkip:    ptr(true),
 				ChainType:            ptr("Optimism"),
 				FinalityDepth:        ptr[uint32](42),
+				FinalityTagEnabled:   ptr(true),
 				FlagsContractAddress: mustAddress("0xae4E781a6218A8031764928E88d457937A954fC3"),
 
 				GasEstimator: evmcfg.GasEstimator{
@@ -474,6 +498,15 @@ func TestConfig_Marshal(t *testing.T) {
 						EIP1559FeeCapBufferBlocks: ptr[uint16](13),
 						TransactionPercentile:     ptr[uint16](15),
 					},
+					FeeHistory: evmcfg.FeeHistoryEstimator{
+						BaseFeeBufferBlocks: ptr[uint16](6),
+						BlockCount:          ptr[uint16](30),
+						RewardPercentile:    ptr[uint16](70),
+					},
+					Composite: evmcfg.CompositeEstimator{
+						Modes:               &[]string{"FeeHistory", "BlockHistory", "FixedPrice"},
+						MaxDeviationPercent: ptr[uint16](50),
+					},
 				},
 
 				KeySpecific: []evmcfg.KeySpecific{
@@ -517,6 +550,13 @@ func TestConfig_Marshal(t *testing.T) {
 					PollInterval:         &minute,
 					SelectionMode:        &selectionMode,
 					SyncThreshold:        ptr[uint32](13),
+					HeadLagTolerance:     ptr[uint32](3),
+					Quorum: evmcfg.NodePoolQuorum{
+						Enabled:   ptr(true),
+						Methods:   &[]string{"CallContract", "TransactionReceipt"},
+						Nodes:     ptr[uint32](3),
+						Threshold: ptr[uint32](2),
+					},
 				},
 				OCR: evmcfg.OCR{
 					ContractConfirmations:              ptr[uint16](11),
@@ -535,11 +575,13 @@ func TestConfig_Marshal(t *testing.T) {
 					Name:    ptr("foo"),
 					HTTPURL: mustURL("https://foo.web"),
 					WSURL:   mustURL("wss://web.socket/test"),
+					Order:   ptr[int32](1),
 				},
 				{
 					Name:    ptr("bar"),
 					HTTPURL: mustURL("https://bar.com"),
 					WSURL:   mustURL("wss://web.socket/test"),
+					Order:   ptr[int32](2),
 				},
 				{
 					Name:     ptr("broadcast"),
@@ -642,6 +684,7 @@ Headers = ['Authorization: token', 'X-SomeOther-Header: value with spaces | and
 `},
 		{"Feature", Config{Core: config.Core{Feature: full.Feature}}, `[Feature]
 FeedsManager = true
+FeedsManagerAutoApproval = true
 LogPoller = true
 UICSAKeys = true
 `},
@@ -656,7 +699,10 @@ MigrateOnStartup = true
 
 [Database.Backup]
 Dir = 'test/backup/dir'
+Encrypt = true
 Frequency = '1h0m0s'
+MaxAge = '720h0m0s'
+MaxCount = 5
 Mode = 'full'
 OnVersionUpgrade = true
 
@@ -680,6 +726,21 @@ MaxBatchSize = 4321
 SendInterval = '1m0s'
 SendTimeout = '5s'
 UseBatchSend = true
+
+[TelemetryIngress.Spool]
+Enabled = true
+MaxSize = '1.00gb'
+SegmentSize = '16.00mb'
+`},
+		{"TelemetryOTLP", Config{Core: config.Core{TelemetryOTLP: full.TelemetryOTLP}}, `[TelemetryOTLP]
+Enabled = true
+Endpoint = 'collector.test:4317'
+Protocol = 'http'
+Insecure = true
+BufferSize = 321
+MaxBatchSize = 123
+SendInterval = '1s'
+SendTimeout = '3s'
 `},
 		{"Log", Config{Core: config.Core{Log: full.Log}}, `[Log]
 Level = 'crit'
@@ -734,6 +795,10 @@ ResultWriteQueueDepth = 10
 [JobPipeline.HTTPRequest]
 DefaultTimeout = '1m0s'
 MaxSize = '100.00mb'
+
+[JobPipeline.CircuitBreaker]
+FailureThreshold = 5
+Cooldown = '1m0s'
 `},
 		{"OCR", Config{Core: config.Core{OCR: full.OCR}}, `[OCR]
 Enabled = true
@@ -832,6 +897,7 @@ BlockBackfillDepth = 100
 BlockBackfillSkip = true
 ChainType = 'Optimism'
 FinalityDepth = 42
+FinalityTagEnabled = true
 FlagsContractAddress = '0xae4E781a6218A8031764928E88d457937A954fC3'
 LinkContractAddress = '0x538aAaB4ea120b2bC2fe5D296852D948F07D849e'
 LogBackfillBatchSize = 17
@@ -889,6 +955,15 @@ CheckInclusionPercentile = 19
 EIP1559FeeCapBufferBlocks = 13
 TransactionPercentile = 15
 
+[EVM.GasEstimator.FeeHistory]
+BaseFeeBufferBlocks = 6
+BlockCount = 30
+RewardPercentile = 70
+
+[EVM.GasEstimator.Composite]
+Modes = ['FeeHistory', 'BlockHistory', 'FixedPrice']
+MaxDeviationPercent = 50
+
 [EVM.HeadTracker]
 HistoryDepth = 15
 MaxBufferSize = 17
@@ -905,6 +980,13 @@ PollFailureThreshold = 5
 PollInterval = '1m0s'
 SelectionMode = 'HighestHead'
 SyncThreshold = 13
+HeadLagTolerance = 3
+
+[EVM.NodePool.Quorum]
+Enabled = true
+Methods = ['CallContract', 'TransactionReceipt']
+Nodes = 3
+Threshold = 2
 
 [EVM.OCR]
 ContractConfirmations = 11
@@ -920,11 +1002,13 @@ GasLimit = 540
 Name = 'foo'
 WSURL = 'wss://web.socket/test'
 HTTPURL = 'https://foo.web'
+Order = 1
 
 [[EVM.Nodes]]
 Name = 'bar'
 WSURL = 'wss://web.socket/test'
 HTTPURL = 'https://bar.com'
+Order = 2
 
 [[EVM.Nodes]]
 Name = 'broadcast'
@@ -1032,6 +1116,9 @@ func TestConfig_full(t *testing.T) {
 			if got.EVM[c].Nodes[n].SendOnly == nil {
 				got.EVM[c].Nodes[n].SendOnly = ptr(true)
 			}
+			if got.EVM[c].Nodes[n].Order == nil {
+				got.EVM[c].Nodes[n].Order = ptr(evmcfg.DefaultNodeOrder)
+			}
 		}
 	}
 
@@ -1047,8 +1134,13 @@ func TestConfig_Validate(t *testing.T) {
 		toml string
 		exp  string
 	}{
-		{name: "invalid", toml: invalidTOML, exp: `invalid configuration: 5 errors:
+		{name: "invalid", toml: invalidTOML, exp: `invalid configuration: 6 errors:
 	- Database.Lock.LeaseRefreshInterval: invalid value (6s): must be less than or equal to half of LeaseDuration (10s)
+	- TelemetryOTLP: 4 errors:
+		- Protocol: invalid value (udp): must be one of grpc or http
+		- Endpoint: missing: must be provided and non-empty when enabled
+		- MaxBatchSize: invalid value (0): must be greater than zero
+		- SendInterval: invalid value (0s): must be greater than zero
 	- EVM: 8 errors:
 		- 1.ChainID: invalid value (1): duplicate - must be unique
 		- 0.Nodes.1.Name: invalid value (foo): duplicate - must be unique
@@ -1095,7 +1187,9 @@ func TestConfig_Validate(t *testing.T) {
 					- Name: empty: required for all nodes
 					- WSURL: missing: required for primary nodes
 					- HTTPURL: invalid value (ws): must be http or https
-				- 3.HTTPURL: missing: required for all nodes
+				- 3: 2 errors:
+					- HTTPURL: missing: required for all nodes
+					- Order: invalid value (0): must be between 1 and 100
 				- 4.HTTPURL: missing: required for all nodes
 		- 4: 2 errors:
 			- ChainID: missing: required for all chains
@@ -483,6 +483,20 @@ func (_m *GeneralConfig) DatabaseBackupDir() string {
 	return r0
 }
 
+// DatabaseBackupEncrypt provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseBackupEncrypt() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // DatabaseBackupFrequency provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseBackupFrequency() time.Duration {
 	ret := _m.Called()
@@ -497,6 +511,34 @@ func (_m *GeneralConfig) DatabaseBackupFrequency() time.Duration {
 	return r0
 }
 
+// DatabaseBackupMaxAge provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseBackupMaxAge() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// DatabaseBackupMaxCount provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseBackupMaxCount() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // DatabaseBackupMode provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseBackupMode() config.DatabaseBackupMode {
 	ret := _m.Called()
@@ -915,6 +957,20 @@ func (_m *GeneralConfig) FeatureFeedsManager() bool {
 	return r0
 }
 
+// FeatureFeedsManagerAutoApproval provides a mock function with given fields:
+func (_m *GeneralConfig) FeatureFeedsManagerAutoApproval() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // FeatureLogPoller provides a mock function with given fields:
 func (_m *GeneralConfig) FeatureLogPoller() bool {
 	ret := _m.Called()
@@ -1041,6 +1097,34 @@ func (_m *GeneralConfig) JSONConsole() bool {
 	return r0
 }
 
+// JobPipelineCircuitBreakerCooldown provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineCircuitBreakerCooldown() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// JobPipelineCircuitBreakerFailureThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineCircuitBreakerFailureThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // JobPipelineMaxRunDuration provides a mock function with given fields:
 func (_m *GeneralConfig) JobPipelineMaxRunDuration() time.Duration {
 	ret := _m.Called()
@@ -2642,6 +2726,48 @@ func (_m *GeneralConfig) TelemetryIngressServerPubKey() string {
 	return r0
 }
 
+// TelemetryIngressSpoolEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) TelemetryIngressSpoolEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TelemetryIngressSpoolMaxSize provides a mock function with given fields:
+func (_m *GeneralConfig) TelemetryIngressSpoolMaxSize() utils.FileSize {
+	ret := _m.Called()
+
+	var r0 utils.FileSize
+	if rf, ok := ret.Get(0).(func() utils.FileSize); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(utils.FileSize)
+	}
+
+	return r0
+}
+
+// TelemetryIngressSpoolSegmentSize provides a mock function with given fields:
+func (_m *GeneralConfig) TelemetryIngressSpoolSegmentSize() utils.FileSize {
+	ret := _m.Called()
+
+	var r0 utils.FileSize
+	if rf, ok := ret.Get(0).(func() utils.FileSize); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(utils.FileSize)
+	}
+
+	return r0
+}
+
 // TelemetryIngressURL provides a mock function with given fields:
 func (_m *GeneralConfig) TelemetryIngressURL() *url.URL {
 	ret := _m.Called()
@@ -2686,6 +2812,118 @@ func (_m *GeneralConfig) TelemetryIngressUseBatchSend() bool {
 	return r0
 }
 
+// TelemetryOTLPBufferSize provides a mock function with given fields:
+func (_m *GeneralConfig) TelemetryOTLPBufferSize() uint {
+	ret := _m.Called()
+
+	var r0 uint
+	if rf, ok := ret.Get(0).(func() uint); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	return r0
+}
+
+// TelemetryOTLPEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) TelemetryOTLPEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TelemetryOTLPEndpoint provides a mock function with given fields:
+func (_m *GeneralConfig) TelemetryOTLPEndpoint() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TelemetryOTLPInsecure provides a mock function with given fields:
+func (_m *GeneralConfig) TelemetryOTLPInsecure() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TelemetryOTLPMaxBatchSize provides a mock function with given fields:
+func (_m *GeneralConfig) TelemetryOTLPMaxBatchSize() uint {
+	ret := _m.Called()
+
+	var r0 uint
+	if rf, ok := ret.Get(0).(func() uint); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	return r0
+}
+
+// TelemetryOTLPProtocol provides a mock function with given fields:
+func (_m *GeneralConfig) TelemetryOTLPProtocol() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TelemetryOTLPSendInterval provides a mock function with given fields:
+func (_m *GeneralConfig) TelemetryOTLPSendInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// TelemetryOTLPSendTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) TelemetryOTLPSendTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *GeneralConfig) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
@@ -0,0 +1,253 @@
+package feeds
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// autoApprovableJobTypes are the job types which a feeds manager can propose.
+var autoApprovableJobTypes = []job.Type{
+	job.FluxMonitor,
+	job.OffchainReporting,
+	job.OffchainReporting2,
+	job.Bootstrap,
+}
+
+// AutoApprovalPolicy defines which job proposal specs sent by a Feeds Manager
+// are approved without waiting for a node operator.
+//
+// A spec is approved automatically only if the policy is enabled and the spec
+// matches every restriction of the policy. Empty restrictions, other than the
+// job types, do not restrict the spec.
+type AutoApprovalPolicy struct {
+	FeedsManagerID int64
+	Enabled        bool
+	// JobTypes are the job types which may be approved, e.g. `offchainreporting2`.
+	JobTypes pq.StringArray
+	// ContractAddresses are the contract addresses of the jobs which may be
+	// approved.
+	ContractAddresses pq.StringArray
+	// EVMChainIDs are the chain IDs of the jobs which may be approved.
+	EVMChainIDs pq.StringArray `db:"evm_chain_ids"`
+	// MaxVersionBump is the maximum difference between the version of the spec
+	// and the version of the approved spec of the job proposal, or zero for no
+	// limit. A job proposal without an approved spec is compared to version 0.
+	MaxVersionBump int32
+	// TimeWindows are the windows of time during which specs may be approved.
+	TimeWindows TimeWindows
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TimeWindow is a daily window of time, in UTC. If End is before Start, the
+// window spans midnight.
+type TimeWindow struct {
+	// Days are the days of the week the window applies to, e.g. `Monday`. The
+	// window applies to every day if it is empty.
+	Days []string `json:"days"`
+	// Start is the start of the window in the format `15:04`.
+	Start string `json:"start"`
+	// End is the end of the window in the format `15:04`.
+	End string `json:"end"`
+}
+
+// Validate checks the days and times of the window.
+func (w TimeWindow) Validate() (err error) {
+	for _, d := range w.Days {
+		if _, ok := parseWeekday(d); !ok {
+			err = multierr.Append(err, errors.Errorf("invalid day %q", d))
+		}
+	}
+	if _, perr := time.Parse("15:04", w.Start); perr != nil {
+		err = multierr.Append(err, errors.Errorf("invalid start time %q: must be in the format HH:MM", w.Start))
+	}
+	if _, perr := time.Parse("15:04", w.End); perr != nil {
+		err = multierr.Append(err, errors.Errorf("invalid end time %q: must be in the format HH:MM", w.End))
+	}
+	return err
+}
+
+// contains returns true if t is within the window.
+func (w TimeWindow) contains(t time.Time) bool {
+	t = t.UTC()
+	if len(w.Days) > 0 {
+		var dayMatches bool
+		for _, d := range w.Days {
+			if wd, ok := parseWeekday(d); ok && wd == t.Weekday() {
+				dayMatches = true
+				break
+			}
+		}
+		if !dayMatches {
+			return false
+		}
+	}
+
+	start, serr := time.Parse("15:04", w.Start)
+	end, eerr := time.Parse("15:04", w.End)
+	if serr != nil || eerr != nil {
+		return false
+	}
+	minute := t.Hour()*60 + t.Minute()
+	startMinute := start.Hour()*60 + start.Minute()
+	endMinute := end.Hour()*60 + end.Minute()
+	if endMinute < startMinute {
+		return minute >= startMinute || minute < endMinute
+	}
+	return minute >= startMinute && minute < endMinute
+}
+
+func parseWeekday(s string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(s, d.String()) {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// TimeWindows is a list of TimeWindow which is stored as JSON.
+type TimeWindows []TimeWindow
+
+func (ws TimeWindows) Value() (driver.Value, error) {
+	if ws == nil {
+		ws = TimeWindows{}
+	}
+	return json.Marshal(ws)
+}
+
+func (ws *TimeWindows) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, ws)
+}
+
+// Validate checks that the restrictions of the policy are well formed.
+func (p AutoApprovalPolicy) Validate() (err error) {
+	if p.Enabled && len(p.JobTypes) == 0 {
+		err = multierr.Append(err, errors.New("at least one job type must be allowed"))
+	}
+	for _, t := range p.JobTypes {
+		if !isAutoApprovableJobType(t) {
+			err = multierr.Append(err, errors.Errorf("invalid job type %q", t))
+		}
+	}
+	for _, a := range p.ContractAddresses {
+		if !common.IsHexAddress(a) {
+			err = multierr.Append(err, errors.Errorf("invalid contract address %q", a))
+		}
+	}
+	for _, id := range p.EVMChainIDs {
+		if _, ok := new(big.Int).SetString(id, 10); !ok {
+			err = multierr.Append(err, errors.Errorf("invalid EVM chain ID %q", id))
+		}
+	}
+	if p.MaxVersionBump < 0 {
+		err = multierr.Append(err, errors.New("max version bump must not be negative"))
+	}
+	for i, w := range p.TimeWindows {
+		if werr := w.Validate(); werr != nil {
+			err = multierr.Append(err, errors.Wrapf(werr, "time window %d", i))
+		}
+	}
+	return err
+}
+
+func isAutoApprovableJobType(t string) bool {
+	for _, jt := range autoApprovableJobTypes {
+		if t == jt.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// autoApprovalCandidate describes a spec which is evaluated against an
+// AutoApprovalPolicy.
+type autoApprovalCandidate struct {
+	jobType         job.Type
+	contractAddress common.Address
+	evmChainID      *utils.Big
+	version         int32
+	approvedVersion int32
+}
+
+// evaluate returns nil if the policy allows the candidate to be approved
+// automatically at time now, or an error describing why it does not.
+func (p AutoApprovalPolicy) evaluate(c autoApprovalCandidate, now time.Time) error {
+	if !p.Enabled {
+		return errors.New("auto-approval policy is disabled")
+	}
+
+	if !containsString(p.JobTypes, c.jobType.String()) {
+		return errors.Errorf("job type %s is not allowed", c.jobType)
+	}
+
+	if len(p.ContractAddresses) > 0 {
+		var allowed bool
+		for _, a := range p.ContractAddresses {
+			if common.HexToAddress(a) == c.contractAddress {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return errors.Errorf("contract address %s is not allowed", c.contractAddress)
+		}
+	}
+
+	if len(p.EVMChainIDs) > 0 {
+		var allowed bool
+		for _, id := range p.EVMChainIDs {
+			if bid, ok := new(big.Int).SetString(id, 10); ok && c.evmChainID != nil && bid.Cmp(c.evmChainID.ToInt()) == 0 {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return errors.Errorf("EVM chain ID %s is not allowed", c.evmChainID)
+		}
+	}
+
+	if bump := c.version - c.approvedVersion; p.MaxVersionBump > 0 && bump > p.MaxVersionBump {
+		return errors.Errorf("version bump from %d to %d exceeds the maximum of %d", c.approvedVersion, c.version, p.MaxVersionBump)
+	}
+
+	if len(p.TimeWindows) > 0 {
+		var allowed bool
+		for _, w := range p.TimeWindows {
+			if w.contains(now) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return errors.Errorf("%s is outside of the allowed time windows", now.UTC().Format(time.RFC3339))
+		}
+	}
+
+	return nil
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
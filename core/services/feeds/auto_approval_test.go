@@ -0,0 +1,184 @@
+package feeds
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func Test_AutoApprovalPolicy_Validate(t *testing.T) {
+	t.Parallel()
+
+	valid := AutoApprovalPolicy{
+		Enabled:           true,
+		JobTypes:          pq.StringArray{"offchainreporting2", "bootstrap"},
+		ContractAddresses: pq.StringArray{"0x613a38AC1659769640aaE063C651F48E0250454C"},
+		EVMChainIDs:       pq.StringArray{"1", "42161"},
+		MaxVersionBump:    1,
+		TimeWindows: TimeWindows{
+			{Days: []string{"Monday", "friday"}, Start: "09:00", End: "17:30"},
+		},
+	}
+	require.NoError(t, valid.Validate())
+
+	// A disabled policy does not need to allow any job types
+	require.NoError(t, AutoApprovalPolicy{}.Validate())
+
+	invalid := AutoApprovalPolicy{
+		Enabled:           true,
+		JobTypes:          pq.StringArray{"webhook"},
+		ContractAddresses: pq.StringArray{"0x1234"},
+		EVMChainIDs:       pq.StringArray{"mainnet"},
+		MaxVersionBump:    -1,
+		TimeWindows: TimeWindows{
+			{Days: []string{"Someday"}, Start: "9am", End: "25:00"},
+		},
+	}
+	err := invalid.Validate()
+	require.Error(t, err)
+	for _, msg := range []string{
+		`invalid job type "webhook"`,
+		`invalid contract address "0x1234"`,
+		`invalid EVM chain ID "mainnet"`,
+		"max version bump must not be negative",
+		`time window 0: invalid day "Someday"`,
+		`invalid start time "9am"`,
+		`invalid end time "25:00"`,
+	} {
+		assert.Contains(t, err.Error(), msg)
+	}
+
+	err = AutoApprovalPolicy{Enabled: true}.Validate()
+	require.EqualError(t, err, "at least one job type must be allowed")
+}
+
+func Test_TimeWindow_contains(t *testing.T) {
+	t.Parallel()
+
+	// 2023-05-01 is a Monday
+	at := func(day int, hour, min int) time.Time {
+		return time.Date(2023, 5, day, hour, min, 0, 0, time.UTC)
+	}
+
+	testCases := []struct {
+		name   string
+		window TimeWindow
+		t      time.Time
+		want   bool
+	}{
+		{"within", TimeWindow{Start: "09:00", End: "17:00"}, at(1, 12, 0), true},
+		{"at start", TimeWindow{Start: "09:00", End: "17:00"}, at(1, 9, 0), true},
+		{"at end", TimeWindow{Start: "09:00", End: "17:00"}, at(1, 17, 0), false},
+		{"before", TimeWindow{Start: "09:00", End: "17:00"}, at(1, 8, 59), false},
+		{"spanning midnight, late", TimeWindow{Start: "22:00", End: "02:00"}, at(1, 23, 0), true},
+		{"spanning midnight, early", TimeWindow{Start: "22:00", End: "02:00"}, at(1, 1, 0), true},
+		{"spanning midnight, outside", TimeWindow{Start: "22:00", End: "02:00"}, at(1, 12, 0), false},
+		{"matching day", TimeWindow{Days: []string{"Monday"}, Start: "09:00", End: "17:00"}, at(1, 12, 0), true},
+		{"other day", TimeWindow{Days: []string{"Tuesday"}, Start: "09:00", End: "17:00"}, at(1, 12, 0), false},
+		{"converted to UTC", TimeWindow{Start: "09:00", End: "17:00"}, at(1, 12, 0).In(time.FixedZone("UTC+10", 10*60*60)), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.window.contains(tc.t))
+		})
+	}
+}
+
+func Test_AutoApprovalPolicy_evaluate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		address = common.HexToAddress("0x613a38AC1659769640aaE063C651F48E0250454C")
+		now     = time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+		policy  = AutoApprovalPolicy{
+			Enabled:           true,
+			JobTypes:          pq.StringArray{"offchainreporting2"},
+			ContractAddresses: pq.StringArray{"0x613a38ac1659769640aae063c651f48e0250454c"},
+			EVMChainIDs:       pq.StringArray{"1"},
+			MaxVersionBump:    1,
+			TimeWindows:       TimeWindows{{Start: "09:00", End: "17:00"}},
+		}
+		candidate = autoApprovalCandidate{
+			jobType:         job.OffchainReporting2,
+			contractAddress: address,
+			evmChainID:      utils.NewBig(big.NewInt(1)),
+			version:         2,
+			approvedVersion: 1,
+		}
+	)
+
+	require.NoError(t, policy.evaluate(candidate, now))
+
+	unrestricted := AutoApprovalPolicy{Enabled: true, JobTypes: pq.StringArray{"offchainreporting2"}}
+	c := candidate
+	c.version = 10
+	c.approvedVersion = 0
+	require.NoError(t, unrestricted.evaluate(c, now))
+
+	testCases := []struct {
+		name   string
+		update func(p *AutoApprovalPolicy, c *autoApprovalCandidate, now *time.Time)
+		err    string
+	}{
+		{
+			name:   "disabled",
+			update: func(p *AutoApprovalPolicy, c *autoApprovalCandidate, now *time.Time) { p.Enabled = false },
+			err:    "auto-approval policy is disabled",
+		},
+		{
+			name:   "job type",
+			update: func(p *AutoApprovalPolicy, c *autoApprovalCandidate, now *time.Time) { c.jobType = job.FluxMonitor },
+			err:    "job type fluxmonitor is not allowed",
+		},
+		{
+			name: "contract address",
+			update: func(p *AutoApprovalPolicy, c *autoApprovalCandidate, now *time.Time) {
+				c.contractAddress = common.HexToAddress("0x0000000000000000000000000000000000000001")
+			},
+			err: "contract address 0x0000000000000000000000000000000000000001 is not allowed",
+		},
+		{
+			name: "chain ID",
+			update: func(p *AutoApprovalPolicy, c *autoApprovalCandidate, now *time.Time) {
+				c.evmChainID = utils.NewBig(big.NewInt(5))
+			},
+			err: "EVM chain ID 5 is not allowed",
+		},
+		{
+			name:   "version bump",
+			update: func(p *AutoApprovalPolicy, c *autoApprovalCandidate, now *time.Time) { c.version = 3 },
+			err:    "version bump from 1 to 3 exceeds the maximum of 1",
+		},
+		{
+			name:   "new job proposal version bump",
+			update: func(p *AutoApprovalPolicy, c *autoApprovalCandidate, now *time.Time) { c.approvedVersion = 0 },
+			err:    "version bump from 0 to 2 exceeds the maximum of 1",
+		},
+		{
+			name: "time window",
+			update: func(p *AutoApprovalPolicy, c *autoApprovalCandidate, now *time.Time) {
+				*now = now.Add(6 * time.Hour)
+			},
+			err: "2023-05-01T18:00:00Z is outside of the allowed time windows",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			p, c, n := policy, candidate, now
+			tc.update(&p, &c, &n)
+			require.EqualError(t, p.evaluate(c, n), tc.err)
+		})
+	}
+}
@@ -11,6 +11,7 @@ type Config interface {
 	pg.QConfig
 	config.OCR2Config
 	OCRDevelopmentMode() bool
+	FeatureFeedsManagerAutoApproval() bool
 	FeatureOffchainReporting() bool
 	FeatureOffchainReporting2() bool
 	DefaultHTTPTimeout() models.Duration
@@ -862,6 +862,75 @@ func (_c *ORM_GetApprovedSpec_Call) RunAndReturn(run func(int64, ...pg.QOpt) (*f
 	return _c
 }
 
+// GetAutoApprovalPolicy provides a mock function with given fields: mgrID, qopts
+func (_m *ORM) GetAutoApprovalPolicy(mgrID int64, qopts ...pg.QOpt) (*feeds.AutoApprovalPolicy, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, mgrID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *feeds.AutoApprovalPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) (*feeds.AutoApprovalPolicy, error)); ok {
+		return rf(mgrID, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) *feeds.AutoApprovalPolicy); ok {
+		r0 = rf(mgrID, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*feeds.AutoApprovalPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, ...pg.QOpt) error); ok {
+		r1 = rf(mgrID, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetAutoApprovalPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAutoApprovalPolicy'
+type ORM_GetAutoApprovalPolicy_Call struct {
+	*mock.Call
+}
+
+// GetAutoApprovalPolicy is a helper method to define mock.On call
+//   - mgrID int64
+//   - qopts ...pg.QOpt
+func (_e *ORM_Expecter) GetAutoApprovalPolicy(mgrID interface{}, qopts ...interface{}) *ORM_GetAutoApprovalPolicy_Call {
+	return &ORM_GetAutoApprovalPolicy_Call{Call: _e.mock.On("GetAutoApprovalPolicy",
+		append([]interface{}{mgrID}, qopts...)...)}
+}
+
+func (_c *ORM_GetAutoApprovalPolicy_Call) Run(run func(mgrID int64, qopts ...pg.QOpt)) *ORM_GetAutoApprovalPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]pg.QOpt, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(pg.QOpt)
+			}
+		}
+		run(args[0].(int64), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ORM_GetAutoApprovalPolicy_Call) Return(_a0 *feeds.AutoApprovalPolicy, _a1 error) *ORM_GetAutoApprovalPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetAutoApprovalPolicy_Call) RunAndReturn(run func(int64, ...pg.QOpt) (*feeds.AutoApprovalPolicy, error)) *ORM_GetAutoApprovalPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetChainConfig provides a mock function with given fields: id
 func (_m *ORM) GetChainConfig(id int64) (*feeds.ChainConfig, error) {
 	ret := _m.Called(id)
@@ -1974,6 +2043,63 @@ func (_c *ORM_UpdateSpecDefinition_Call) RunAndReturn(run func(int64, string, ..
 	return _c
 }
 
+// UpsertAutoApprovalPolicy provides a mock function with given fields: policy, qopts
+func (_m *ORM) UpsertAutoApprovalPolicy(policy feeds.AutoApprovalPolicy, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, policy)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(feeds.AutoApprovalPolicy, ...pg.QOpt) error); ok {
+		r0 = rf(policy, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_UpsertAutoApprovalPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertAutoApprovalPolicy'
+type ORM_UpsertAutoApprovalPolicy_Call struct {
+	*mock.Call
+}
+
+// UpsertAutoApprovalPolicy is a helper method to define mock.On call
+//   - policy feeds.AutoApprovalPolicy
+//   - qopts ...pg.QOpt
+func (_e *ORM_Expecter) UpsertAutoApprovalPolicy(policy interface{}, qopts ...interface{}) *ORM_UpsertAutoApprovalPolicy_Call {
+	return &ORM_UpsertAutoApprovalPolicy_Call{Call: _e.mock.On("UpsertAutoApprovalPolicy",
+		append([]interface{}{policy}, qopts...)...)}
+}
+
+func (_c *ORM_UpsertAutoApprovalPolicy_Call) Run(run func(policy feeds.AutoApprovalPolicy, qopts ...pg.QOpt)) *ORM_UpsertAutoApprovalPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]pg.QOpt, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(pg.QOpt)
+			}
+		}
+		run(args[0].(feeds.AutoApprovalPolicy), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ORM_UpsertAutoApprovalPolicy_Call) Return(_a0 error) *ORM_UpsertAutoApprovalPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_UpsertAutoApprovalPolicy_Call) RunAndReturn(run func(feeds.AutoApprovalPolicy, ...pg.QOpt) error) *ORM_UpsertAutoApprovalPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertJobProposal provides a mock function with given fields: jp, qopts
 func (_m *ORM) UpsertJobProposal(jp *feeds.JobProposal, qopts ...pg.QOpt) (int64, error) {
 	_va := make([]interface{}, len(qopts))
@@ -178,6 +178,84 @@ func (_m *Service) DeleteJob(ctx context.Context, args *feeds.DeleteJobArgs) (in
 	return r0, r1
 }
 
+// DiffSpec provides a mock function with given fields: ctx, id
+func (_m *Service) DiffSpec(ctx context.Context, id int64) (*feeds.SpecDiff, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *feeds.SpecDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*feeds.SpecDiff, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *feeds.SpecDiff); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*feeds.SpecDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DryRunApproveSpec provides a mock function with given fields: ctx, id
+func (_m *Service) DryRunApproveSpec(ctx context.Context, id int64) (*feeds.SpecDryRun, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *feeds.SpecDryRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*feeds.SpecDryRun, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *feeds.SpecDryRun); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*feeds.SpecDryRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAutoApprovalPolicy provides a mock function with given fields: mgrID
+func (_m *Service) GetAutoApprovalPolicy(mgrID int64) (*feeds.AutoApprovalPolicy, error) {
+	ret := _m.Called(mgrID)
+
+	var r0 *feeds.AutoApprovalPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (*feeds.AutoApprovalPolicy, error)); ok {
+		return rf(mgrID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) *feeds.AutoApprovalPolicy); ok {
+		r0 = rf(mgrID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*feeds.AutoApprovalPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(mgrID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetChainConfig provides a mock function with given fields: id
 func (_m *Service) GetChainConfig(id int64) (*feeds.ChainConfig, error) {
 	ret := _m.Called(id)
@@ -581,6 +659,20 @@ func (_m *Service) Unsafe_SetConnectionsManager(_a0 feeds.ConnectionsManager) {
 	_m.Called(_a0)
 }
 
+// UpdateAutoApprovalPolicy provides a mock function with given fields: ctx, policy
+func (_m *Service) UpdateAutoApprovalPolicy(ctx context.Context, policy feeds.AutoApprovalPolicy) error {
+	ret := _m.Called(ctx, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, feeds.AutoApprovalPolicy) error); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateChainConfig provides a mock function with given fields: ctx, cfg
 func (_m *Service) UpdateChainConfig(ctx context.Context, cfg feeds.ChainConfig) (int64, error) {
 	ret := _m.Called(ctx, cfg)
@@ -32,6 +32,9 @@ type ORM interface {
 	ListChainConfigsByManagerIDs(mgrIDs []int64) ([]ChainConfig, error)
 	UpdateChainConfig(cfg ChainConfig) (int64, error)
 
+	GetAutoApprovalPolicy(mgrID int64, qopts ...pg.QOpt) (*AutoApprovalPolicy, error)
+	UpsertAutoApprovalPolicy(policy AutoApprovalPolicy, qopts ...pg.QOpt) error
+
 	CountJobProposals() (int64, error)
 	CountJobProposalsByStatus() (counts *JobProposalCounts, err error)
 	CreateJobProposal(jp *JobProposal) (int64, error)
@@ -238,6 +241,59 @@ RETURNING id;
 	return cfgID, errors.Wrap(err, "UpdateChainConfig failed")
 }
 
+// GetAutoApprovalPolicy fetches the auto-approval policy of a feeds manager.
+func (o *orm) GetAutoApprovalPolicy(mgrID int64, qopts ...pg.QOpt) (*AutoApprovalPolicy, error) {
+	stmt := `
+SELECT feeds_manager_id, enabled, job_types, contract_addresses, evm_chain_ids, max_version_bump, time_windows, created_at, updated_at
+FROM feeds_manager_auto_approval_policies
+WHERE feeds_manager_id = $1;
+`
+
+	var policy AutoApprovalPolicy
+	err := o.q.WithOpts(qopts...).Get(&policy, stmt, mgrID)
+
+	return &policy, errors.Wrap(err, "GetAutoApprovalPolicy failed")
+}
+
+// UpsertAutoApprovalPolicy creates the auto-approval policy of a feeds
+// manager, or replaces it if it exists.
+func (o *orm) UpsertAutoApprovalPolicy(policy AutoApprovalPolicy, qopts ...pg.QOpt) error {
+	stmt := `
+INSERT INTO feeds_manager_auto_approval_policies (feeds_manager_id, enabled, job_types, contract_addresses, evm_chain_ids, max_version_bump, time_windows, created_at, updated_at)
+VALUES ($1,$2,$3,$4,$5,$6,$7,NOW(),NOW())
+ON CONFLICT (feeds_manager_id)
+DO UPDATE SET
+	enabled = EXCLUDED.enabled,
+	job_types = EXCLUDED.job_types,
+	contract_addresses = EXCLUDED.contract_addresses,
+	evm_chain_ids = EXCLUDED.evm_chain_ids,
+	max_version_bump = EXCLUDED.max_version_bump,
+	time_windows = EXCLUDED.time_windows,
+	updated_at = EXCLUDED.updated_at;
+`
+
+	err := o.q.WithOpts(qopts...).ExecQ(stmt,
+		policy.FeedsManagerID,
+		policy.Enabled,
+		nonNilStringArray(policy.JobTypes),
+		nonNilStringArray(policy.ContractAddresses),
+		nonNilStringArray(policy.EVMChainIDs),
+		policy.MaxVersionBump,
+		policy.TimeWindows,
+	)
+
+	return errors.Wrap(err, "UpsertAutoApprovalPolicy failed")
+}
+
+// nonNilStringArray replaces a nil array with an empty array, which the NOT
+// NULL array columns accept.
+func nonNilStringArray(a pq.StringArray) pq.StringArray {
+	if a == nil {
+		return pq.StringArray{}
+	}
+	return a
+}
+
 // GetManager gets a feeds manager by id.
 func (o *orm) GetManager(id int64) (mgr *FeedsManager, err error) {
 	stmt := `
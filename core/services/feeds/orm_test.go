@@ -435,6 +435,53 @@ func Test_ORM_UpdateChainConfig(t *testing.T) {
 	}, *actual)
 }
 
+// Auto-approval policies
+
+func Test_ORM_UpsertAutoApprovalPolicy(t *testing.T) {
+	t.Parallel()
+
+	orm := setupORM(t)
+	fmID := createFeedsManager(t, orm)
+
+	_, err := orm.GetAutoApprovalPolicy(fmID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// Nil restrictions are stored as empty arrays
+	err = orm.UpsertAutoApprovalPolicy(feeds.AutoApprovalPolicy{FeedsManagerID: fmID})
+	require.NoError(t, err)
+
+	actual, err := orm.GetAutoApprovalPolicy(fmID)
+	require.NoError(t, err)
+	assert.Equal(t, fmID, actual.FeedsManagerID)
+	assert.False(t, actual.Enabled)
+	assert.Empty(t, actual.JobTypes)
+	assert.Empty(t, actual.TimeWindows)
+	assert.NotZero(t, actual.CreatedAt)
+
+	policy := feeds.AutoApprovalPolicy{
+		FeedsManagerID:    fmID,
+		Enabled:           true,
+		JobTypes:          pq.StringArray{"offchainreporting2"},
+		ContractAddresses: pq.StringArray{"0x613a38AC1659769640aaE063C651F48E0250454C"},
+		EVMChainIDs:       pq.StringArray{"1"},
+		MaxVersionBump:    2,
+		TimeWindows: feeds.TimeWindows{
+			{Days: []string{"Monday"}, Start: "09:00", End: "17:00"},
+		},
+	}
+	err = orm.UpsertAutoApprovalPolicy(policy)
+	require.NoError(t, err)
+
+	actual, err = orm.GetAutoApprovalPolicy(fmID)
+	require.NoError(t, err)
+	assert.True(t, actual.Enabled)
+	assert.Equal(t, policy.JobTypes, actual.JobTypes)
+	assert.Equal(t, policy.ContractAddresses, actual.ContractAddresses)
+	assert.Equal(t, policy.EVMChainIDs, actual.EVMChainIDs)
+	assert.Equal(t, policy.MaxVersionBump, actual.MaxVersionBump)
+	assert.Equal(t, policy.TimeWindows, actual.TimeWindows)
+}
+
 // Job Proposals
 
 func Test_ORM_CreateJobProposal(t *testing.T) {
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/google/uuid"
@@ -19,6 +21,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	pb "github.com/smartcontractkit/chainlink/v2/core/services/feeds/proto"
 	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -30,6 +33,7 @@ import (
 	ocr2 "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
 )
@@ -76,6 +80,9 @@ type Service interface {
 	ListChainConfigsByManagerIDs(mgrIDs []int64) ([]ChainConfig, error)
 	UpdateChainConfig(ctx context.Context, cfg ChainConfig) (int64, error)
 
+	GetAutoApprovalPolicy(mgrID int64) (*AutoApprovalPolicy, error)
+	UpdateAutoApprovalPolicy(ctx context.Context, policy AutoApprovalPolicy) error
+
 	DeleteJob(ctx context.Context, args *DeleteJobArgs) (int64, error)
 	IsJobManaged(ctx context.Context, jobID int64) (bool, error)
 	ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64, error)
@@ -89,6 +96,8 @@ type Service interface {
 
 	ApproveSpec(ctx context.Context, id int64, force bool) error
 	CancelSpec(ctx context.Context, id int64) error
+	DiffSpec(ctx context.Context, id int64) (*SpecDiff, error)
+	DryRunApproveSpec(ctx context.Context, id int64) (*SpecDryRun, error)
 	GetSpec(id int64) (*JobProposalSpec, error)
 	ListSpecsByJobProposalIDs(ids []int64) ([]JobProposalSpec, error)
 	RejectSpec(ctx context.Context, id int64) error
@@ -104,6 +113,7 @@ type service struct {
 	jobORM       job.ORM
 	q            pg.Q
 	csaKeyStore  keystore.CSA
+	ethKeyStore  keystore.Eth
 	p2pKeyStore  keystore.P2P
 	ocr1KeyStore keystore.OCR
 	ocr2KeyStore keystore.OCR2
@@ -112,7 +122,11 @@ type service struct {
 	connMgr      ConnectionsManager
 	chainSet     evm.ChainSet
 	lggr         logger.Logger
+	auditLogger  audit.AuditLogger
 	version      string
+
+	chStop utils.StopChan
+	wg     sync.WaitGroup
 }
 
 // NewService constructs a new feeds service
@@ -125,6 +139,7 @@ func NewService(
 	cfg Config,
 	chainSet evm.ChainSet,
 	lggr logger.Logger,
+	auditLogger audit.AuditLogger,
 	version string,
 ) *service {
 	lggr = lggr.Named("Feeds")
@@ -135,13 +150,16 @@ func NewService(
 		jobSpawner:   jobSpawner,
 		p2pKeyStore:  keyStore.P2P(),
 		csaKeyStore:  keyStore.CSA(),
+		ethKeyStore:  keyStore.Eth(),
 		ocr1KeyStore: keyStore.OCR(),
 		ocr2KeyStore: keyStore.OCR2(),
 		cfg:          cfg,
 		connMgr:      newConnectionsManager(lggr),
 		chainSet:     chainSet,
 		lggr:         lggr,
+		auditLogger:  auditLogger,
 		version:      version,
+		chStop:       make(chan struct{}),
 	}
 
 	return svc
@@ -396,6 +414,34 @@ func (s *service) UpdateChainConfig(ctx context.Context, cfg ChainConfig) (int64
 	return id, nil
 }
 
+// GetAutoApprovalPolicy gets the auto-approval policy of a feeds manager.
+func (s *service) GetAutoApprovalPolicy(mgrID int64) (*AutoApprovalPolicy, error) {
+	policy, err := s.orm.GetAutoApprovalPolicy(mgrID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetAutoApprovalPolicy failed")
+	}
+
+	return policy, nil
+}
+
+// UpdateAutoApprovalPolicy validates and saves the auto-approval policy of a
+// feeds manager.
+func (s *service) UpdateAutoApprovalPolicy(ctx context.Context, policy AutoApprovalPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return errors.Wrap(err, "invalid auto-approval policy")
+	}
+
+	if _, err := s.orm.GetManager(policy.FeedsManagerID); err != nil {
+		return errors.Wrap(err, "GetManager failed")
+	}
+
+	if err := s.orm.UpsertAutoApprovalPolicy(policy, pg.WithParentCtx(ctx)); err != nil {
+		return errors.Wrap(err, "UpsertAutoApprovalPolicy failed")
+	}
+
+	return nil
+}
+
 // Lists all JobProposals
 //
 // When we support multiple feed managers, we will need to change this to filter
@@ -536,7 +582,7 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 		}
 	}
 
-	var id int64
+	var id, specID int64
 	q := s.q.WithOpts(pg.WithParentCtx(ctx))
 	err = q.Transaction(func(tx pg.Queryer) error {
 		var txerr error
@@ -557,7 +603,7 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 		}
 
 		// Create the spec version
-		_, txerr = s.orm.CreateSpec(JobProposalSpec{
+		specID, txerr = s.orm.CreateSpec(JobProposalSpec{
 			Definition:    args.Spec,
 			Status:        SpecStatusPending,
 			Version:       args.Version,
@@ -580,6 +626,18 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 		return 0, err
 	}
 
+	// Approval notifies the feeds manager, so it must not block the handling
+	// of the proposal.
+	if s.cfg.FeatureFeedsManagerAutoApproval() {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			actx, cancel := s.chStop.NewCtx()
+			defer cancel()
+			s.autoApproveSpec(actx, args.FeedsManagerID, specID)
+		}()
+	}
+
 	return id, nil
 }
 
@@ -785,6 +843,195 @@ func (s *service) ApproveSpec(ctx context.Context, id int64, force bool) error {
 	return nil
 }
 
+// DiffSpec compares the definition of a spec for a job proposal to the
+// definition of the approved spec the job is currently running. If the job
+// proposal has no approved spec, every key of the spec is reported as added.
+func (s *service) DiffSpec(ctx context.Context, id int64) (*SpecDiff, error) {
+	pctx := pg.WithParentCtx(ctx)
+
+	spec, err := s.orm.GetSpec(id, pctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: job proposal spec")
+	}
+
+	diff := &SpecDiff{Spec: spec}
+	var current string
+	approvedSpec, err := s.orm.GetApprovedSpec(spec.JobProposalID, pctx)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(err, "orm: approved job proposal spec")
+		}
+	} else {
+		diff.ApprovedSpec = approvedSpec
+		current = approvedSpec.Definition
+	}
+
+	if diff.Changes, err = diffSpecDefinitions(current, spec.Definition); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// DryRunApproveSpec runs the checks that approving a spec for a job proposal
+// would run, without creating the job or updating the spec. The spec is
+// validated by the delegate of its job type, and the chain, bridges and keys
+// it refers to are looked up. Any reasons the approval would fail are
+// returned as errors of the result, rather than as an error.
+func (s *service) DryRunApproveSpec(ctx context.Context, id int64) (*SpecDryRun, error) {
+	pctx := pg.WithParentCtx(ctx)
+
+	spec, err := s.orm.GetSpec(id, pctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: job proposal spec")
+	}
+
+	proposal, err := s.orm.GetJobProposal(spec.JobProposalID, pctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: job proposal")
+	}
+
+	res := &SpecDryRun{Spec: spec, Errors: []string{}, Warnings: []string{}}
+	addErr := func(err error) { res.Errors = append(res.Errors, err.Error()) }
+
+	if err = s.isApprovable(proposal.Status, proposal.ID, spec.Status, spec.ID); err != nil {
+		addErr(err)
+	}
+
+	if _, err = s.connMgr.GetClient(proposal.FeedsManagerID); err != nil {
+		addErr(errors.Wrap(err, "fms rpc client"))
+	}
+
+	j, err := s.generateJob(spec.Definition)
+	if err != nil {
+		// the remaining checks need the job
+		addErr(errors.Wrap(err, "could not generate job from spec"))
+		return res, nil
+	}
+
+	if err = s.jobORM.AssertBridgesExist(j.Pipeline); err != nil {
+		addErr(errors.Wrap(err, "bridge check failed"))
+	}
+
+	for _, err = range s.checkJobKeys(j) {
+		addErr(err)
+	}
+
+	address, evmChainID, err := s.getAddressAndEVMChainIDFromJob(j)
+	if err != nil {
+		addErr(err)
+		return res, nil
+	}
+
+	existingJobID, err := s.jobORM.FindJobIDByAddress(address, evmChainID, pctx)
+	if err == nil {
+		res.Warnings = append(res.Warnings, fmt.Sprintf("job %d for contract address %s will be replaced; approval requires the force option", existingJobID, address))
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "FindJobIDByAddress failed")
+	}
+
+	return res, nil
+}
+
+// autoApproveSpec approves a newly proposed spec if it matches the
+// auto-approval policy of its feeds manager. Every decision is recorded in the
+// audit log.
+func (s *service) autoApproveSpec(ctx context.Context, mgrID int64, specID int64) {
+	lggr := s.lggr.With("feeds_manager_id", mgrID, "job_proposal_spec_id", specID)
+
+	policy, err := s.orm.GetAutoApprovalPolicy(mgrID, pg.WithParentCtx(ctx))
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			lggr.Errorw("Failed to get auto-approval policy", "err", err)
+		}
+		return
+	}
+	if !policy.Enabled {
+		return
+	}
+
+	candidate, force, err := s.autoApprovalCandidate(ctx, specID)
+	if err == nil {
+		err = policy.evaluate(candidate, time.Now())
+	}
+	if err == nil {
+		if err = s.ApproveSpec(ctx, specID, force); err != nil {
+			err = errors.Wrap(err, "approval failed")
+		}
+	}
+
+	if err != nil {
+		lggr.Infow("Job proposal spec was not approved automatically", "reason", err)
+		s.auditLogger.Audit(audit.JobProposalSpecAutoApprovalDenied, map[string]interface{}{
+			"feedsManagerID":    mgrID,
+			"jobProposalSpecID": specID,
+			"reason":            err.Error(),
+		})
+		return
+	}
+
+	lggr.Infow("Job proposal spec was approved automatically")
+	s.auditLogger.Audit(audit.JobProposalSpecAutoApproved, map[string]interface{}{
+		"feedsManagerID":    mgrID,
+		"jobProposalSpecID": specID,
+	})
+}
+
+// autoApprovalCandidate describes the spec for evaluation against an
+// auto-approval policy. Approval must replace the job running for the contract
+// address of the spec, which is only allowed if that job was created from the
+// same job proposal, so that auto-approval never removes another proposal's
+// job or one created by an operator.
+func (s *service) autoApprovalCandidate(ctx context.Context, specID int64) (c autoApprovalCandidate, force bool, err error) {
+	pctx := pg.WithParentCtx(ctx)
+
+	spec, err := s.orm.GetSpec(specID, pctx)
+	if err != nil {
+		return c, false, errors.Wrap(err, "orm: job proposal spec")
+	}
+	c.version = spec.Version
+
+	approvedSpec, err := s.orm.GetApprovedSpec(spec.JobProposalID, pctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return c, false, errors.Wrap(err, "orm: approved job proposal spec")
+	}
+	if err == nil {
+		c.approvedVersion = approvedSpec.Version
+	}
+
+	j, err := s.generateJob(spec.Definition)
+	if err != nil {
+		return c, false, errors.Wrap(err, "could not generate job from spec")
+	}
+	c.jobType = j.Type
+
+	address, evmChainID, err := s.getAddressAndEVMChainIDFromJob(j)
+	if err != nil {
+		return c, false, err
+	}
+	c.contractAddress = address.Address()
+	c.evmChainID = evmChainID
+
+	existingJobID, err := s.jobORM.FindJobIDByAddress(address, evmChainID, pctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return c, false, nil
+	} else if err != nil {
+		return c, false, errors.Wrap(err, "FindJobIDByAddress failed")
+	}
+	proposal, err := s.orm.GetJobProposal(spec.JobProposalID, pctx)
+	if err != nil {
+		return c, false, errors.Wrap(err, "orm: job proposal")
+	}
+	existingJob, err := s.jobORM.FindJob(ctx, existingJobID)
+	if err != nil {
+		return c, false, errors.Wrap(err, "FindJob failed")
+	}
+	if !proposal.ExternalJobID.Valid || proposal.ExternalJobID.UUID != existingJob.ExternalJobID {
+		return c, false, errors.Errorf("job %d for contract address %s was not created by this job proposal", existingJobID, address)
+	}
+
+	return c, true, nil
+}
+
 // CancelSpec cancels a spec for a job proposal.
 func (s *service) CancelSpec(ctx context.Context, id int64) error {
 	pctx := pg.WithParentCtx(ctx)
@@ -910,6 +1157,9 @@ func (s *service) Start(ctx context.Context) error {
 // Close shuts down the service
 func (s *service) Close() error {
 	return s.StopOnce("FeedsService", func() error {
+		close(s.chStop)
+		s.wg.Wait()
+
 		// This blocks until it finishes
 		s.connMgr.Close()
 
@@ -1029,6 +1279,47 @@ func (s *service) getAddressAndEVMChainIDFromJob(j *job.Job) (ethkey.EIP55Addres
 	return address, evmChainID, nil
 }
 
+// checkJobKeys checks that the keys a job refers to exist in the keystore.
+// Keys which are not set in the job, and default to the node's configuration,
+// are not checked.
+func (s *service) checkJobKeys(j *job.Job) (errs []error) {
+	switch j.Type {
+	case job.OffchainReporting:
+		spec := j.OCROracleSpec
+		if spec.EncryptedOCRKeyBundleID != nil {
+			if _, err := s.ocr1KeyStore.Get(spec.EncryptedOCRKeyBundleID.String()); err != nil {
+				errs = append(errs, errors.Wrapf(err, "OCR key bundle %s not found", spec.EncryptedOCRKeyBundleID))
+			}
+		}
+		if spec.TransmitterAddress != nil && spec.EVMChainID != nil {
+			if err := s.ethKeyStore.CheckEnabled(spec.TransmitterAddress.Address(), spec.EVMChainID.ToInt()); err != nil {
+				errs = append(errs, errors.Wrapf(err, "transmitter address %s is not usable", spec.TransmitterAddress))
+			}
+		}
+	case job.OffchainReporting2:
+		spec := j.OCR2OracleSpec
+		if spec.OCRKeyBundleID.Valid {
+			if _, err := s.ocr2KeyStore.Get(spec.OCRKeyBundleID.String); err != nil {
+				errs = append(errs, errors.Wrapf(err, "OCR2 key bundle %s not found", spec.OCRKeyBundleID.String))
+			}
+		}
+		// Mercury transmits with the CSA key, other EVM plugins with an eth key
+		if spec.TransmitterID.Valid && spec.Relay == relay.EVM && spec.PluginType != job.Mercury {
+			chain, err := job.EVMChainForJob(j, s.chainSet)
+			if err != nil {
+				// reported when looking up the contract address
+				break
+			}
+			if !common.IsHexAddress(spec.TransmitterID.String) {
+				errs = append(errs, errors.Errorf("transmitterID %s is not a valid address", spec.TransmitterID.String))
+			} else if err = s.ethKeyStore.CheckEnabled(common.HexToAddress(spec.TransmitterID.String), chain.ID()); err != nil {
+				errs = append(errs, errors.Wrapf(err, "transmitter address %s is not usable", spec.TransmitterID.String))
+			}
+		}
+	}
+	return errs
+}
+
 // generateJob validates and generates a job from a spec.
 func (s *service) generateJob(spec string) (*job.Job, error) {
 	jobType, err := job.ValidateSpec(spec)
@@ -1312,6 +1603,12 @@ func (ns NullService) CountJobProposalsByStatus() (*JobProposalCounts, error) {
 func (ns NullService) CancelSpec(ctx context.Context, id int64) error {
 	return ErrFeedsManagerDisabled
 }
+func (ns NullService) DiffSpec(ctx context.Context, id int64) (*SpecDiff, error) {
+	return nil, ErrFeedsManagerDisabled
+}
+func (ns NullService) DryRunApproveSpec(ctx context.Context, id int64) (*SpecDryRun, error) {
+	return nil, ErrFeedsManagerDisabled
+}
 func (ns NullService) GetJobProposal(id int64) (*JobProposal, error) {
 	return nil, ErrFeedsManagerDisabled
 }
@@ -1343,6 +1640,12 @@ func (ns NullService) ListChainConfigsByManagerIDs(mgrIDs []int64) ([]ChainConfi
 func (ns NullService) UpdateChainConfig(ctx context.Context, cfg ChainConfig) (int64, error) {
 	return 0, ErrFeedsManagerDisabled
 }
+func (ns NullService) GetAutoApprovalPolicy(mgrID int64) (*AutoApprovalPolicy, error) {
+	return nil, ErrFeedsManagerDisabled
+}
+func (ns NullService) UpdateAutoApprovalPolicy(ctx context.Context, policy AutoApprovalPolicy) error {
+	return ErrFeedsManagerDisabled
+}
 func (ns NullService) ListJobProposals() ([]JobProposal, error) { return nil, nil }
 func (ns NullService) ListJobProposalsByManagersIDs(ids []int64) ([]JobProposal, error) {
 	return nil, ErrFeedsManagerDisabled
@@ -19,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds/mocks"
@@ -147,6 +148,7 @@ type TestService struct {
 	p2pKeystore  *ksmocks.P2P
 	ocr1Keystore *ksmocks.OCR
 	ocr2Keystore *ksmocks.OCR2
+	auditLogger  *testAuditLogger
 	cc           evm.ChainSet
 }
 
@@ -185,7 +187,8 @@ func setupTestServiceCfg(t *testing.T, overrideCfg func(c *chainlink.Config, s *
 	keyStore.On("P2P").Return(p2pKeystore)
 	keyStore.On("OCR").Return(ocr1Keystore)
 	keyStore.On("OCR2").Return(ocr2Keystore)
-	svc := feeds.NewService(orm, jobORM, db, spawner, keyStore, scopedConfig, cc, lggr, "1.0.0")
+	auditLogger := &testAuditLogger{AuditLogger: audit.NoopLogger, events: make(chan audit.EventID, 10)}
+	svc := feeds.NewService(orm, jobORM, db, spawner, keyStore, scopedConfig, cc, lggr, auditLogger, "1.0.0")
 	svc.SetConnectionsManager(connMgr)
 
 	return &TestService{
@@ -199,10 +202,21 @@ func setupTestServiceCfg(t *testing.T, overrideCfg func(c *chainlink.Config, s *
 		p2pKeystore:  p2pKeystore,
 		ocr1Keystore: ocr1Keystore,
 		ocr2Keystore: ocr2Keystore,
+		auditLogger:  auditLogger,
 		cc:           cc,
 	}
 }
 
+// testAuditLogger records the events sent to the audit log.
+type testAuditLogger struct {
+	audit.AuditLogger
+	events chan audit.EventID
+}
+
+func (l *testAuditLogger) Audit(eventID audit.EventID, data audit.Data) {
+	l.events <- eventID
+}
+
 func Test_Service_RegisterManager(t *testing.T) {
 	t.Parallel()
 
@@ -781,6 +795,207 @@ func Test_Service_ProposeJob(t *testing.T) {
 	}
 }
 
+func Test_Service_ProposeJob_AutoApproval(t *testing.T) {
+	t.Parallel()
+
+	var (
+		jpID       = int64(1)
+		specID     = int64(2)
+		remoteUUID = uuid.New()
+		args       = &feeds.ProposeJobArgs{
+			FeedsManagerID: 1,
+			RemoteUUID:     remoteUUID,
+			Spec:           BootstrapTestSpec,
+			Version:        1,
+		}
+		spec = &feeds.JobProposalSpec{
+			ID:            specID,
+			Definition:    BootstrapTestSpec,
+			Status:        feeds.SpecStatusPending,
+			Version:       args.Version,
+			JobProposalID: jpID,
+		}
+		jp = &feeds.JobProposal{
+			ID:             jpID,
+			FeedsManagerID: args.FeedsManagerID,
+			RemoteUUID:     remoteUUID,
+			Status:         feeds.JobProposalStatusPending,
+		}
+		address         = ethkey.EIP55AddressFromAddress(common.HexToAddress("0x613a38AC1659769640aaE063C651F48E0250454C"))
+		evmChainID      = utils.NewBig(big.NewInt(1337))
+		externalJobID   = uuid.New()
+		existingJobID   = int32(5)
+		approvingPolicy = feeds.AutoApprovalPolicy{
+			FeedsManagerID: args.FeedsManagerID,
+			Enabled:        true,
+			JobTypes:       pq.StringArray{"bootstrap"},
+			EVMChainIDs:    pq.StringArray{"1337"},
+		}
+	)
+	// the proposal already has a running job
+	jpWithJob := *jp
+	jpWithJob.ExternalJobID = uuid.NullUUID{UUID: externalJobID, Valid: true}
+
+	proposeMocks := func(svc *TestService, policy *feeds.AutoApprovalPolicy) {
+		svc.orm.On("GetJobProposalByRemoteUUID", remoteUUID).Return(new(feeds.JobProposal), sql.ErrNoRows)
+		svc.orm.On("UpsertJobProposal", mock.Anything, mock.Anything).Return(jpID, nil)
+		svc.orm.On("CreateSpec", mock.Anything, mock.Anything).Return(specID, nil)
+		svc.orm.On("CountJobProposalsByStatus").Return(&feeds.JobProposalCounts{}, nil)
+		svc.orm.On("GetAutoApprovalPolicy", args.FeedsManagerID, mock.Anything).Return(policy, nil)
+		svc.orm.On("GetSpec", specID, mock.Anything).Return(spec, nil)
+		svc.orm.On("GetApprovedSpec", jpID, mock.Anything).Return(nil, sql.ErrNoRows)
+	}
+
+	testCases := []struct {
+		name      string
+		policy    feeds.AutoApprovalPolicy
+		before    func(svc *TestService)
+		wantEvent audit.EventID
+	}{
+		{
+			name:   "approved",
+			policy: approvingPolicy,
+			before: func(svc *TestService) {
+				svc.orm.On("GetJobProposal", jpID, mock.Anything).Return(jp, nil)
+				svc.connMgr.On("GetClient", args.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.jobORM.On("AssertBridgesExist", mock.IsType(pipeline.Pipeline{})).Return(nil)
+				svc.jobORM.On("FindJobIDByAddress", address, evmChainID, mock.Anything).Return(int32(0), sql.ErrNoRows)
+				svc.spawner.On("CreateJob", mock.Anything, mock.Anything).Return(nil)
+				svc.orm.On("ApproveSpec", specID, mock.Anything, mock.Anything).Return(nil)
+				svc.fmsClient.On("ApprovedJob", mock.Anything, &proto.ApprovedJobRequest{
+					Uuid:    remoteUUID.String(),
+					Version: int64(spec.Version),
+				}).Return(&proto.ApprovedJobResponse{}, nil)
+			},
+			wantEvent: audit.JobProposalSpecAutoApproved,
+		},
+		{
+			name:   "replaces the job of the proposal",
+			policy: approvingPolicy,
+			before: func(svc *TestService) {
+				svc.orm.On("GetJobProposal", jpID, mock.Anything).Return(&jpWithJob, nil)
+				svc.connMgr.On("GetClient", args.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.jobORM.On("AssertBridgesExist", mock.IsType(pipeline.Pipeline{})).Return(nil)
+				svc.jobORM.On("FindJobIDByAddress", address, evmChainID, mock.Anything).Return(existingJobID, nil)
+				svc.jobORM.On("FindJob", mock.Anything, existingJobID).Return(job.Job{ID: existingJobID, ExternalJobID: externalJobID}, nil)
+				svc.spawner.On("DeleteJob", existingJobID, mock.Anything).Return(nil)
+				svc.spawner.On("CreateJob", mock.Anything, mock.Anything).Return(nil)
+				svc.orm.On("ApproveSpec", specID, mock.Anything, mock.Anything).Return(nil)
+				svc.fmsClient.On("ApprovedJob", mock.Anything, &proto.ApprovedJobRequest{
+					Uuid:    remoteUUID.String(),
+					Version: int64(spec.Version),
+				}).Return(&proto.ApprovedJobResponse{}, nil)
+			},
+			wantEvent: audit.JobProposalSpecAutoApproved,
+		},
+		{
+			name:   "denied if another job runs for the contract address",
+			policy: approvingPolicy,
+			before: func(svc *TestService) {
+				svc.orm.On("GetJobProposal", jpID, mock.Anything).Return(&jpWithJob, nil)
+				svc.jobORM.On("FindJobIDByAddress", address, evmChainID, mock.Anything).Return(existingJobID, nil)
+				svc.jobORM.On("FindJob", mock.Anything, existingJobID).Return(job.Job{ID: existingJobID, ExternalJobID: uuid.New()}, nil)
+			},
+			wantEvent: audit.JobProposalSpecAutoApprovalDenied,
+		},
+		{
+			name: "denied by policy",
+			policy: feeds.AutoApprovalPolicy{
+				FeedsManagerID: args.FeedsManagerID,
+				Enabled:        true,
+				JobTypes:       pq.StringArray{"fluxmonitor"},
+			},
+			before: func(svc *TestService) {
+				svc.jobORM.On("FindJobIDByAddress", address, evmChainID, mock.Anything).Return(int32(0), sql.ErrNoRows)
+			},
+			wantEvent: audit.JobProposalSpecAutoApprovalDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			svc := setupTestServiceCfg(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+				c.Feature.FeedsManagerAutoApproval = testutils.Ptr(true)
+			})
+			proposeMocks(svc, &tc.policy)
+			if tc.before != nil {
+				tc.before(svc)
+			}
+
+			id, err := svc.ProposeJob(testutils.Context(t), args)
+			require.NoError(t, err)
+			assert.Equal(t, jpID, id)
+
+			select {
+			case event := <-svc.auditLogger.events:
+				assert.Equal(t, tc.wantEvent, event)
+			case <-time.After(testutils.WaitTimeout(t)):
+				t.Fatal("timed out waiting for the auto-approval decision")
+			}
+		})
+	}
+}
+
+func Test_Service_UpdateAutoApprovalPolicy(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mgrID  = int64(1)
+		policy = feeds.AutoApprovalPolicy{
+			FeedsManagerID: mgrID,
+			Enabled:        true,
+			JobTypes:       pq.StringArray{"offchainreporting2"},
+		}
+	)
+
+	testCases := []struct {
+		name    string
+		policy  feeds.AutoApprovalPolicy
+		before  func(svc *TestService)
+		wantErr string
+	}{
+		{
+			name:   "success",
+			policy: policy,
+			before: func(svc *TestService) {
+				svc.orm.On("GetManager", mgrID).Return(&feeds.FeedsManager{ID: mgrID}, nil)
+				svc.orm.On("UpsertAutoApprovalPolicy", policy, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name:    "invalid policy",
+			policy:  feeds.AutoApprovalPolicy{FeedsManagerID: mgrID, Enabled: true},
+			wantErr: "invalid auto-approval policy: at least one job type must be allowed",
+		},
+		{
+			name:   "feeds manager not found",
+			policy: policy,
+			before: func(svc *TestService) {
+				svc.orm.On("GetManager", mgrID).Return(nil, sql.ErrNoRows)
+			},
+			wantErr: "GetManager failed: sql: no rows in result set",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			svc := setupTestService(t)
+			if tc.before != nil {
+				tc.before(svc)
+			}
+
+			err := svc.UpdateAutoApprovalPolicy(testutils.Context(t), tc.policy)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func Test_Service_DeleteJob(t *testing.T) {
 	t.Parallel()
 
@@ -2832,6 +3047,224 @@ chainID = 0
 	}
 }
 
+func Test_Service_DiffSpec(t *testing.T) {
+	var (
+		ctx          = testutils.Context(t)
+		approvedSpec = &feeds.JobProposalSpec{
+			ID:            20,
+			Status:        feeds.SpecStatusApproved,
+			JobProposalID: 1,
+			Version:       1,
+			Definition:    "type = 'bootstrap'\ncontractID = '0x0000000000000000000000000000000000000001'\n",
+		}
+		spec = &feeds.JobProposalSpec{
+			ID:            21,
+			Status:        feeds.SpecStatusPending,
+			JobProposalID: 1,
+			Version:       2,
+			Definition:    "type = 'bootstrap'\ncontractID = '0x0000000000000000000000000000000000000002'\n",
+		}
+	)
+
+	testCases := []struct {
+		name    string
+		before  func(svc *TestService)
+		want    *feeds.SpecDiff
+		wantErr string
+	}{
+		{
+			name: "diff against the approved spec",
+			before: func(svc *TestService) {
+				svc.orm.On("GetSpec", spec.ID, mock.Anything).Return(spec, nil)
+				svc.orm.On("GetApprovedSpec", spec.JobProposalID, mock.Anything).Return(approvedSpec, nil)
+			},
+			want: &feeds.SpecDiff{
+				Spec:         spec,
+				ApprovedSpec: approvedSpec,
+				Changes: []feeds.SpecChange{{
+					Path:     "contractID",
+					Type:     feeds.SpecChangeTypeModified,
+					OldValue: null.StringFrom("'0x0000000000000000000000000000000000000001'"),
+					NewValue: null.StringFrom("'0x0000000000000000000000000000000000000002'"),
+				}},
+			},
+		},
+		{
+			name: "no approved spec",
+			before: func(svc *TestService) {
+				svc.orm.On("GetSpec", spec.ID, mock.Anything).Return(spec, nil)
+				svc.orm.On("GetApprovedSpec", spec.JobProposalID, mock.Anything).Return(nil, sql.ErrNoRows)
+			},
+			want: &feeds.SpecDiff{
+				Spec: spec,
+				Changes: []feeds.SpecChange{
+					{
+						Path:     "contractID",
+						Type:     feeds.SpecChangeTypeAdded,
+						NewValue: null.StringFrom("'0x0000000000000000000000000000000000000002'"),
+					},
+					{
+						Path:     "type",
+						Type:     feeds.SpecChangeTypeAdded,
+						NewValue: null.StringFrom("'bootstrap'"),
+					},
+				},
+			},
+		},
+		{
+			name: "fails to get spec",
+			before: func(svc *TestService) {
+				svc.orm.On("GetSpec", spec.ID, mock.Anything).Return(nil, sql.ErrNoRows)
+			},
+			wantErr: "orm: job proposal spec: sql: no rows in result set",
+		},
+		{
+			name: "fails to get approved spec",
+			before: func(svc *TestService) {
+				svc.orm.On("GetSpec", spec.ID, mock.Anything).Return(spec, nil)
+				svc.orm.On("GetApprovedSpec", spec.JobProposalID, mock.Anything).Return(nil, errors.New("failure"))
+			},
+			wantErr: "orm: approved job proposal spec: failure",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			svc := setupTestService(t)
+			if tc.before != nil {
+				tc.before(svc)
+			}
+
+			diff, err := svc.DiffSpec(ctx, spec.ID)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, diff)
+		})
+	}
+}
+
+func Test_Service_DryRunApproveSpec(t *testing.T) {
+	evmChainID := utils.NewBig(big.NewInt(0))
+	address := ethkey.EIP55AddressFromAddress(common.HexToAddress("0x613a38AC1659769640aaE063C651F48E0250454C"))
+
+	var (
+		ctx  = testutils.Context(t)
+		defn = `
+name = 'LINK / ETH | version 3 | contract 0x0000000000000000000000000000000000000000'
+type = 'bootstrap'
+schemaVersion = 1
+contractID = '0x613a38AC1659769640aaE063C651F48E0250454C'
+externalJobID = '00000000-0000-0000-0000-000000000001'
+relay = 'evm'
+
+[relayConfig]
+chainID = 0
+`
+		jp = &feeds.JobProposal{
+			ID:             1,
+			FeedsManagerID: 100,
+		}
+		spec = &feeds.JobProposalSpec{
+			ID:            20,
+			Status:        feeds.SpecStatusPending,
+			JobProposalID: jp.ID,
+			Version:       1,
+			Definition:    defn,
+		}
+	)
+
+	testCases := []struct {
+		name         string
+		before       func(svc *TestService)
+		wantErrors   []string
+		wantWarnings []string
+	}{
+		{
+			name: "valid",
+			before: func(svc *TestService) {
+				svc.orm.On("GetSpec", spec.ID, mock.Anything).Return(spec, nil)
+				svc.orm.On("GetJobProposal", jp.ID, mock.Anything).Return(jp, nil)
+				svc.connMgr.On("GetClient", jp.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.jobORM.On("AssertBridgesExist", mock.IsType(pipeline.Pipeline{})).Return(nil)
+				svc.jobORM.On("FindJobIDByAddress", address, evmChainID, mock.Anything).Return(int32(0), sql.ErrNoRows)
+			},
+			wantErrors:   []string{},
+			wantWarnings: []string{},
+		},
+		{
+			name: "replaces an existing job",
+			before: func(svc *TestService) {
+				svc.orm.On("GetSpec", spec.ID, mock.Anything).Return(spec, nil)
+				svc.orm.On("GetJobProposal", jp.ID, mock.Anything).Return(jp, nil)
+				svc.connMgr.On("GetClient", jp.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.jobORM.On("AssertBridgesExist", mock.IsType(pipeline.Pipeline{})).Return(nil)
+				svc.jobORM.On("FindJobIDByAddress", address, evmChainID, mock.Anything).Return(int32(7), nil)
+			},
+			wantErrors:   []string{},
+			wantWarnings: []string{"job 7 for contract address 0x613a38AC1659769640aaE063C651F48E0250454C will be replaced; approval requires the force option"},
+		},
+		{
+			name: "collects errors",
+			before: func(svc *TestService) {
+				svc.orm.On("GetSpec", spec.ID, mock.Anything).Return(spec, nil)
+				svc.orm.On("GetJobProposal", jp.ID, mock.Anything).Return(&feeds.JobProposal{
+					ID:             jp.ID,
+					FeedsManagerID: jp.FeedsManagerID,
+					Status:         feeds.JobProposalStatusDeleted,
+				}, nil)
+				svc.connMgr.On("GetClient", jp.FeedsManagerID).Return(nil, errors.New("disconnected"))
+				svc.jobORM.On("AssertBridgesExist", mock.IsType(pipeline.Pipeline{})).Return(errors.New("bridge not found"))
+				svc.jobORM.On("FindJobIDByAddress", address, evmChainID, mock.Anything).Return(int32(0), sql.ErrNoRows)
+			},
+			wantErrors: []string{
+				"cannot approve spec for a deleted job proposal",
+				"fms rpc client: disconnected",
+				"bridge check failed: bridge not found",
+			},
+			wantWarnings: []string{},
+		},
+		{
+			name: "invalid spec",
+			before: func(svc *TestService) {
+				svc.orm.On("GetSpec", spec.ID, mock.Anything).Return(&feeds.JobProposalSpec{
+					ID:            spec.ID,
+					Status:        feeds.SpecStatusPending,
+					JobProposalID: jp.ID,
+					Definition:    "type = 'unknown'",
+				}, nil)
+				svc.orm.On("GetJobProposal", jp.ID, mock.Anything).Return(jp, nil)
+				svc.connMgr.On("GetClient", jp.FeedsManagerID).Return(svc.fmsClient, nil)
+			},
+			wantErrors:   []string{"could not generate job from spec: failed to parse job spec TOML: invalid job type"},
+			wantWarnings: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			svc := setupTestServiceCfg(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+				c.OCR2.Enabled = testutils.Ptr(true)
+			})
+			if tc.before != nil {
+				tc.before(svc)
+			}
+
+			res, err := svc.DryRunApproveSpec(ctx, spec.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantErrors, res.Errors)
+			assert.Equal(t, tc.wantWarnings, res.Warnings)
+			assert.Equal(t, len(tc.wantErrors) == 0, res.Valid())
+		})
+	}
+}
+
 func Test_Service_RejectSpec(t *testing.T) {
 	var (
 		ctx = testutils.Context(t)
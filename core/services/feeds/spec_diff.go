@@ -0,0 +1,156 @@
+package feeds
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+)
+
+// SpecChangeType defines the kind of change made to a key of a job spec.
+type SpecChangeType string
+
+const (
+	SpecChangeTypeAdded    SpecChangeType = "added"
+	SpecChangeTypeRemoved  SpecChangeType = "removed"
+	SpecChangeTypeModified SpecChangeType = "modified"
+)
+
+// SpecChange is a change to a single key of a job spec. Values are encoded as
+// TOML.
+type SpecChange struct {
+	// Path is the dotted path of the key, e.g. `pluginConfig.juelsPerFeeCoinSource`.
+	Path     string
+	Type     SpecChangeType
+	OldValue null.String
+	NewValue null.String
+}
+
+// SpecDiff is the difference between the definition of the spec currently
+// running as a job and a proposed spec.
+type SpecDiff struct {
+	Spec *JobProposalSpec
+	// ApprovedSpec is the spec of the currently running job, or nil if the job
+	// proposal has no approved spec.
+	ApprovedSpec *JobProposalSpec
+	Changes      []SpecChange
+}
+
+// SpecDryRun is the result of validating a spec for approval without creating
+// the job.
+type SpecDryRun struct {
+	Spec *JobProposalSpec
+	// Errors are the reasons approving the spec would fail.
+	Errors []string
+	// Warnings are the side effects that approving the spec would have, such
+	// as replacing an existing job.
+	Warnings []string
+}
+
+// Valid returns true if approving the spec is expected to succeed.
+func (r *SpecDryRun) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+// diffSpecDefinitions returns the changes from the TOML job spec definition
+// from to to, sorted by path. Tables are compared key by key, all other values
+// (including arrays) are compared as a whole.
+func diffSpecDefinitions(from, to string) ([]SpecChange, error) {
+	fromTree := map[string]interface{}{}
+	if err := toml.Unmarshal([]byte(from), &fromTree); err != nil {
+		return nil, errors.Wrap(err, "failed to parse current spec definition")
+	}
+	toTree := map[string]interface{}{}
+	if err := toml.Unmarshal([]byte(to), &toTree); err != nil {
+		return nil, errors.Wrap(err, "failed to parse proposed spec definition")
+	}
+
+	var changes []SpecChange
+	if err := diffTrees(nil, fromTree, toTree, &changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func diffTrees(path []string, from, to map[string]interface{}, changes *[]SpecChange) error {
+	keys := make([]string, 0, len(from)+len(to))
+	for k := range from {
+		keys = append(keys, k)
+	}
+	for k := range to {
+		if _, ok := from[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		keyPath := append(path[:len(path):len(path)], k)
+		fromVal, inFrom := from[k]
+		toVal, inTo := to[k]
+
+		fromTable, fromIsTable := fromVal.(map[string]interface{})
+		toTable, toIsTable := toVal.(map[string]interface{})
+		switch {
+		case fromIsTable && toIsTable:
+			if err := diffTrees(keyPath, fromTable, toTable, changes); err != nil {
+				return err
+			}
+			continue
+		case fromIsTable && !inTo:
+			if err := diffTrees(keyPath, fromTable, map[string]interface{}{}, changes); err != nil {
+				return err
+			}
+			continue
+		case toIsTable && !inFrom:
+			if err := diffTrees(keyPath, map[string]interface{}{}, toTable, changes); err != nil {
+				return err
+			}
+			continue
+		}
+
+		change := SpecChange{Path: strings.Join(keyPath, ".")}
+		switch {
+		case !inFrom:
+			change.Type = SpecChangeTypeAdded
+		case !inTo:
+			change.Type = SpecChangeTypeRemoved
+		case reflect.DeepEqual(fromVal, toVal):
+			continue
+		default:
+			change.Type = SpecChangeTypeModified
+		}
+		var err error
+		if inFrom {
+			if change.OldValue, err = encodeSpecValue(fromVal); err != nil {
+				return err
+			}
+		}
+		if inTo {
+			if change.NewValue, err = encodeSpecValue(toVal); err != nil {
+				return err
+			}
+		}
+		*changes = append(*changes, change)
+	}
+	return nil
+}
+
+// encodeSpecValue encodes a single value of a job spec as TOML. Multi-line
+// strings, such as pipeline definitions, are kept as literal strings so that
+// they remain readable.
+func encodeSpecValue(v interface{}) (null.String, error) {
+	if str, ok := v.(string); ok && strings.Contains(str, "\n") && !strings.Contains(str, "'''") {
+		return null.StringFrom("'''\n" + str + "'''"), nil
+	}
+	const key = "v"
+	b, err := toml.Marshal(map[string]interface{}{key: v})
+	if err != nil {
+		return null.String{}, errors.Wrap(err, "failed to encode spec value")
+	}
+	s := strings.TrimPrefix(string(b), key+" = ")
+	return null.StringFrom(strings.TrimSuffix(s, "\n")), nil
+}
@@ -0,0 +1,89 @@
+package feeds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func Test_diffSpecDefinitions(t *testing.T) {
+	t.Parallel()
+
+	current := `
+type = "offchainreporting2"
+schemaVersion = 1
+contractID = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pv2Bootstrappers = ["a@127.0.0.1:5001"]
+observationSource = """
+ds1 [type=bridge name=bridge-api0];
+"""
+[relayConfig]
+chainID = 1
+[pluginConfig]
+juelsPerFeeCoinSource = "1"
+`
+	proposed := `
+type = "offchainreporting2"
+schemaVersion = 1
+contractID = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pv2Bootstrappers = ["a@127.0.0.1:5001", "b@127.0.0.1:5002"]
+observationSource = """
+ds1 [type=bridge name=bridge-api1];
+"""
+transmitterID = "0x0000000000000000000000000000000000000001"
+[relayConfig]
+chainID = 1
+`
+
+	t.Run("changes", func(t *testing.T) {
+		changes, err := diffSpecDefinitions(current, proposed)
+		require.NoError(t, err)
+		assert.Equal(t, []SpecChange{
+			{
+				Path:     "observationSource",
+				Type:     SpecChangeTypeModified,
+				OldValue: null.StringFrom("'''\nds1 [type=bridge name=bridge-api0];\n'''"),
+				NewValue: null.StringFrom("'''\nds1 [type=bridge name=bridge-api1];\n'''"),
+			},
+			{
+				Path:     "p2pv2Bootstrappers",
+				Type:     SpecChangeTypeModified,
+				OldValue: null.StringFrom("['a@127.0.0.1:5001']"),
+				NewValue: null.StringFrom("['a@127.0.0.1:5001', 'b@127.0.0.1:5002']"),
+			},
+			{
+				Path:     "pluginConfig.juelsPerFeeCoinSource",
+				Type:     SpecChangeTypeRemoved,
+				OldValue: null.StringFrom("'1'"),
+			},
+			{
+				Path:     "transmitterID",
+				Type:     SpecChangeTypeAdded,
+				NewValue: null.StringFrom("'0x0000000000000000000000000000000000000001'"),
+			},
+		}, changes)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		changes, err := diffSpecDefinitions(current, current)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("no current spec", func(t *testing.T) {
+		changes, err := diffSpecDefinitions("", `type = "bootstrap"`)
+		require.NoError(t, err)
+		assert.Equal(t, []SpecChange{{
+			Path:     "type",
+			Type:     SpecChangeTypeAdded,
+			NewValue: null.StringFrom("'bootstrap'"),
+		}}, changes)
+	})
+
+	t.Run("invalid spec", func(t *testing.T) {
+		_, err := diffSpecDefinitions(current, "type = ")
+		require.ErrorContains(t, err, "failed to parse proposed spec definition")
+	})
+}
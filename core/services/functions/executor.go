@@ -0,0 +1,295 @@
+package functions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	clhttp "github.com/smartcontractkit/chainlink/v2/core/utils/http"
+)
+
+const (
+	// ExternalAdapterBridgeName is the name of the bridge to the external
+	// adapter which runs the computation of Functions requests.
+	ExternalAdapterBridgeName = "ea_bridge"
+
+	// DefaultMaxAdapterResponseSizeBytes limits the size of the external
+	// adapter response body if the job does not configure a limit.
+	DefaultMaxAdapterResponseSizeBytes uint32 = 256 * 1024
+)
+
+//go:generate mockery --quiet --name Executor --output ./mocks/ --case=underscore
+
+// Executor runs the computation of a Functions request.
+type Executor interface {
+	// Execute returns the outcome of the computation. An error is returned only
+	// if the computation could not be run, errors raised by the user's code are
+	// returned in ExecutionResult.Error.
+	Execute(ctx context.Context, req ExecutionRequest) (*ExecutionResult, error)
+}
+
+// ExecutionRequest is a Functions request received in an on-chain event.
+type ExecutionRequest struct {
+	RequestID         RequestID
+	SubscriptionOwner common.Address
+	SubscriptionID    uint64
+	// Data is the CBOR-decoded request data.
+	Data map[string]interface{}
+}
+
+// ExecutionResult is the outcome of the computation of a Functions request.
+type ExecutionResult struct {
+	// RunID is the ID of the pipeline run which computed the result, or zero
+	// if there was no pipeline run.
+	RunID   int64
+	Result  []byte
+	Error   []byte
+	Domains []string
+}
+
+// applySizeLimit replaces a result or error which exceeds maxSize bytes with
+// an error. A maxSize of zero disables the limit.
+func (r *ExecutionResult) applySizeLimit(maxSize uint32) {
+	if maxSize == 0 {
+		return
+	}
+	if uint32(len(r.Result)) > maxSize || uint32(len(r.Error)) > maxSize {
+		r.Result = nil
+		r.Error = []byte(fmt.Sprintf("response too big (max %d bytes)", maxSize))
+	}
+}
+
+// ExternalAdapterExecutorConfig configures an ExternalAdapterExecutor.
+type ExternalAdapterExecutorConfig struct {
+	JobName string
+	// MaxAdapterResponseSizeBytes limits the size of the external adapter
+	// response body.
+	MaxAdapterResponseSizeBytes uint32
+	// MaxResponseSizeBytes limits the size of the computation result and
+	// error, zero for no limit.
+	MaxResponseSizeBytes uint32
+	// Timeout limits the duration of a request to the external adapter, zero
+	// for no limit other than the context.
+	Timeout time.Duration
+}
+
+type externalAdapterExecutor struct {
+	cfg        ExternalAdapterExecutorConfig
+	bridgeORM  bridges.ORM
+	httpClient *http.Client
+	lggr       logger.Logger
+}
+
+var _ Executor = (*externalAdapterExecutor)(nil)
+
+// NewExternalAdapterExecutor returns an Executor which sends requests directly
+// to the external adapter behind the ExternalAdapterBridgeName bridge.
+func NewExternalAdapterExecutor(cfg ExternalAdapterExecutorConfig, bridgeORM bridges.ORM, httpClient *http.Client, lggr logger.Logger) Executor {
+	if cfg.MaxAdapterResponseSizeBytes == 0 {
+		cfg.MaxAdapterResponseSizeBytes = DefaultMaxAdapterResponseSizeBytes
+	}
+	return &externalAdapterExecutor{
+		cfg:        cfg,
+		bridgeORM:  bridgeORM,
+		httpClient: httpClient,
+		lggr:       lggr.Named("ExternalAdapterExecutor"),
+	}
+}
+
+type adapterRequestMeta struct {
+	RequestID         string                 `json:"requestId"`
+	SubscriptionOwner common.Address         `json:"subscriptionOwner"`
+	SubscriptionID    uint64                 `json:"subscriptionId"`
+	RequestData       map[string]interface{} `json:"requestData"`
+}
+
+// adapterRequest has the same layout as the request sent by the bridge task of
+// PipelineObservationSource, so that adapters work with either executor.
+type adapterRequest struct {
+	RequestID         string                 `json:"requestId"`
+	JobName           string                 `json:"jobName"`
+	SubscriptionOwner common.Address         `json:"subscriptionOwner"`
+	SubscriptionID    uint64                 `json:"subscriptionId"`
+	Data              map[string]interface{} `json:"data"`
+	Meta              adapterRequestMeta     `json:"meta"`
+}
+
+type adapterResponse struct {
+	Data struct {
+		Result  json.RawMessage `json:"result"`
+		Error   json.RawMessage `json:"error"`
+		Domains json.RawMessage `json:"domains"`
+	} `json:"data"`
+}
+
+func (e *externalAdapterExecutor) Execute(ctx context.Context, req ExecutionRequest) (*ExecutionResult, error) {
+	bt, err := e.bridgeORM.FindBridge(ExternalAdapterBridgeName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not find bridge with name '%s'", ExternalAdapterBridgeName)
+	}
+
+	requestID := formatRequestId(req.RequestID)
+	body, err := json.Marshal(adapterRequest{
+		RequestID:         requestID,
+		JobName:           e.cfg.JobName,
+		SubscriptionOwner: req.SubscriptionOwner,
+		SubscriptionID:    req.SubscriptionID,
+		Data:              req.Data,
+		Meta: adapterRequestMeta{
+			RequestID:         requestID,
+			SubscriptionOwner: req.SubscriptionOwner,
+			SubscriptionID:    req.SubscriptionID,
+			RequestData:       req.Data,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode external adapter request")
+	}
+
+	if e.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.cfg.Timeout)
+		defer cancel()
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, bt.URL.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create external adapter request")
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpRequest := clhttp.HTTPRequest{
+		Client:  e.httpClient,
+		Request: httpReq,
+		Config:  clhttp.HTTPRequestConfig{SizeLimit: int64(e.cfg.MaxAdapterResponseSizeBytes)},
+		Logger:  e.lggr,
+	}
+	responseBytes, statusCode, _, err := httpRequest.SendRequest()
+	if ctx.Err() != nil {
+		return nil, errors.New("external adapter request timed out or interrupted")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "external adapter request failed")
+	}
+	if statusCode >= 400 {
+		return nil, errors.Errorf("external adapter responded with status code %d", statusCode)
+	}
+
+	var resp adapterResponse
+	if err = json.Unmarshal(responseBytes, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to decode external adapter response")
+	}
+	result := &ExecutionResult{}
+	if result.Result, err = ExtractRawBytes(resp.Data.Result); err != nil {
+		return nil, errors.Wrap(err, "failed to extract result")
+	}
+	if result.Error, err = ExtractRawBytes(resp.Data.Error); err != nil {
+		return nil, errors.Wrap(err, "failed to extract error")
+	}
+	if len(resp.Data.Domains) > 0 {
+		if err = json.Unmarshal(resp.Data.Domains, &result.Domains); err != nil {
+			e.lggr.Warnw("failed to parse reported domains", "requestID", requestID, "err", err)
+		}
+	}
+
+	result.applySizeLimit(e.cfg.MaxResponseSizeBytes)
+	return result, nil
+}
+
+type pipelineExecutor struct {
+	job                  job.Job
+	runner               pipeline.Runner
+	jobORM               job.ORM
+	maxResponseSizeBytes uint32
+	lggr                 logger.Logger
+}
+
+var _ Executor = (*pipelineExecutor)(nil)
+
+// NewPipelineExecutor returns an Executor which runs PipelineObservationSource
+// and reads the results of its tasks back from the database. It is retained as
+// a fallback for the ExternalAdapterExecutor.
+func NewPipelineExecutor(jb job.Job, runner pipeline.Runner, jobORM job.ORM, maxResponseSizeBytes uint32, lggr logger.Logger) Executor {
+	return &pipelineExecutor{
+		job:                  jb,
+		runner:               runner,
+		jobORM:               jobORM,
+		maxResponseSizeBytes: maxResponseSizeBytes,
+		lggr:                 lggr.Named("PipelineExecutor"),
+	}
+}
+
+func (e *pipelineExecutor) Execute(ctx context.Context, req ExecutionRequest) (*ExecutionResult, error) {
+	requestID := formatRequestId(req.RequestID)
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"databaseID":    e.job.ID,
+			"externalJobID": e.job.ExternalJobID,
+			"name":          e.job.Name.ValueOrZero(),
+		},
+		"jobRun": map[string]interface{}{
+			"meta": map[string]interface{}{
+				"requestId":         requestID,
+				"subscriptionOwner": req.SubscriptionOwner,
+				"subscriptionId":    req.SubscriptionID,
+				"requestData":       req.Data,
+			},
+		},
+	})
+
+	spec := pipeline.Spec{
+		DotDagSource:      PipelineObservationSource,
+		ID:                e.job.PipelineSpec.ID,
+		JobID:             e.job.PipelineSpec.JobID,
+		JobName:           e.job.PipelineSpec.JobName,
+		JobType:           e.job.PipelineSpec.JobType,
+		CreatedAt:         e.job.CreatedAt,
+		MaxTaskDuration:   e.job.MaxTaskDuration,
+		ForwardingAllowed: e.job.ForwardingAllowed,
+	}
+
+	run := pipeline.NewRun(spec, vars)
+	if _, err := e.runner.Run(ctx, &run, e.lggr, true, nil); err != nil {
+		return nil, errors.Wrap(err, "pipeline run failed")
+	}
+	e.lggr.Infow("pipeline run finished", "requestID", requestID, "runID", run.ID)
+
+	result := &ExecutionResult{RunID: run.ID}
+	computationResult, err := e.jobORM.FindTaskResultByRunIDAndTaskName(run.ID, ParseResultTaskName, pg.WithParentCtx(ctx))
+	if err != nil {
+		return result, errors.Wrap(err, "can't retrieve computation results field")
+	}
+	if result.Result, err = ExtractRawBytes(computationResult); err != nil {
+		return result, errors.Wrap(err, "failed to extract result")
+	}
+
+	computationError, err := e.jobORM.FindTaskResultByRunIDAndTaskName(run.ID, ParseErrorTaskName, pg.WithParentCtx(ctx))
+	if err != nil {
+		return result, errors.Wrap(err, "can't retrieve computation error field")
+	}
+	if result.Error, err = ExtractRawBytes(computationError); err != nil {
+		return result, errors.Wrap(err, "failed to extract error")
+	}
+
+	reportedDomainsJson, err := e.jobORM.FindTaskResultByRunIDAndTaskName(run.ID, ParseDomainsTaskName, pg.WithParentCtx(ctx))
+	if err != nil {
+		e.lggr.Errorw("failed to extract domains", "requestID", requestID, "err", err)
+	} else if len(reportedDomainsJson) > 0 {
+		if err = json.Unmarshal(reportedDomainsJson, &result.Domains); err != nil {
+			e.lggr.Warnw("failed to parse reported domains", "requestID", requestID, "err", err)
+		}
+	}
+
+	result.applySizeLimit(e.maxResponseSizeBytes)
+	return result, nil
+}
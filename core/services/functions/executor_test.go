@@ -0,0 +1,209 @@
+package functions_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	bridges_mocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	functions_service "github.com/smartcontractkit/chainlink/v2/core/services/functions"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	job_mocks "github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	pipeline_mocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+const (
+	CorrectResultData string = "\"0x1234\""
+	CorrectErrorData  string = "\"0x424144\""
+	EmptyData         string = "\"\""
+)
+
+func newExecutionRequest() functions_service.ExecutionRequest {
+	return functions_service.ExecutionRequest{
+		RequestID:         RequestID,
+		SubscriptionOwner: common.HexToAddress("0x1"),
+		SubscriptionID:    7,
+		Data:              map[string]interface{}{"source": "return 1"},
+	}
+}
+
+func newExternalAdapterExecutor(t *testing.T, cfg functions_service.ExternalAdapterExecutorConfig, handler http.HandlerFunc) functions_service.Executor {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	bridgeORM := bridges_mocks.NewORM(t)
+	bridgeORM.On("FindBridge", bridges.BridgeName(functions_service.ExternalAdapterBridgeName)).
+		Return(bridges.BridgeType{URL: models.WebURL(*testutils.MustParseURL(t, server.URL))}, nil)
+
+	return functions_service.NewExternalAdapterExecutor(cfg, bridgeORM, server.Client(), logger.TestLogger(t))
+}
+
+func adapterResponse(result, errData, domains string) string {
+	return `{"data": {"result": ` + result + `, "error": ` + errData + `, "domains": ` + domains + `}}`
+}
+
+func TestExternalAdapterExecutor_Execute(t *testing.T) {
+	t.Parallel()
+
+	executor := newExternalAdapterExecutor(t, functions_service.ExternalAdapterExecutorConfig{JobName: "functions"}, func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var req map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &req))
+		assert.Equal(t, "0x"+RequestID.String(), req["requestId"])
+		assert.Equal(t, "functions", req["jobName"])
+		assert.Equal(t, strings.ToLower(common.HexToAddress("0x1").Hex()), strings.ToLower(req["subscriptionOwner"].(string)))
+		assert.Equal(t, float64(7), req["subscriptionId"])
+		assert.Equal(t, map[string]interface{}{"source": "return 1"}, req["data"])
+		assert.Contains(t, req, "meta")
+
+		_, _ = io.WriteString(w, adapterResponse(CorrectResultData, EmptyData, `["github.com"]`))
+	})
+
+	result, err := executor.Execute(testutils.Context(t), newExecutionRequest())
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x12, 0x34}, result.Result)
+	assert.Equal(t, []byte{}, result.Error)
+	assert.Equal(t, []string{"github.com"}, result.Domains)
+	assert.Zero(t, result.RunID)
+}
+
+func TestExternalAdapterExecutor_ComputationError(t *testing.T) {
+	t.Parallel()
+
+	executor := newExternalAdapterExecutor(t, functions_service.ExternalAdapterExecutorConfig{}, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"data": {"result": "", "error": "0x424144"}}`)
+	})
+
+	result, err := executor.Execute(testutils.Context(t), newExecutionRequest())
+	require.NoError(t, err)
+	assert.Equal(t, []byte{}, result.Result)
+	assert.Equal(t, []byte("BAD"), result.Error)
+	assert.Empty(t, result.Domains)
+}
+
+func TestExternalAdapterExecutor_MaxResponseSize(t *testing.T) {
+	t.Parallel()
+
+	executor := newExternalAdapterExecutor(t, functions_service.ExternalAdapterExecutorConfig{MaxResponseSizeBytes: 1}, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, adapterResponse(CorrectResultData, EmptyData, "null"))
+	})
+
+	result, err := executor.Execute(testutils.Context(t), newExecutionRequest())
+	require.NoError(t, err)
+	assert.Nil(t, result.Result)
+	assert.Equal(t, []byte("response too big (max 1 bytes)"), result.Error)
+}
+
+func TestExternalAdapterExecutor_Errors(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		cfg      functions_service.ExternalAdapterExecutorConfig
+		response string
+		status   int
+		err      string
+	}{
+		{"status code", functions_service.ExternalAdapterExecutorConfig{}, `{}`, http.StatusInternalServerError, "external adapter responded with status code 500"},
+		{"malformed response", functions_service.ExternalAdapterExecutorConfig{}, `not json`, http.StatusOK, "failed to decode external adapter response"},
+		{"missing result", functions_service.ExternalAdapterExecutorConfig{}, `{"data": {"error": ""}}`, http.StatusOK, "failed to extract result"},
+		{"invalid error", functions_service.ExternalAdapterExecutorConfig{}, `{"data": {"result": "", "error": "0xabc"}}`, http.StatusOK, "failed to extract error"},
+		{"adapter response too big", functions_service.ExternalAdapterExecutorConfig{MaxAdapterResponseSizeBytes: 16}, adapterResponse(CorrectResultData, EmptyData, "null"), http.StatusOK, "external adapter request failed"},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			executor := newExternalAdapterExecutor(t, tc.cfg, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = io.WriteString(w, tc.response)
+			})
+
+			_, err := executor.Execute(testutils.Context(t), newExecutionRequest())
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestExternalAdapterExecutor_BridgeNotFound(t *testing.T) {
+	t.Parallel()
+
+	bridgeORM := bridges_mocks.NewORM(t)
+	bridgeORM.On("FindBridge", bridges.BridgeName(functions_service.ExternalAdapterBridgeName)).Return(bridges.BridgeType{}, errors.New("not found"))
+	executor := functions_service.NewExternalAdapterExecutor(functions_service.ExternalAdapterExecutorConfig{}, bridgeORM, http.DefaultClient, logger.TestLogger(t))
+
+	_, err := executor.Execute(testutils.Context(t), newExecutionRequest())
+	require.ErrorContains(t, err, "could not find bridge with name 'ea_bridge'")
+}
+
+func newPipelineExecutor(t *testing.T, maxResponseSizeBytes uint32) (functions_service.Executor, *job_mocks.ORM) {
+	runner := pipeline_mocks.NewRunner(t)
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, true, mock.Anything).
+		Return(false, nil).
+		Run(func(args mock.Arguments) {
+			run := args.Get(1).(*pipeline.Run)
+			assert.Equal(t, functions_service.PipelineObservationSource, run.PipelineSpec.DotDagSource)
+			run.ID = 42
+		}).Once()
+	jobORM := job_mocks.NewORM(t)
+	jb := job.Job{Name: null.StringFrom("functions"), PipelineSpec: &pipeline.Spec{}}
+
+	return functions_service.NewPipelineExecutor(jb, runner, jobORM, maxResponseSizeBytes, logger.TestLogger(t)), jobORM
+}
+
+func TestPipelineExecutor_Execute(t *testing.T) {
+	t.Parallel()
+
+	executor, jobORM := newPipelineExecutor(t, 0)
+	jobORM.On("FindTaskResultByRunIDAndTaskName", int64(42), functions_service.ParseResultTaskName, mock.Anything).Return([]byte(CorrectResultData), nil)
+	jobORM.On("FindTaskResultByRunIDAndTaskName", int64(42), functions_service.ParseErrorTaskName, mock.Anything).Return([]byte(EmptyData), nil)
+	jobORM.On("FindTaskResultByRunIDAndTaskName", int64(42), functions_service.ParseDomainsTaskName, mock.Anything).Return([]byte(`["github.com","google.com"]`), nil)
+
+	result, err := executor.Execute(testutils.Context(t), newExecutionRequest())
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), result.RunID)
+	assert.Equal(t, []byte{0x12, 0x34}, result.Result)
+	assert.Equal(t, []byte{}, result.Error)
+	assert.Equal(t, []string{"github.com", "google.com"}, result.Domains)
+}
+
+func TestPipelineExecutor_MaxResponseSize(t *testing.T) {
+	t.Parallel()
+
+	executor, jobORM := newPipelineExecutor(t, 2)
+	jobORM.On("FindTaskResultByRunIDAndTaskName", int64(42), functions_service.ParseResultTaskName, mock.Anything).Return([]byte(EmptyData), nil)
+	jobORM.On("FindTaskResultByRunIDAndTaskName", int64(42), functions_service.ParseErrorTaskName, mock.Anything).Return([]byte(CorrectErrorData), nil)
+	jobORM.On("FindTaskResultByRunIDAndTaskName", int64(42), functions_service.ParseDomainsTaskName, mock.Anything).Return([]byte{}, nil)
+
+	result, err := executor.Execute(testutils.Context(t), newExecutionRequest())
+	require.NoError(t, err)
+	assert.Nil(t, result.Result)
+	assert.Equal(t, []byte("response too big (max 2 bytes)"), result.Error)
+}
+
+func TestPipelineExecutor_MissingTaskResult(t *testing.T) {
+	t.Parallel()
+
+	executor, jobORM := newPipelineExecutor(t, 0)
+	jobORM.On("FindTaskResultByRunIDAndTaskName", int64(42), functions_service.ParseResultTaskName, mock.Anything).Return(nil, errors.New("no rows"))
+
+	result, err := executor.Execute(testutils.Context(t), newExecutionRequest())
+	require.ErrorContains(t, err, "can't retrieve computation results field")
+	assert.Equal(t, int64(42), result.RunID)
+}
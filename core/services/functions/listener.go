@@ -3,7 +3,6 @@ package functions
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"reflect"
 	"sync"
@@ -21,7 +20,6 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/functions/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
-	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 
@@ -106,7 +104,8 @@ const (
 	ParseResultTaskName  string = "parse_result"
 	ParseErrorTaskName   string = "parse_error"
 	ParseDomainsTaskName string = "parse_domains"
-	// TODO: Remove/reduce dependency on pipeline tasks (https://smartcontract-it.atlassian.net/browse/FUN-135)
+	// PipelineObservationSource is run by the pipeline Executor, which is only used as a fallback for the
+	// ExternalAdapterExecutor.
 	PipelineObservationSource string = `
 		run_computation [type="bridge" name="ea_bridge" requestData="{\"requestId\": $(jobRun.meta.requestId), \"jobName\": $(jobSpec.name), \"subscriptionOwner\": $(jobRun.meta.subscriptionOwner), \"subscriptionId\": $(jobRun.meta.subscriptionId), \"data\": $(jobRun.meta.requestData)}"]
 		parse_result    [type=jsonparse data="$(run_computation)" path="data,result"]
@@ -121,8 +120,7 @@ type FunctionsListener struct {
 	oracle            *ocr2dr_oracle.OCR2DROracle
 	oracleHexAddr     string
 	job               job.Job
-	pipelineRunner    pipeline.Runner
-	jobORM            job.ORM
+	executor          Executor
 	logBroadcaster    log.Broadcaster
 	shutdownWaitGroup sync.WaitGroup
 	mbOracleEvents    *utils.Mailbox[log.Broadcast]
@@ -140,13 +138,12 @@ func formatRequestId(requestId [32]byte) string {
 	return fmt.Sprintf("0x%x", requestId)
 }
 
-func NewFunctionsListener(oracle *ocr2dr_oracle.OCR2DROracle, job job.Job, runner pipeline.Runner, jobORM job.ORM, pluginORM ORM, pluginConfig config.PluginConfig, logBroadcaster log.Broadcaster, lggr logger.Logger, mailMon *utils.MailboxMonitor, urlsMonEndpoint commontypes.MonitoringEndpoint) *FunctionsListener {
+func NewFunctionsListener(oracle *ocr2dr_oracle.OCR2DROracle, job job.Job, executor Executor, pluginORM ORM, pluginConfig config.PluginConfig, logBroadcaster log.Broadcaster, lggr logger.Logger, mailMon *utils.MailboxMonitor, urlsMonEndpoint commontypes.MonitoringEndpoint) *FunctionsListener {
 	return &FunctionsListener{
 		oracle:          oracle,
 		oracleHexAddr:   oracle.Address().Hex(),
 		job:             job,
-		pipelineRunner:  runner,
-		jobORM:          jobORM,
+		executor:        executor,
 		logBroadcaster:  logBroadcaster,
 		mbOracleEvents:  utils.NewHighCapacityMailbox[log.Broadcast](),
 		chStop:          make(chan struct{}),
@@ -285,7 +282,7 @@ func (l *FunctionsListener) processOracleEvents() {
 	}
 }
 
-// Process result from the EA saved by a jsonparse pipeline task.
+// Process result or error field of the EA response.
 // That value is a valid JSON string so it contains double quote characters.
 // Allowed inputs are:
 //
@@ -347,7 +344,7 @@ func (l *FunctionsListener) handleOracleRequest(request *ocr2dr_oracle.OCR2DROra
 	ctx, cancel := l.getNewHandlerContext()
 	defer cancel()
 
-	err := l.pluginORM.CreateRequest(request.RequestId, time.Now(), &request.Raw.TxHash, pg.WithParentCtx(ctx))
+	err := l.pluginORM.CreateRequest(request.RequestId, request.SubscriptionId, time.Now(), &request.Raw.TxHash, pg.WithParentCtx(ctx))
 	if err != nil {
 		l.logger.Errorw("failed to create a DB entry for new request", "requestID", formatRequestId(request.RequestId), "err", err)
 		return
@@ -367,93 +364,41 @@ func (l *FunctionsListener) handleOracleRequest(request *ocr2dr_oracle.OCR2DROra
 		return
 	}
 
-	vars := pipeline.NewVarsFrom(map[string]interface{}{
-		"jobSpec": map[string]interface{}{
-			"databaseID":    l.job.ID,
-			"externalJobID": l.job.ExternalJobID,
-			"name":          l.job.Name.ValueOrZero(),
-		},
-		"jobRun": map[string]interface{}{
-			"meta": map[string]interface{}{
-				"requestId":         formatRequestId(request.RequestId),
-				"subscriptionOwner": request.SubscriptionOwner,
-				"subscriptionId":    request.SubscriptionId,
-				"requestData":       requestData,
-			},
-		},
+	result, err := l.executor.Execute(ctx, ExecutionRequest{
+		RequestID:         request.RequestId,
+		SubscriptionOwner: request.SubscriptionOwner,
+		SubscriptionID:    request.SubscriptionId,
+		Data:              requestData,
 	})
-
-	// TODO: Remove/reduce dependency on pipeline tasks (https://smartcontract-it.atlassian.net/browse/FUN-135)
-	spec := pipeline.Spec{
-		DotDagSource:      PipelineObservationSource,
-		ID:                l.job.PipelineSpec.ID,
-		JobID:             l.job.PipelineSpec.JobID,
-		JobName:           l.job.PipelineSpec.JobName,
-		JobType:           l.job.PipelineSpec.JobType,
-		CreatedAt:         l.job.CreatedAt,
-		MaxTaskDuration:   l.job.MaxTaskDuration,
-		ForwardingAllowed: l.job.ForwardingAllowed,
+	var runID int64
+	if result != nil {
+		runID = result.RunID
 	}
-
-	run := pipeline.NewRun(spec, vars)
-	_, err = l.pipelineRunner.Run(ctx, &run, l.logger, true, nil)
 	if err != nil {
-		l.logger.Errorw("pipeline run failed", "requestID", formatRequestId(request.RequestId), "runID", run.ID, "err", err)
-		return
-	}
-	l.logger.Infow("pipeline run finished", "requestID", formatRequestId(request.RequestId), "runID", run.ID)
-
-	computationResult, errResult := l.jobORM.FindTaskResultByRunIDAndTaskName(run.ID, ParseResultTaskName, pg.WithParentCtx(ctx))
-	if errResult != nil {
-		// Internal problem: Can't find computation results
-		l.logger.Errorw("internal error: can't retrieve computation results field", "requestID", formatRequestId(request.RequestId))
-		l.setError(ctx, request.RequestId, run.ID, INTERNAL_ERROR, []byte(errResult.Error()))
-		return
-	}
-	computationResult, errResult = ExtractRawBytes(computationResult)
-	if errResult != nil {
-		l.logger.Errorw("failed to extract result", "requestID", formatRequestId(request.RequestId), "err", errResult)
-		return
-	}
-
-	computationError, errErr := l.jobORM.FindTaskResultByRunIDAndTaskName(run.ID, ParseErrorTaskName, pg.WithParentCtx(ctx))
-	if errErr != nil {
-		// Internal problem: Can't find computation errors
-		l.logger.Errorw("internal error: can't retrieve computation error field", "requestID", formatRequestId(request.RequestId))
-		l.setError(ctx, request.RequestId, run.ID, INTERNAL_ERROR, []byte(errErr.Error()))
-		return
-	}
-	computationError, errErr = ExtractRawBytes(computationError)
-	if errErr != nil {
-		l.logger.Errorw("failed to extract error", "requestID", formatRequestId(request.RequestId), "err", errErr)
+		// Internal problem: the computation could not be run or its outcome could not be read
+		l.logger.Errorw("internal error: request execution failed", "requestID", formatRequestId(request.RequestId), "runID", runID, "err", err)
+		l.setError(ctx, request.RequestId, runID, INTERNAL_ERROR, []byte(err.Error()))
 		return
 	}
+	l.logger.Infow("request execution finished", "requestID", formatRequestId(request.RequestId), "runID", runID)
 
-	reportedDomainsJson, errDomains := l.jobORM.FindTaskResultByRunIDAndTaskName(run.ID, ParseDomainsTaskName, pg.WithParentCtx(ctx))
-	if errDomains != nil {
-		l.logger.Errorw("failed to extract domains", "requestID", formatRequestId(request.RequestId), "err", errDomains)
-	} else if len(reportedDomainsJson) > 0 {
-		var reportedDomains []string
-		errJson := json.Unmarshal(reportedDomainsJson, &reportedDomains)
-		if errJson != nil {
-			l.logger.Warnw("failed to parse reported domains", "requestID", formatRequestId(request.RequestId), "err", errJson)
-		} else if len(reportedDomains) > 0 {
-			l.reportSourceCodeDomains(request.RequestId, reportedDomains)
-		}
+	if len(result.Domains) > 0 {
+		l.reportSourceCodeDomains(request.RequestId, result.Domains)
 	}
 
+	computationResult, computationError := result.Result, result.Error
 	if len(computationError) != 0 {
 		if len(computationResult) != 0 {
 			l.logger.Warnw("both result and error are non-empty - using error", "requestID", formatRequestId(request.RequestId))
 		}
 		l.logger.Debugw("saving computation error", "requestID", formatRequestId(request.RequestId))
-		l.setError(ctx, request.RequestId, run.ID, USER_ERROR, computationError)
+		l.setError(ctx, request.RequestId, runID, USER_ERROR, computationError)
 		promComputationErrorSize.WithLabelValues(l.oracleHexAddr).Set(float64(len(computationError)))
 	} else {
 		promRequestComputationSuccess.WithLabelValues(l.oracleHexAddr).Inc()
 		promComputationResultSize.WithLabelValues(l.oracleHexAddr).Set(float64(len(computationResult)))
 		l.logger.Debugw("saving computation result", "requestID", formatRequestId(request.RequestId))
-		if err2 := l.pluginORM.SetResult(request.RequestId, run.ID, computationResult, time.Now(), pg.WithParentCtx(ctx)); err2 != nil {
+		if err2 := l.pluginORM.SetResult(request.RequestId, runID, computationResult, time.Now(), pg.WithParentCtx(ctx)); err2 != nil {
 			l.logger.Errorw("call to SetResult failed", "requestID", formatRequestId(request.RequestId), "err", err2)
 		}
 	}
@@ -465,7 +410,7 @@ func (l *FunctionsListener) handleOracleResponse(responseType string, requestID
 
 	ctx, cancel := l.getNewHandlerContext()
 	defer cancel()
-	if err := l.pluginORM.SetConfirmed(requestID, pg.WithParentCtx(ctx)); err != nil {
+	if err := l.pluginORM.SetConfirmed(requestID, time.Now(), pg.WithParentCtx(ctx)); err != nil {
 		l.logger.Errorw("setting CONFIRMED state failed", "requestID", formatRequestId(requestID), "err", err)
 	}
 	promRequestConfirmed.WithLabelValues(l.oracleHexAddr, responseType).Inc()
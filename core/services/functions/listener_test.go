@@ -26,11 +26,9 @@ import (
 	functions_service "github.com/smartcontractkit/chainlink/v2/core/services/functions"
 	functions_mocks "github.com/smartcontractkit/chainlink/v2/core/services/functions/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
-	job_mocks "github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/functions/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
-	pipeline_mocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/srvctest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
 	sync_mocks "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/mocks"
@@ -40,9 +38,8 @@ import (
 )
 
 type FunctionsListenerUniverse struct {
-	runner         *pipeline_mocks.Runner
+	executor       *functions_mocks.Executor
 	service        *functions_service.FunctionsListener
-	jobORM         *job_mocks.ORM
 	pluginORM      *functions_mocks.ORM
 	logBroadcaster *log_mocks.Broadcaster
 	ingressClient  *sync_mocks.TelemetryIngressClient
@@ -56,7 +53,7 @@ func NewFunctionsListenerUniverse(t *testing.T, timeoutSec int) *FunctionsListen
 	})
 	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
 	broadcaster := log_mocks.NewBroadcaster(t)
-	runner := pipeline_mocks.NewRunner(t)
+	executor := functions_mocks.NewExecutor(t)
 	broadcaster.On("AddDependents", 1)
 	mailMon := srvctest.Start(t, utils.NewMailboxMonitor(t.Name()))
 
@@ -66,7 +63,6 @@ func NewFunctionsListenerUniverse(t *testing.T, timeoutSec int) *FunctionsListen
 	chain := cc.Chains()[0]
 	lggr := logger.TestLogger(t)
 
-	jobORM := job_mocks.NewORM(t)
 	pluginORM := functions_mocks.NewORM(t)
 	jsonConfig := job.JSONConfig{
 		"requestTimeoutSec":               timeoutSec,
@@ -93,21 +89,20 @@ func NewFunctionsListenerUniverse(t *testing.T, timeoutSec int) *FunctionsListen
 
 	ingressClient := sync_mocks.NewTelemetryIngressClient(t)
 	ingressAgent := telemetry.NewIngressAgentWrapper(ingressClient)
-	monEndpoint := ingressAgent.GenMonitoringEndpoint("0xa", synchronization.FunctionsRequests)
+	monEndpoint := ingressAgent.GenMonitoringEndpoint("0xa", synchronization.FunctionsRequests, 1, "1337")
 
-	functionsListener := functions_service.NewFunctionsListener(oracleContract, jb, runner, jobORM, pluginORM, pluginConfig, broadcaster, lggr, mailMon, monEndpoint)
+	functionsListener := functions_service.NewFunctionsListener(oracleContract, jb, executor, pluginORM, pluginConfig, broadcaster, lggr, mailMon, monEndpoint)
 
 	return &FunctionsListenerUniverse{
-		runner:         runner,
+		executor:       executor,
 		service:        functionsListener,
-		jobORM:         jobORM,
 		pluginORM:      pluginORM,
 		logBroadcaster: broadcaster,
 		ingressClient:  ingressClient,
 	}
 }
 
-func PrepareAndStartFunctionsListener(t *testing.T, cbor []byte, expectPipelineRun bool) (*FunctionsListenerUniverse, *log_mocks.Broadcast, cltest.Awaiter) {
+func PrepareAndStartFunctionsListener(t *testing.T, cbor []byte, result *functions_service.ExecutionResult, executionErr error) (*FunctionsListenerUniverse, *log_mocks.Broadcast, cltest.Awaiter) {
 	uni := NewFunctionsListenerUniverse(t, 0)
 	uni.logBroadcaster.On("Register", mock.Anything, mock.Anything).Return(func() {})
 
@@ -127,44 +122,37 @@ func PrepareAndStartFunctionsListener(t *testing.T, cbor []byte, expectPipelineR
 	log.On("DecodedLog").Return(&logOracleRequest)
 	log.On("String").Return("")
 
-	if !expectPipelineRun {
+	if result == nil && executionErr == nil {
 		return uni, log, nil
 	}
 
-	runBeganAwaiter := cltest.NewAwaiter()
-	uni.runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
-		Return(false, nil).
+	executionBeganAwaiter := cltest.NewAwaiter()
+	uni.executor.On("Execute", mock.Anything, mock.MatchedBy(func(req functions_service.ExecutionRequest) bool {
+		return req.RequestID == RequestID
+	})).
+		Return(result, executionErr).
 		Run(func(args mock.Arguments) {
-			runBeganAwaiter.ItHappened()
+			executionBeganAwaiter.ItHappened()
 		}).Once()
 
-	return uni, log, runBeganAwaiter
+	return uni, log, executionBeganAwaiter
 }
 
 var RequestID functions_service.RequestID = newRequestID()
 
-const (
-	CorrectResultData string = "\"0x1234\""
-	CorrectErrorData  string = "\"0x424144\""
-	EmptyData         string = "\"\""
-)
-
 func TestFunctionsListener_HandleOracleRequestSuccess(t *testing.T) {
 	testutils.SkipShortDB(t)
 	t.Parallel()
 
-	uni, log, runBeganAwaiter := PrepareAndStartFunctionsListener(t, []byte{}, true)
+	uni, log, executionBeganAwaiter := PrepareAndStartFunctionsListener(t, []byte{}, &functions_service.ExecutionResult{Result: []byte{0x12, 0x34}}, nil)
 
-	uni.pluginORM.On("CreateRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	uni.pluginORM.On("CreateRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	uni.logBroadcaster.On("MarkConsumed", mock.Anything, mock.Anything).Return(nil)
-	uni.jobORM.On("FindTaskResultByRunIDAndTaskName", mock.Anything, functions_service.ParseResultTaskName, mock.Anything).Return([]byte(CorrectResultData), nil)
-	uni.jobORM.On("FindTaskResultByRunIDAndTaskName", mock.Anything, functions_service.ParseErrorTaskName, mock.Anything).Return([]byte(EmptyData), nil)
-	uni.jobORM.On("FindTaskResultByRunIDAndTaskName", mock.Anything, functions_service.ParseDomainsTaskName, mock.Anything).Return([]byte{}, nil)
 	uni.pluginORM.On("SetResult", RequestID, mock.Anything, []byte{0x12, 0x34}, mock.Anything, mock.Anything).Return(nil)
 
 	uni.service.HandleLog(log)
 
-	runBeganAwaiter.AwaitOrFail(t, 5*time.Second)
+	executionBeganAwaiter.AwaitOrFail(t, 5*time.Second)
 	uni.service.Close()
 }
 
@@ -172,13 +160,13 @@ func TestFunctionsListener_reportSourceCodeDomains(t *testing.T) {
 	testutils.SkipShortDB(t)
 	t.Parallel()
 
-	uni, log, runBeganAwaiter := PrepareAndStartFunctionsListener(t, []byte{}, true)
+	uni, log, executionBeganAwaiter := PrepareAndStartFunctionsListener(t, []byte{}, &functions_service.ExecutionResult{
+		Result:  []byte{0x12, 0x34},
+		Domains: []string{"github.com", "google.com"},
+	}, nil)
 
-	uni.pluginORM.On("CreateRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	uni.pluginORM.On("CreateRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	uni.logBroadcaster.On("MarkConsumed", mock.Anything, mock.Anything).Return(nil)
-	uni.jobORM.On("FindTaskResultByRunIDAndTaskName", mock.Anything, functions_service.ParseResultTaskName, mock.Anything).Return([]byte(CorrectResultData), nil)
-	uni.jobORM.On("FindTaskResultByRunIDAndTaskName", mock.Anything, functions_service.ParseErrorTaskName, mock.Anything).Return([]byte(EmptyData), nil)
-	uni.jobORM.On("FindTaskResultByRunIDAndTaskName", mock.Anything, functions_service.ParseDomainsTaskName, mock.Anything).Return([]byte(`["github.com","google.com"]`), nil)
 	uni.pluginORM.On("SetResult", RequestID, mock.Anything, []byte{0x12, 0x34}, mock.Anything, mock.Anything).Return(nil)
 
 	var sentMessage []byte
@@ -188,7 +176,7 @@ func TestFunctionsListener_reportSourceCodeDomains(t *testing.T) {
 
 	uni.service.HandleLog(log)
 
-	runBeganAwaiter.AwaitOrFail(t, 5*time.Second)
+	executionBeganAwaiter.AwaitOrFail(t, 5*time.Second)
 	uni.service.Close()
 
 	assert.NotEmpty(t, sentMessage)
@@ -204,18 +192,31 @@ func TestFunctionsListener_HandleOracleRequestComputationError(t *testing.T) {
 	testutils.SkipShortDB(t)
 	t.Parallel()
 
-	uni, log, runBeganAwaiter := PrepareAndStartFunctionsListener(t, []byte{}, true)
+	uni, log, executionBeganAwaiter := PrepareAndStartFunctionsListener(t, []byte{}, &functions_service.ExecutionResult{Result: []byte{}, Error: []byte("BAD")}, nil)
 
-	uni.pluginORM.On("CreateRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	uni.pluginORM.On("CreateRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	uni.logBroadcaster.On("MarkConsumed", mock.Anything, mock.Anything).Return(nil)
-	uni.jobORM.On("FindTaskResultByRunIDAndTaskName", mock.Anything, functions_service.ParseResultTaskName, mock.Anything).Return([]byte(EmptyData), nil)
-	uni.jobORM.On("FindTaskResultByRunIDAndTaskName", mock.Anything, functions_service.ParseErrorTaskName, mock.Anything).Return([]byte(CorrectErrorData), nil)
-	uni.jobORM.On("FindTaskResultByRunIDAndTaskName", mock.Anything, functions_service.ParseDomainsTaskName, mock.Anything).Return([]byte{}, nil)
 	uni.pluginORM.On("SetError", RequestID, mock.Anything, functions_service.USER_ERROR, []byte("BAD"), mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	uni.service.HandleLog(log)
 
-	runBeganAwaiter.AwaitOrFail(t, 5*time.Second)
+	executionBeganAwaiter.AwaitOrFail(t, 5*time.Second)
+	uni.service.Close()
+}
+
+func TestFunctionsListener_HandleOracleRequestExecutionError(t *testing.T) {
+	testutils.SkipShortDB(t)
+	t.Parallel()
+
+	uni, log, executionBeganAwaiter := PrepareAndStartFunctionsListener(t, []byte{}, nil, errors.New("adapter unreachable"))
+
+	uni.pluginORM.On("CreateRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	uni.logBroadcaster.On("MarkConsumed", mock.Anything, mock.Anything).Return(nil)
+	uni.pluginORM.On("SetError", RequestID, int64(0), functions_service.INTERNAL_ERROR, []byte("adapter unreachable"), mock.Anything, false, mock.Anything).Return(nil)
+
+	uni.service.HandleLog(log)
+
+	executionBeganAwaiter.AwaitOrFail(t, 5*time.Second)
 	uni.service.Close()
 }
 
@@ -223,10 +224,10 @@ func TestFunctionsListener_HandleOracleRequestCBORParsingError(t *testing.T) {
 	testutils.SkipShortDB(t)
 	t.Parallel()
 
-	uni, log, _ := PrepareAndStartFunctionsListener(t, []byte("invalid cbor"), false)
+	uni, log, _ := PrepareAndStartFunctionsListener(t, []byte("invalid cbor"), nil, nil)
 
 	done := make(chan bool)
-	uni.pluginORM.On("CreateRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	uni.pluginORM.On("CreateRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	uni.logBroadcaster.On("MarkConsumed", mock.Anything, mock.Anything).Return(nil)
 	uni.pluginORM.On("SetError", RequestID, mock.Anything, functions_service.USER_ERROR, []byte("CBOR parsing error"), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
 		done <- true
@@ -262,10 +263,10 @@ func TestFunctionsListener_ORMDoesNotFreezeHandlersForever(t *testing.T) {
 
 	var ormCallExited sync.WaitGroup
 	ormCallExited.Add(1)
-	uni, log, _ := PrepareAndStartFunctionsListener(t, []byte{}, false)
-	uni.pluginORM.On("CreateRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+	uni, log, _ := PrepareAndStartFunctionsListener(t, []byte{}, nil, nil)
+	uni.pluginORM.On("CreateRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
 		var queryerWrapper pg.Q
-		args.Get(3).(pg.QOpt)(&queryerWrapper)
+		args.Get(4).(pg.QOpt)(&queryerWrapper)
 		<-queryerWrapper.ParentCtx.Done()
 		ormCallExited.Done()
 	}).Return(errors.New("timeout!"))
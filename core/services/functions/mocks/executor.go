@@ -0,0 +1,56 @@
+// Code generated by mockery v2.22.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	functions "github.com/smartcontractkit/chainlink/v2/core/services/functions"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Executor is an autogenerated mock type for the Executor type
+type Executor struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, req
+func (_m *Executor) Execute(ctx context.Context, req functions.ExecutionRequest) (*functions.ExecutionResult, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *functions.ExecutionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, functions.ExecutionRequest) (*functions.ExecutionResult, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, functions.ExecutionRequest) *functions.ExecutionResult); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*functions.ExecutionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, functions.ExecutionRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewExecutor interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewExecutor creates a new instance of Executor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewExecutor(t mockConstructorTestingTNewExecutor) *Executor {
+	mock := &Executor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -17,20 +17,20 @@ type ORM struct {
 	mock.Mock
 }
 
-// CreateRequest provides a mock function with given fields: requestID, receivedAt, requestTxHash, qopts
-func (_m *ORM) CreateRequest(requestID functions.RequestID, receivedAt time.Time, requestTxHash *common.Hash, qopts ...pg.QOpt) error {
+// CreateRequest provides a mock function with given fields: requestID, subscriptionID, receivedAt, requestTxHash, qopts
+func (_m *ORM) CreateRequest(requestID functions.RequestID, subscriptionID uint64, receivedAt time.Time, requestTxHash *common.Hash, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
 	for _i := range qopts {
 		_va[_i] = qopts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, requestID, receivedAt, requestTxHash)
+	_ca = append(_ca, requestID, subscriptionID, receivedAt, requestTxHash)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(functions.RequestID, time.Time, *common.Hash, ...pg.QOpt) error); ok {
-		r0 = rf(requestID, receivedAt, requestTxHash, qopts...)
+	if rf, ok := ret.Get(0).(func(functions.RequestID, uint64, time.Time, *common.Hash, ...pg.QOpt) error); ok {
+		r0 = rf(requestID, subscriptionID, receivedAt, requestTxHash, qopts...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -104,20 +104,20 @@ func (_m *ORM) FindOldestEntriesByState(state functions.RequestState, limit uint
 	return r0, r1
 }
 
-// SetConfirmed provides a mock function with given fields: requestID, qopts
-func (_m *ORM) SetConfirmed(requestID functions.RequestID, qopts ...pg.QOpt) error {
+// SetConfirmed provides a mock function with given fields: requestID, confirmedAt, qopts
+func (_m *ORM) SetConfirmed(requestID functions.RequestID, confirmedAt time.Time, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
 	for _i := range qopts {
 		_va[_i] = qopts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, requestID)
+	_ca = append(_ca, requestID, confirmedAt)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(functions.RequestID, ...pg.QOpt) error); ok {
-		r0 = rf(requestID, qopts...)
+	if rf, ok := ret.Get(0).(func(functions.RequestID, time.Time, ...pg.QOpt) error); ok {
+		r0 = rf(requestID, confirmedAt, qopts...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -146,20 +146,20 @@ func (_m *ORM) SetError(requestID functions.RequestID, runID int64, errorType fu
 	return r0
 }
 
-// SetFinalized provides a mock function with given fields: requestID, reportedResult, reportedError, qopts
-func (_m *ORM) SetFinalized(requestID functions.RequestID, reportedResult []byte, reportedError []byte, qopts ...pg.QOpt) error {
+// SetFinalized provides a mock function with given fields: requestID, reportedResult, reportedError, finalizedAt, qopts
+func (_m *ORM) SetFinalized(requestID functions.RequestID, reportedResult []byte, reportedError []byte, finalizedAt time.Time, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
 	for _i := range qopts {
 		_va[_i] = qopts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, requestID, reportedResult, reportedError)
+	_ca = append(_ca, requestID, reportedResult, reportedError, finalizedAt)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(functions.RequestID, []byte, []byte, ...pg.QOpt) error); ok {
-		r0 = rf(requestID, reportedResult, reportedError, qopts...)
+	if rf, ok := ret.Get(0).(func(functions.RequestID, []byte, []byte, time.Time, ...pg.QOpt) error); ok {
+		r0 = rf(requestID, reportedResult, reportedError, finalizedAt, qopts...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,70 @@
+// Code generated by mockery v2.22.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	functions "github.com/smartcontractkit/chainlink/v2/core/services/functions"
+	mock "github.com/stretchr/testify/mock"
+
+	pg "github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+// RequestsORM is an autogenerated mock type for the RequestsORM type
+type RequestsORM struct {
+	mock.Mock
+}
+
+// FindRequests provides a mock function with given fields: filter, offset, limit, qopts
+func (_m *RequestsORM) FindRequests(filter functions.RequestFilter, offset int, limit int, qopts ...pg.QOpt) ([]functions.Request, int, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, filter, offset, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []functions.Request
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(functions.RequestFilter, int, int, ...pg.QOpt) ([]functions.Request, int, error)); ok {
+		return rf(filter, offset, limit, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(functions.RequestFilter, int, int, ...pg.QOpt) []functions.Request); ok {
+		r0 = rf(filter, offset, limit, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]functions.Request)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(functions.RequestFilter, int, int, ...pg.QOpt) int); ok {
+		r1 = rf(filter, offset, limit, qopts...)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(functions.RequestFilter, int, int, ...pg.QOpt) error); ok {
+		r2 = rf(filter, offset, limit, qopts...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+type mockConstructorTestingTNewRequestsORM interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRequestsORM creates a new instance of RequestsORM. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRequestsORM(t mockConstructorTestingTNewRequestsORM) *RequestsORM {
+	mock := &RequestsORM{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -15,6 +15,8 @@ const RequestIDLength int = 32
 type RequestID [RequestIDLength]byte
 type Request struct {
 	RequestID         RequestID
+	ContractAddress   common.Address
+	SubscriptionID    *uint64
 	RunID             *int64
 	ReceivedAt        time.Time
 	RequestTxHash     *common.Hash
@@ -25,7 +27,22 @@ type Request struct {
 	Error             []byte
 	TransmittedResult []byte
 	TransmittedError  []byte
-	// TODO: add timestamps for other possible states: https://app.shortcut.com/chainlinklabs/story/58428/timestamp-fields-for-all-states-in-ocr2dr-data-model
+	// Timestamps of the transitions to the FINALIZED, CONFIRMED and TIMED_OUT states.
+	// ReceivedAt and ResultReadyAt are the timestamps of the IN_PROGRESS and RESULT_READY states.
+	FinalizedAt *time.Time
+	ConfirmedAt *time.Time
+	TimedOutAt  *time.Time
+}
+
+// RequestFilter restricts the requests returned by RequestsORM.FindRequests.
+// Empty fields do not restrict the requests.
+type RequestFilter struct {
+	ContractAddress *common.Address
+	States          []RequestState
+	SubscriptionID  *uint64
+	// ReceivedAfter and ReceivedBefore bound the time window in which requests were received.
+	ReceivedAfter  *time.Time
+	ReceivedBefore *time.Time
 }
 
 type RequestState int8
@@ -149,6 +166,16 @@ func (r RequestID) Value() (driver.Value, error) {
 	return r[:], nil
 }
 
+// ParseRequestState parses the name of a RequestState, as returned by String().
+func ParseRequestState(s string) (RequestState, error) {
+	for state := IN_PROGRESS; state <= CONFIRMED; state++ {
+		if state.String() == s {
+			return state, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown request state: %s", s)
+}
+
 func (s RequestState) String() string {
 	switch s {
 	case IN_PROGRESS:
@@ -2,9 +2,11 @@ package functions
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
 
 	"github.com/smartcontractkit/sqlx"
 
@@ -15,12 +17,12 @@ import (
 //go:generate mockery --quiet --name ORM --output ./mocks/ --case=underscore
 
 type ORM interface {
-	CreateRequest(requestID RequestID, receivedAt time.Time, requestTxHash *common.Hash, qopts ...pg.QOpt) error
+	CreateRequest(requestID RequestID, subscriptionID uint64, receivedAt time.Time, requestTxHash *common.Hash, qopts ...pg.QOpt) error
 
 	SetResult(requestID RequestID, runID int64, computationResult []byte, readyAt time.Time, qopts ...pg.QOpt) error
 	SetError(requestID RequestID, runID int64, errorType ErrType, computationError []byte, readyAt time.Time, readyForProcessing bool, qopts ...pg.QOpt) error
-	SetFinalized(requestID RequestID, reportedResult []byte, reportedError []byte, qopts ...pg.QOpt) error
-	SetConfirmed(requestID RequestID, qopts ...pg.QOpt) error
+	SetFinalized(requestID RequestID, reportedResult []byte, reportedError []byte, finalizedAt time.Time, qopts ...pg.QOpt) error
+	SetConfirmed(requestID RequestID, confirmedAt time.Time, qopts ...pg.QOpt) error
 
 	TimeoutExpiredResults(cutoff time.Time, limit uint32, qopts ...pg.QOpt) ([]RequestID, error)
 
@@ -35,9 +37,9 @@ type orm struct {
 
 var _ ORM = (*orm)(nil)
 
-const requestFields = "request_id, run_id, received_at, request_tx_hash, " +
+const requestFields = "request_id, contract_address, subscription_id, run_id, received_at, request_tx_hash, " +
 	"state, result_ready_at, result, error_type, error, " +
-	"transmitted_result, transmitted_error"
+	"transmitted_result, transmitted_error, finalized_at, confirmed_at, timed_out_at"
 
 func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig, contractAddress common.Address) ORM {
 	return &orm{
@@ -46,12 +48,12 @@ func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig, contractAddress com
 	}
 }
 
-func (o orm) CreateRequest(requestID RequestID, receivedAt time.Time, requestTxHash *common.Hash, qopts ...pg.QOpt) error {
+func (o orm) CreateRequest(requestID RequestID, subscriptionID uint64, receivedAt time.Time, requestTxHash *common.Hash, qopts ...pg.QOpt) error {
 	stmt := `
-		INSERT INTO ocr2dr_requests (request_id, contract_address, received_at, request_tx_hash, state)
-		VALUES ($1,$2,$3,$4,$5);
+		INSERT INTO ocr2dr_requests (request_id, contract_address, subscription_id, received_at, request_tx_hash, state)
+		VALUES ($1,$2,$3,$4,$5,$6);
 	`
-	return o.q.WithOpts(qopts...).ExecQ(stmt, requestID, o.contractAddress, receivedAt, requestTxHash, IN_PROGRESS)
+	return o.q.WithOpts(qopts...).ExecQ(stmt, requestID, o.contractAddress, subscriptionID, receivedAt, requestTxHash, IN_PROGRESS)
 }
 
 func (o orm) setWithStateTransitionCheck(requestID RequestID, newState RequestState, setter func(pg.Queryer) error, qopts ...pg.QOpt) error {
@@ -103,25 +105,25 @@ func (o orm) SetError(requestID RequestID, runID int64, errorType ErrType, compu
 	return err
 }
 
-func (o orm) SetFinalized(requestID RequestID, reportedResult []byte, reportedError []byte, qopts ...pg.QOpt) error {
+func (o orm) SetFinalized(requestID RequestID, reportedResult []byte, reportedError []byte, finalizedAt time.Time, qopts ...pg.QOpt) error {
 	newState := FINALIZED
 	err := o.setWithStateTransitionCheck(requestID, newState, func(tx pg.Queryer) error {
 		stmt := `
 			UPDATE ocr2dr_requests
-			SET transmitted_result=$3, transmitted_error=$4, state=$5
+			SET transmitted_result=$3, transmitted_error=$4, finalized_at=$5, state=$6
 			WHERE request_id=$1 AND contract_address=$2;
 		`
-		_, err2 := tx.Exec(stmt, requestID, o.contractAddress, reportedResult, reportedError, newState)
+		_, err2 := tx.Exec(stmt, requestID, o.contractAddress, reportedResult, reportedError, finalizedAt, newState)
 		return err2
 	}, qopts...)
 	return err
 }
 
-func (o orm) SetConfirmed(requestID RequestID, qopts ...pg.QOpt) error {
+func (o orm) SetConfirmed(requestID RequestID, confirmedAt time.Time, qopts ...pg.QOpt) error {
 	newState := CONFIRMED
 	err := o.setWithStateTransitionCheck(requestID, newState, func(tx pg.Queryer) error {
-		stmt := `UPDATE ocr2dr_requests SET state=$3 WHERE request_id=$1 AND contract_address=$2;`
-		_, err2 := tx.Exec(stmt, requestID, o.contractAddress, newState)
+		stmt := `UPDATE ocr2dr_requests SET confirmed_at=$3, state=$4 WHERE request_id=$1 AND contract_address=$2;`
+		_, err2 := tx.Exec(stmt, requestID, o.contractAddress, confirmedAt, newState)
 		return err2
 	}, qopts...)
 	return err
@@ -153,12 +155,13 @@ func (o orm) TimeoutExpiredResults(cutoff time.Time, limit uint32, qopts ...pg.Q
 
 		a := map[string]any{
 			"nextState":    nextState,
+			"timedOutAt":   time.Now(),
 			"contractAddr": o.contractAddress,
 			"ids":          ids,
 		}
 		updateStmt, args, err2 := sqlx.Named(`
 			UPDATE ocr2dr_requests
-			SET state = :nextState
+			SET state = :nextState, timed_out_at = :timedOutAt
 			WHERE contract_address = :contractAddr AND request_id IN (:ids);`, a)
 		if err2 != nil {
 			return err2
@@ -194,3 +197,67 @@ func (o orm) FindById(requestID RequestID, qopts ...pg.QOpt) (*Request, error) {
 	}
 	return &request, nil
 }
+
+//go:generate mockery --quiet --name RequestsORM --output ./mocks/ --case=underscore
+
+// RequestsORM queries the Functions requests of every contract.
+type RequestsORM interface {
+	// FindRequests returns the requests matching the filter, most recently received first, and the total number of
+	// matching requests.
+	FindRequests(filter RequestFilter, offset, limit int, qopts ...pg.QOpt) ([]Request, int, error)
+}
+
+type requestsORM struct {
+	q pg.Q
+}
+
+var _ RequestsORM = (*requestsORM)(nil)
+
+func NewRequestsORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) RequestsORM {
+	return &requestsORM{q: pg.NewQ(db, lggr, cfg)}
+}
+
+func (o *requestsORM) FindRequests(filter RequestFilter, offset, limit int, qopts ...pg.QOpt) (requests []Request, count int, err error) {
+	var conds []string
+	var args []any
+	addCond := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if filter.ContractAddress != nil {
+		addCond("contract_address = $%d", *filter.ContractAddress)
+	}
+	if len(filter.States) > 0 {
+		states := make(pq.Int64Array, len(filter.States))
+		for i, state := range filter.States {
+			states[i] = int64(state)
+		}
+		addCond("state = ANY($%d)", states)
+	}
+	if filter.SubscriptionID != nil {
+		addCond("subscription_id = $%d", *filter.SubscriptionID)
+	}
+	if filter.ReceivedAfter != nil {
+		addCond("received_at >= $%d", *filter.ReceivedAfter)
+	}
+	if filter.ReceivedBefore != nil {
+		addCond("received_at < $%d", *filter.ReceivedBefore)
+	}
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	err = o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
+		if err2 := tx.Get(&count, fmt.Sprintf(`SELECT count(*) FROM ocr2dr_requests %s;`, where), args...); err2 != nil {
+			return fmt.Errorf("failed to count requests: %w", err2)
+		}
+		stmt := fmt.Sprintf(`SELECT %s FROM ocr2dr_requests %s ORDER BY received_at DESC, request_id LIMIT $%d OFFSET $%d;`,
+			requestFields, where, len(args)+1, len(args)+2)
+		if err2 := tx.Select(&requests, stmt, append(args, limit, offset)...); err2 != nil {
+			return fmt.Errorf("failed to find requests: %w", err2)
+		}
+		return nil
+	}, pg.OptReadOnlyTx())
+	return
+}
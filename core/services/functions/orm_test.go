@@ -37,9 +37,13 @@ func createRequest(t *testing.T, orm functions.ORM) (functions.RequestID, common
 }
 
 func createRequestWithTimestamp(t *testing.T, orm functions.ORM, ts time.Time) (functions.RequestID, common.Hash) {
+	return createRequestWithSubscription(t, orm, ts, 1)
+}
+
+func createRequestWithSubscription(t *testing.T, orm functions.ORM, ts time.Time, subscriptionID uint64) (functions.RequestID, common.Hash) {
 	id := newRequestID()
 	txHash := testutils.NewAddress().Hash()
-	err := orm.CreateRequest(id, ts, &txHash)
+	err := orm.CreateRequest(id, subscriptionID, ts, &txHash)
 	require.NoError(t, err)
 	return id, txHash
 }
@@ -57,6 +61,8 @@ func TestORM_CreateRequestsAndFindByID(t *testing.T) {
 	require.Equal(t, &txHash1, req1.RequestTxHash)
 	require.Equal(t, ts1, req1.ReceivedAt)
 	require.Equal(t, functions.IN_PROGRESS, req1.State)
+	require.NotNil(t, req1.SubscriptionID)
+	require.Equal(t, uint64(1), *req1.SubscriptionID)
 
 	req2, err := orm.FindById(id2)
 	require.NoError(t, err)
@@ -72,9 +78,9 @@ func TestORM_CreateRequestsAndFindByID(t *testing.T) {
 	})
 
 	t.Run("duplicated", func(t *testing.T) {
-		err := orm.CreateRequest(id1, ts1, &txHash1)
+		err := orm.CreateRequest(id1, 1, ts1, &txHash1)
 		require.Error(t, err)
-		err = orm.CreateRequest(id1, ts1, &txHash1)
+		err = orm.CreateRequest(id1, 1, ts1, &txHash1)
 		require.Error(t, err)
 	})
 }
@@ -151,7 +157,8 @@ func TestORM_SetFinalized(t *testing.T) {
 	orm := setupORM(t)
 	id, _, _ := createRequest(t, orm)
 
-	err := orm.SetFinalized(id, []byte("result"), []byte("error"))
+	fts := time.Now().Round(time.Second)
+	err := orm.SetFinalized(id, []byte("result"), []byte("error"), fts)
 	require.NoError(t, err)
 
 	req, err := orm.FindById(id)
@@ -159,6 +166,8 @@ func TestORM_SetFinalized(t *testing.T) {
 	require.Equal(t, []byte("result"), req.TransmittedResult)
 	require.Equal(t, []byte("error"), req.TransmittedError)
 	require.Equal(t, functions.FINALIZED, req.State)
+	require.NotNil(t, req.FinalizedAt)
+	require.Equal(t, fts, *req.FinalizedAt)
 }
 
 func TestORM_SetConfirmed(t *testing.T) {
@@ -167,12 +176,15 @@ func TestORM_SetConfirmed(t *testing.T) {
 	orm := setupORM(t)
 	id, _, _ := createRequest(t, orm)
 
-	err := orm.SetConfirmed(id)
+	cts := time.Now().Round(time.Second)
+	err := orm.SetConfirmed(id, cts)
 	require.NoError(t, err)
 
 	req, err := orm.FindById(id)
 	require.NoError(t, err)
 	require.Equal(t, functions.CONFIRMED, req.State)
+	require.NotNil(t, req.ConfirmedAt)
+	require.Equal(t, cts, *req.ConfirmedAt)
 }
 
 func TestORM_StateTransitions(t *testing.T) {
@@ -196,14 +208,15 @@ func TestORM_StateTransitions(t *testing.T) {
 	req, err = orm.FindById(id)
 	require.NoError(t, err)
 	require.Equal(t, functions.TIMED_OUT, req.State)
+	require.NotNil(t, req.TimedOutAt)
 
-	err = orm.SetFinalized(id, nil, nil)
+	err = orm.SetFinalized(id, nil, nil, now)
 	require.Error(t, err)
 	req, err = orm.FindById(id)
 	require.NoError(t, err)
 	require.Equal(t, functions.TIMED_OUT, req.State)
 
-	err = orm.SetConfirmed(id)
+	err = orm.SetConfirmed(id, now)
 	require.NoError(t, err)
 	req, err = orm.FindById(id)
 	require.NoError(t, err)
@@ -253,10 +266,10 @@ func TestORM_TimeoutExpiredResults(t *testing.T) {
 	// can time out IN_PROGRESS, RESULT_READY or FINALIZED
 	err := orm.SetResult(ids[0], 123, []byte("result"), now)
 	require.NoError(t, err)
-	err = orm.SetFinalized(ids[1], []byte("result"), []byte(""))
+	err = orm.SetFinalized(ids[1], []byte("result"), []byte(""), now)
 	require.NoError(t, err)
 	// can't time out CONFIRMED
-	err = orm.SetConfirmed(ids[2])
+	err = orm.SetConfirmed(ids[2], now)
 	require.NoError(t, err)
 
 	results, err := orm.TimeoutExpiredResults(now.Add(-35*time.Minute), 1)
@@ -287,3 +300,58 @@ func TestORM_TimeoutExpiredResults(t *testing.T) {
 		require.Equal(t, req.State, expectedState, "incorrect state")
 	}
 }
+
+func TestRequestsORM_FindRequests(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	contract1, contract2 := testutils.NewAddress(), testutils.NewAddress()
+	orm1 := functions.NewORM(db, lggr, pgtest.NewQConfig(true), contract1)
+	orm2 := functions.NewORM(db, lggr, pgtest.NewQConfig(true), contract2)
+	requestsORM := functions.NewRequestsORM(db, lggr, pgtest.NewQConfig(true))
+
+	now := time.Now().Round(time.Second)
+	id1, _ := createRequestWithSubscription(t, orm1, now.Add(-3*time.Minute), 1)
+	id2, _ := createRequestWithSubscription(t, orm1, now.Add(-2*time.Minute), 2)
+	id3, _ := createRequestWithSubscription(t, orm2, now.Add(-1*time.Minute), 2)
+	require.NoError(t, orm1.SetResult(id2, 0, []byte("result"), now))
+
+	requestIDs := func(reqs []functions.Request) (ids []functions.RequestID) {
+		for _, r := range reqs {
+			ids = append(ids, r.RequestID)
+		}
+		return
+	}
+	subscriptionID := uint64(2)
+	after, before := now.Add(-150*time.Second), now.Add(-90*time.Second)
+
+	for _, tc := range []struct {
+		name   string
+		filter functions.RequestFilter
+		ids    []functions.RequestID
+	}{
+		{"no filter", functions.RequestFilter{}, []functions.RequestID{id3, id2, id1}},
+		{"contract", functions.RequestFilter{ContractAddress: &contract1}, []functions.RequestID{id2, id1}},
+		{"states", functions.RequestFilter{States: []functions.RequestState{functions.RESULT_READY, functions.CONFIRMED}}, []functions.RequestID{id2}},
+		{"subscription", functions.RequestFilter{SubscriptionID: &subscriptionID}, []functions.RequestID{id3, id2}},
+		{"time window", functions.RequestFilter{ReceivedAfter: &after, ReceivedBefore: &before}, []functions.RequestID{id2}},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			reqs, count, err := requestsORM.FindRequests(tc.filter, 0, 10)
+			require.NoError(t, err)
+			require.Equal(t, len(tc.ids), count)
+			require.Equal(t, tc.ids, requestIDs(reqs))
+		})
+	}
+
+	t.Run("pagination", func(t *testing.T) {
+		reqs, count, err := requestsORM.FindRequests(functions.RequestFilter{}, 1, 1)
+		require.NoError(t, err)
+		require.Equal(t, 3, count)
+		require.Equal(t, []functions.RequestID{id2}, requestIDs(reqs))
+		require.Equal(t, contract1, reqs[0].ContractAddress)
+		require.Equal(t, functions.RESULT_READY, reqs[0].State)
+	})
+}
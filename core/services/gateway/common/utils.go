@@ -0,0 +1,43 @@
+package common
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// SignatureLength is the length in bytes of signatures produced by SignData
+const SignatureLength = crypto.SignatureLength
+
+// SignData signs the keccak256 hash of the concatenated data, returning a 65
+// byte [R || S || V] signature.
+func SignData(privateKey *ecdsa.PrivateKey, data ...[]byte) ([]byte, error) {
+	hash := crypto.Keccak256(data...)
+	return crypto.Sign(hash, privateKey)
+}
+
+// ExtractSigner recovers the address that produced signature over the
+// concatenated data.
+func ExtractSigner(signature []byte, data ...[]byte) (common.Address, error) {
+	if len(signature) != SignatureLength {
+		return common.Address{}, errors.Errorf("invalid signature length: expected %d, got %d", SignatureLength, len(signature))
+	}
+	hash := crypto.Keccak256(data...)
+	pubKey, err := crypto.SigToPub(hash, signature)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "failed to recover signer")
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// Uint32ToBytes returns the big-endian encoding of val
+func Uint32ToBytes(val uint32) []byte {
+	return []byte{byte(val >> 24), byte(val >> 16), byte(val >> 8), byte(val)}
+}
+
+// BytesToUint32 decodes a big-endian uint32 from the first 4 bytes of data
+func BytesToUint32(data []byte) uint32 {
+	return uint32(data[0])<<24 | uint32(data[1])<<16 | uint32(data[2])<<8 | uint32(data[3])
+}
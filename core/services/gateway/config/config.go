@@ -0,0 +1,42 @@
+package config
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+)
+
+type GatewayConfig struct {
+	UserServerConfig        network.HTTPServerConfig
+	NodeServerConfig        network.WebSocketServerConfig
+	ConnectionManagerConfig ConnectionManagerConfig
+	UserMessageConfig       UserMessageConfig
+	Dons                    []DONConfig
+}
+
+type ConnectionManagerConfig struct {
+	// AuthGatewayId is the ID nodes must include in their auth header
+	AuthGatewayId string
+	// AuthTimestampToleranceSec is how far from the current time the
+	// timestamps of auth headers and challenge responses may be
+	AuthTimestampToleranceSec uint32
+	// AuthChallengeLen is the number of random bytes in each challenge
+	AuthChallengeLen uint32
+}
+
+type UserMessageConfig struct {
+	// ReplayWindowSec is how long a MessageId is remembered per sender, so
+	// that signed messages can't be replayed. Messages are not timestamped,
+	// so they are only rejected as replays within this window, and not
+	// across restarts. 0 disables replay protection.
+	ReplayWindowSec uint32
+}
+
+type DONConfig struct {
+	DonId       string
+	HandlerName string
+	Members     []NodeConfig
+}
+
+type NodeConfig struct {
+	Name    string
+	Address string
+}
@@ -0,0 +1,295 @@
+package gateway
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	gw_common "github.com/smartcontractkit/chainlink/v2/core/services/gateway/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// ConnectionManager holds the websocket connections of all DON members and
+// authenticates them when they connect.
+type ConnectionManager interface {
+	job.ServiceCtx
+	network.ConnectionAcceptor
+
+	// DONConnectionManager returns the manager for the given DON, or nil if
+	// the DON is not configured.
+	DONConnectionManager(donId string) DONConnectionManager
+}
+
+// DONConnectionManager routes messages between a Handler and the members of
+// its DON.
+type DONConnectionManager interface {
+	DON
+	// SetHandler must be called for each DON before the ConnectionManager is started.
+	SetHandler(handler Handler)
+}
+
+type connectionManager struct {
+	utils.StartStopOnce
+
+	config         *config.ConnectionManagerConfig
+	dons           map[string]*donConnectionManager
+	now            func() time.Time
+	attemptsMu     sync.Mutex
+	activeAttempts map[string]*connAttempt
+	chStop         utils.StopChan
+	wg             sync.WaitGroup
+	lggr           logger.Logger
+}
+
+type donConnectionManager struct {
+	donConfig *config.DONConfig
+	nodes     map[common.Address]*nodeState
+	handler   Handler
+	chStop    utils.StopChan
+	lggr      logger.Logger
+}
+
+type nodeState struct {
+	name string
+	conn network.WSConnectionWrapper
+}
+
+// connAttempt is a handshake which has been started but not finalized
+type connAttempt struct {
+	nodeState *nodeState
+	nodeAddr  common.Address
+	challenge []byte
+	timestamp uint32
+}
+
+func NewConnectionManager(gwConfig *config.GatewayConfig, lggr logger.Logger) (ConnectionManager, error) {
+	chStop := make(utils.StopChan)
+	dons := make(map[string]*donConnectionManager)
+	for i := range gwConfig.Dons {
+		donConfig := &gwConfig.Dons[i]
+		if _, ok := dons[donConfig.DonId]; ok {
+			return nil, errors.Errorf("duplicate DON ID %s", donConfig.DonId)
+		}
+		nodes := make(map[common.Address]*nodeState)
+		for _, member := range donConfig.Members {
+			if !common.IsHexAddress(member.Address) {
+				return nil, errors.Errorf("invalid address %q for node %s of DON %s", member.Address, member.Name, donConfig.DonId)
+			}
+			addr := common.HexToAddress(member.Address)
+			if _, ok := nodes[addr]; ok {
+				return nil, errors.Errorf("duplicate node address %s in DON %s", addr, donConfig.DonId)
+			}
+			nodes[addr] = &nodeState{name: member.Name, conn: network.NewWSConnectionWrapper()}
+		}
+		dons[donConfig.DonId] = &donConnectionManager{
+			donConfig: donConfig,
+			nodes:     nodes,
+			chStop:    chStop,
+			lggr:      lggr.Named("DONConnectionManager." + donConfig.DonId),
+		}
+	}
+	return &connectionManager{
+		config:         &gwConfig.ConnectionManagerConfig,
+		dons:           dons,
+		now:            time.Now,
+		activeAttempts: make(map[string]*connAttempt),
+		chStop:         chStop,
+		lggr:           lggr.Named("ConnectionManager"),
+	}, nil
+}
+
+func (m *connectionManager) DONConnectionManager(donId string) DONConnectionManager {
+	if don, ok := m.dons[donId]; ok {
+		return don
+	}
+	return nil
+}
+
+func (m *connectionManager) Start(ctx context.Context) error {
+	return m.StartOnce("ConnectionManager", func() error {
+		m.lggr.Info("starting connection manager")
+		for _, don := range m.dons {
+			if don.handler == nil {
+				return errors.Errorf("no handler set for DON %s", don.donConfig.DonId)
+			}
+		}
+		for _, don := range m.dons {
+			for nodeAddr, state := range don.nodes {
+				m.wg.Add(1)
+				go func(nodeAddr common.Address, state *nodeState, don *donConnectionManager) {
+					defer m.wg.Done()
+					don.readLoop(nodeAddr, state)
+				}(nodeAddr, state, don)
+			}
+		}
+		return nil
+	})
+}
+
+func (m *connectionManager) Close() error {
+	return m.StopOnce("ConnectionManager", func() error {
+		m.lggr.Info("closing connection manager")
+		close(m.chStop)
+		for _, don := range m.dons {
+			for _, nodeState := range don.nodes {
+				nodeState.conn.Close()
+			}
+		}
+		m.wg.Wait()
+		return nil
+	})
+}
+
+func (m *connectionManager) StartHandshake(authHeader []byte) (attemptId string, challenge []byte, err error) {
+	elems, signer, err := UnpackAuthHeader(authHeader)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "invalid auth header")
+	}
+	if elems.Version != HandshakeVersion {
+		return "", nil, errors.Errorf("unsupported handshake version %d", elems.Version)
+	}
+	if elems.GatewayId != m.config.AuthGatewayId {
+		return "", nil, errors.Errorf("invalid gateway ID %q", elems.GatewayId)
+	}
+	if err = m.checkTimestamp(elems.Timestamp); err != nil {
+		return "", nil, err
+	}
+	don, ok := m.dons[elems.DonId]
+	if !ok {
+		return "", nil, errors.Errorf("unknown DON ID %q", elems.DonId)
+	}
+	nodeState, ok := don.nodes[signer]
+	if !ok {
+		return "", nil, errors.Errorf("node %s is not a member of DON %s", signer, elems.DonId)
+	}
+
+	challengeBytes := make([]byte, m.config.AuthChallengeLen)
+	if _, err = rand.Read(challengeBytes); err != nil {
+		return "", nil, errors.Wrap(err, "failed to generate challenge")
+	}
+	challengeElems := ChallengeElems{
+		Timestamp:      uint32(m.now().Unix()),
+		GatewayId:      m.config.AuthGatewayId,
+		ChallengeBytes: challengeBytes,
+	}
+	challenge, err = PackChallenge(&challengeElems)
+	if err != nil {
+		return "", nil, err
+	}
+	attemptId = hex.EncodeToString(challengeBytes)
+
+	m.attemptsMu.Lock()
+	defer m.attemptsMu.Unlock()
+	m.pruneAttempts()
+	m.activeAttempts[attemptId] = &connAttempt{
+		nodeState: nodeState,
+		nodeAddr:  signer,
+		challenge: challenge,
+		timestamp: challengeElems.Timestamp,
+	}
+	return attemptId, challenge, nil
+}
+
+func (m *connectionManager) FinalizeHandshake(attemptId string, response []byte, conn *websocket.Conn) error {
+	m.attemptsMu.Lock()
+	attempt, ok := m.activeAttempts[attemptId]
+	delete(m.activeAttempts, attemptId)
+	m.attemptsMu.Unlock()
+	if !ok {
+		return errors.New("unknown or expired handshake attempt")
+	}
+	if err := m.checkTimestamp(attempt.timestamp); err != nil {
+		return err
+	}
+	signer, err := gw_common.ExtractSigner(response, attempt.challenge)
+	if err != nil {
+		return errors.Wrap(err, "invalid challenge response")
+	}
+	if signer != attempt.nodeAddr {
+		return errors.Errorf("challenge response signed by %s, expected %s", signer, attempt.nodeAddr)
+	}
+	m.lggr.Infow("node connected", "nodeName", attempt.nodeState.name, "nodeAddress", attempt.nodeAddr)
+	// replaces any previous connection from the same node
+	attempt.nodeState.conn.Reset(conn)
+	return nil
+}
+
+func (m *connectionManager) AbortHandshake(attemptId string) {
+	m.attemptsMu.Lock()
+	defer m.attemptsMu.Unlock()
+	delete(m.activeAttempts, attemptId)
+}
+
+func (m *connectionManager) checkTimestamp(timestamp uint32) error {
+	now := m.now().Unix()
+	tolerance := int64(m.config.AuthTimestampToleranceSec)
+	if int64(timestamp) < now-tolerance || int64(timestamp) > now+tolerance {
+		return errors.Errorf("timestamp %d is outside the allowed tolerance of %ds", timestamp, tolerance)
+	}
+	return nil
+}
+
+// pruneAttempts removes expired attempts. Must be called with attemptsMu held.
+func (m *connectionManager) pruneAttempts() {
+	for id, attempt := range m.activeAttempts {
+		if m.checkTimestamp(attempt.timestamp) != nil {
+			delete(m.activeAttempts, id)
+		}
+	}
+}
+
+func (d *donConnectionManager) SetHandler(handler Handler) {
+	d.handler = handler
+}
+
+func (d *donConnectionManager) SendToNode(ctx context.Context, nodeAddress string, msg *Message) error {
+	if msg == nil {
+		return errors.New("nil message")
+	}
+	nodeState, ok := d.nodes[common.HexToAddress(nodeAddress)]
+	if !ok {
+		return errors.Errorf("node %s is not a member of DON %s", nodeAddress, d.donConfig.DonId)
+	}
+	data, err := EncodeRequest(msg)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode message")
+	}
+	return nodeState.conn.Write(websocket.BinaryMessage, data)
+}
+
+func (d *donConnectionManager) readLoop(nodeAddr common.Address, nodeState *nodeState) {
+	ctx, cancel := d.chStop.NewCtx()
+	defer cancel()
+	for item := range nodeState.conn.ReadChannel() {
+		msg, err := DecodeResponse(item.Data)
+		if err != nil {
+			d.lggr.Errorw("failed to decode message from node", "nodeName", nodeState.name, "err", err)
+			continue
+		}
+		if msg == nil || msg.Body.DonId != d.donConfig.DonId {
+			d.lggr.Errorw("received message for the wrong DON", "nodeName", nodeState.name)
+			continue
+		}
+		if err = msg.Validate(); err != nil {
+			d.lggr.Errorw("received message with invalid signature", "nodeName", nodeState.name, "err", err)
+			continue
+		}
+		if common.HexToAddress(msg.Body.Sender) != nodeAddr {
+			d.lggr.Errorw("received message signed by another sender", "nodeName", nodeState.name, "sender", msg.Body.Sender)
+			continue
+		}
+		if err = d.handler.HandleNodeMessage(ctx, msg, nodeAddr.Hex()); err != nil {
+			d.lggr.Errorw("handler failed to process node message", "nodeName", nodeState.name, "err", err)
+		}
+	}
+}
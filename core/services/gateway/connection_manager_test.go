@@ -0,0 +1,86 @@
+package gateway_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	gw_common "github.com/smartcontractkit/chainlink/v2/core/services/gateway/common"
+)
+
+func TestConnectionManager_StartHandshake(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	cfg := parseGatewayConfig(t, 1000, crypto.PubkeyToAddress(key.PublicKey).Hex())
+	mgr, err := gateway.NewConnectionManager(cfg, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	validElems := func() gateway.AuthHeaderElems {
+		return gateway.AuthHeaderElems{
+			Version:   gateway.HandshakeVersion,
+			Timestamp: uint32(time.Now().Unix()),
+			DonId:     "test_don",
+			GatewayId: "test_gateway",
+		}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		elems := validElems()
+		header, err := gateway.PackAuthHeader(&elems, key)
+		require.NoError(t, err)
+		attemptId, challenge, err := mgr.StartHandshake(header)
+		require.NoError(t, err)
+		assert.NotEmpty(t, attemptId)
+
+		challengeElems, err := gateway.UnpackChallenge(challenge)
+		require.NoError(t, err)
+		assert.Equal(t, "test_gateway", challengeElems.GatewayId)
+		assert.Len(t, challengeElems.ChallengeBytes, 32)
+
+		// signed by the wrong key
+		response, err := gw_common.SignData(otherKey, challenge)
+		require.NoError(t, err)
+		require.ErrorContains(t, mgr.FinalizeHandshake(attemptId, response, nil), "challenge response signed by")
+
+		// attempts can only be finalized once
+		response, err = gw_common.SignData(key, challenge)
+		require.NoError(t, err)
+		require.ErrorContains(t, mgr.FinalizeHandshake(attemptId, response, nil), "unknown or expired handshake attempt")
+	})
+
+	for _, tt := range []struct {
+		name   string
+		modify func(*gateway.AuthHeaderElems)
+		signer bool
+		errMsg string
+	}{
+		{"wrong version", func(e *gateway.AuthHeaderElems) { e.Version = 2 }, true, "unsupported handshake version"},
+		{"wrong gateway", func(e *gateway.AuthHeaderElems) { e.GatewayId = "other_gateway" }, true, "invalid gateway ID"},
+		{"stale timestamp", func(e *gateway.AuthHeaderElems) { e.Timestamp -= 60 }, true, "outside the allowed tolerance"},
+		{"unknown DON", func(e *gateway.AuthHeaderElems) { e.DonId = "other_don" }, true, "unknown DON ID"},
+		{"not a member", func(e *gateway.AuthHeaderElems) {}, false, "is not a member of DON"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			elems := validElems()
+			tt.modify(&elems)
+			signerKey := key
+			if !tt.signer {
+				signerKey = otherKey
+			}
+			header, err := gateway.PackAuthHeader(&elems, signerKey)
+			require.NoError(t, err)
+			_, _, err = mgr.StartHandshake(header)
+			require.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+}
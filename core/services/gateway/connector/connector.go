@@ -0,0 +1,229 @@
+package connector
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	gw_common "github.com/smartcontractkit/chainlink/v2/core/services/gateway/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// GatewayConnector is used by nodes to connect to one or more Gateways,
+// receive user requests forwarded by them and send back responses.
+type GatewayConnector interface {
+	job.ServiceCtx
+	network.ConnectionInitiator
+
+	// SendToGateway signs msg with the node's key and sends it to the Gateway.
+	SendToGateway(ctx context.Context, gatewayId string, msg *gateway.Message) error
+}
+
+// GatewayConnectorHandler processes messages received from Gateways.
+type GatewayConnectorHandler interface {
+	HandleGatewayMessage(ctx context.Context, gatewayId string, msg *gateway.Message)
+}
+
+type ConnectorGatewayConfig struct {
+	Id  string
+	URL string
+}
+
+type ConnectorConfig struct {
+	DonId                     string
+	Gateways                  []ConnectorGatewayConfig
+	WsClientConfig            network.WebSocketClientConfig
+	AuthMinChallengeLen       int
+	AuthTimestampToleranceSec uint32
+	ReconnectIntervalMillis   uint32
+}
+
+type gatewayConnector struct {
+	utils.StartStopOnce
+
+	config    *ConnectorConfig
+	signerKey *ecdsa.PrivateKey
+	handler   GatewayConnectorHandler
+	wsClient  network.WebSocketClient
+	gateways  map[string]*gatewayState
+	urlToId   map[string]string
+	now       func() time.Time
+	chStop    utils.StopChan
+	wg        sync.WaitGroup
+	lggr      logger.Logger
+}
+
+type gatewayState struct {
+	conn   network.WSConnectionWrapper
+	config ConnectorGatewayConfig
+	url    *url.URL
+}
+
+var _ GatewayConnector = (*gatewayConnector)(nil)
+
+func NewGatewayConnector(config *ConnectorConfig, signerKey *ecdsa.PrivateKey, handler GatewayConnectorHandler, lggr logger.Logger) (GatewayConnector, error) {
+	if config == nil || signerKey == nil || handler == nil {
+		return nil, errors.New("nil dependency")
+	}
+	if len(config.DonId) == 0 {
+		return nil, errors.New("empty DonId")
+	}
+	connector := &gatewayConnector{
+		config:    config,
+		signerKey: signerKey,
+		handler:   handler,
+		gateways:  make(map[string]*gatewayState),
+		urlToId:   make(map[string]string),
+		now:       time.Now,
+		chStop:    make(utils.StopChan),
+		lggr:      lggr.Named("GatewayConnector"),
+	}
+	connector.wsClient = network.NewWebSocketClient(config.WsClientConfig, connector)
+	for _, gw := range config.Gateways {
+		if _, exists := connector.gateways[gw.Id]; exists {
+			return nil, errors.Errorf("duplicate Gateway ID %s", gw.Id)
+		}
+		parsedURL, err := url.Parse(gw.URL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid URL for Gateway %s", gw.Id)
+		}
+		if _, exists := connector.urlToId[parsedURL.String()]; exists {
+			return nil, errors.Errorf("duplicate Gateway URL %s", gw.URL)
+		}
+		connector.gateways[gw.Id] = &gatewayState{
+			conn:   network.NewWSConnectionWrapper(),
+			config: gw,
+			url:    parsedURL,
+		}
+		connector.urlToId[parsedURL.String()] = gw.Id
+	}
+	return connector, nil
+}
+
+func (c *gatewayConnector) SendToGateway(ctx context.Context, gatewayId string, msg *gateway.Message) error {
+	gw, ok := c.gateways[gatewayId]
+	if !ok {
+		return errors.Errorf("invalid Gateway ID %s", gatewayId)
+	}
+	if err := msg.Sign(c.signerKey); err != nil {
+		return err
+	}
+	data, err := gateway.EncodeResponse(msg)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode message")
+	}
+	return gw.conn.Write(websocket.BinaryMessage, data)
+}
+
+func (c *gatewayConnector) readLoop(gatewayState *gatewayState) {
+	defer c.wg.Done()
+	ctx, cancel := c.chStop.NewCtx()
+	defer cancel()
+
+	for item := range gatewayState.conn.ReadChannel() {
+		msg, err := gateway.DecodeRequest(item.Data)
+		if err != nil || msg == nil {
+			c.lggr.Errorw("failed to decode message from Gateway", "gatewayId", gatewayState.config.Id, "err", err)
+			continue
+		}
+		if err = msg.Validate(); err != nil {
+			c.lggr.Errorw("received message with invalid signature", "gatewayId", gatewayState.config.Id, "err", err)
+			continue
+		}
+		c.handler.HandleGatewayMessage(ctx, gatewayState.config.Id, msg)
+	}
+}
+
+// reconnectLoop keeps a connection to the Gateway open until the connector
+// is closed
+func (c *gatewayConnector) reconnectLoop(gatewayState *gatewayState) {
+	defer c.wg.Done()
+	ctx, cancel := c.chStop.NewCtx()
+	defer cancel()
+
+	interval := time.Duration(c.config.ReconnectIntervalMillis) * time.Millisecond
+	for {
+		if !gatewayState.conn.IsConnected() {
+			conn, err := c.wsClient.Connect(ctx, gatewayState.url)
+			if err != nil {
+				c.lggr.Errorw("failed to connect to Gateway", "gatewayId", gatewayState.config.Id, "err", err)
+			} else {
+				c.lggr.Infow("connected to Gateway", "gatewayId", gatewayState.config.Id)
+				gatewayState.conn.Reset(conn)
+			}
+		}
+		select {
+		case <-c.chStop:
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (c *gatewayConnector) Start(ctx context.Context) error {
+	return c.StartOnce("GatewayConnector", func() error {
+		c.lggr.Info("starting gateway connector")
+		for _, gatewayState := range c.gateways {
+			gatewayState := gatewayState
+			c.wg.Add(2)
+			go c.readLoop(gatewayState)
+			go c.reconnectLoop(gatewayState)
+		}
+		return nil
+	})
+}
+
+func (c *gatewayConnector) Close() error {
+	return c.StopOnce("GatewayConnector", func() (err error) {
+		c.lggr.Info("closing gateway connector")
+		close(c.chStop)
+		for _, gatewayState := range c.gateways {
+			gatewayState.conn.Close()
+		}
+		c.wg.Wait()
+		return nil
+	})
+}
+
+func (c *gatewayConnector) NewAuthHeader(url *url.URL) ([]byte, error) {
+	gatewayId, found := c.urlToId[url.String()]
+	if !found {
+		return nil, errors.Errorf("unknown Gateway URL %s", url.Redacted())
+	}
+	authHeaderElems := &gateway.AuthHeaderElems{
+		Version:   gateway.HandshakeVersion,
+		DonId:     c.config.DonId,
+		GatewayId: gatewayId,
+		Timestamp: uint32(c.now().Unix()),
+	}
+	return gateway.PackAuthHeader(authHeaderElems, c.signerKey)
+}
+
+func (c *gatewayConnector) ChallengeResponse(url *url.URL, challenge []byte) ([]byte, error) {
+	challengeElems, err := gateway.UnpackChallenge(challenge)
+	if err != nil {
+		return nil, err
+	}
+	if len(challengeElems.ChallengeBytes) < c.config.AuthMinChallengeLen {
+		return nil, errors.New("challenge too short")
+	}
+	nowTs := c.now().Unix()
+	ts := int64(challengeElems.Timestamp)
+	tolerance := int64(c.config.AuthTimestampToleranceSec)
+	if ts < nowTs-tolerance || ts > nowTs+tolerance {
+		return nil, errors.New("timestamp outside of tolerance range")
+	}
+	if challengeElems.GatewayId != c.urlToId[url.String()] {
+		return nil, errors.New("invalid gateway ID")
+	}
+	return gw_common.SignData(c.signerKey, challenge)
+}
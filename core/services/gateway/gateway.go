@@ -0,0 +1,187 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// Gateway accepts JSON-RPC requests from users over HTTP, and routes them by
+// DonId to the Handler of that DON, which communicates with the DON's nodes
+// over websocket connections managed by the ConnectionManager.
+type Gateway interface {
+	job.ServiceCtx
+	network.HTTPRequestHandler
+
+	// GetUserPort and GetNodePort return the ports the servers are listening
+	// on. Can be called after Start() returns.
+	GetUserPort() int
+	GetNodePort() int
+}
+
+type gateway struct {
+	utils.StartStopOnce
+
+	httpServer network.HTTPServer
+	wsServer   network.WebSocketServer
+	handlers   map[string]Handler
+	connMgr    ConnectionManager
+	replays    *replayGuard
+	lggr       logger.Logger
+}
+
+// NewGatewayFromConfig creates a Gateway, its servers and a Handler for each
+// configured DON.
+func NewGatewayFromConfig(gwConfig *config.GatewayConfig, lggr logger.Logger) (Gateway, error) {
+	lggr = lggr.Named("Gateway")
+	connMgr, err := NewConnectionManager(gwConfig, lggr)
+	if err != nil {
+		return nil, err
+	}
+	handlers := make(map[string]Handler)
+	for i := range gwConfig.Dons {
+		donConfig := &gwConfig.Dons[i]
+		donConnMgr := connMgr.DONConnectionManager(donConfig.DonId)
+		handler, err := NewHandler(donConfig, donConnMgr, lggr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create handler for DON %s", donConfig.DonId)
+		}
+		donConnMgr.SetHandler(handler)
+		handlers[donConfig.DonId] = handler
+	}
+	httpServer := network.NewHTTPServer(&gwConfig.UserServerConfig, lggr)
+	wsServer := network.NewWebSocketServer(&gwConfig.NodeServerConfig, connMgr, lggr)
+	replayWindow := time.Duration(gwConfig.UserMessageConfig.ReplayWindowSec) * time.Second
+	return NewGateway(httpServer, wsServer, handlers, connMgr, replayWindow, lggr), nil
+}
+
+func NewGateway(httpServer network.HTTPServer, wsServer network.WebSocketServer, handlers map[string]Handler, connMgr ConnectionManager, replayWindow time.Duration, lggr logger.Logger) Gateway {
+	gw := &gateway{
+		httpServer: httpServer,
+		wsServer:   wsServer,
+		handlers:   handlers,
+		connMgr:    connMgr,
+		replays:    newReplayGuard(replayWindow),
+		lggr:       lggr,
+	}
+	httpServer.SetHTTPRequestHandler(gw)
+	return gw
+}
+
+func (g *gateway) Start(ctx context.Context) error {
+	return g.StartOnce("Gateway", func() error {
+		g.lggr.Info("starting gateway")
+		var ms services.MultiStart
+		for _, handler := range g.handlers {
+			if err := ms.Start(ctx, handler); err != nil {
+				return err
+			}
+		}
+		return ms.Start(ctx, g.connMgr, g.wsServer, g.httpServer)
+	})
+}
+
+func (g *gateway) Close() error {
+	return g.StopOnce("Gateway", func() (err error) {
+		g.lggr.Info("closing gateway")
+		// stop accepting requests before tearing down the connections they use
+		err = multierr.Combine(g.httpServer.Close(), g.wsServer.Close(), g.connMgr.Close())
+		for _, handler := range g.handlers {
+			err = multierr.Append(err, handler.Close())
+		}
+		return
+	})
+}
+
+func (g *gateway) GetUserPort() int {
+	return g.httpServer.GetPort()
+}
+
+func (g *gateway) GetNodePort() int {
+	return g.wsServer.GetPort()
+}
+
+// ProcessRequest implements network.HTTPRequestHandler. The request is
+// decoded, its signature and MessageId are checked, it is passed to the
+// Handler of its DON, and the response it sends back is correlated with the
+// request by MessageId.
+func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawResponse []byte, httpStatusCode int) {
+	msg, err := DecodeRequest(rawRequest)
+	if err != nil {
+		return newError(g.lggr, "", UserMessageParseError, err.Error())
+	}
+	if msg == nil {
+		return newError(g.lggr, "", UserMessageParseError, "nil message")
+	}
+	id := msg.Body.MessageId
+	if err = msg.Validate(); err != nil {
+		return newError(g.lggr, id, UserMessageAuthError, err.Error())
+	}
+	handler, ok := g.handlers[msg.Body.DonId]
+	if !ok {
+		return newError(g.lggr, id, UnsupportedDONIdError, "unsupported DON ID")
+	}
+	// Validate checked the Sender, which is keyed the way it is signed
+	if err = g.replays.check(common.HexToAddress(msg.Body.Sender), id); err != nil {
+		return newError(g.lggr, id, ReplayedMessageError, err.Error())
+	}
+	// buffered, so that handlers never block on a request that timed out
+	callbackCh := make(chan UserCallbackPayload, 1)
+	if err = handler.HandleUserMessage(ctx, msg, callbackCh); err != nil {
+		return newError(g.lggr, id, HandlerError, err.Error())
+	}
+	select {
+	case <-ctx.Done():
+		return newError(g.lggr, id, RequestTimeoutError, "handler timeout")
+	case response := <-callbackCh:
+		if response.ErrCode != NoError {
+			return newError(g.lggr, id, response.ErrCode, response.ErrMsg)
+		}
+		if response.Msg == nil {
+			return newError(g.lggr, id, NodeResponseEncodingError, "nil response")
+		}
+		// the response must carry the request's ID for the user to correlate it
+		response.Msg.Body.MessageId = id
+		rawResponse, err = EncodeResponse(response.Msg)
+		if err != nil {
+			return newError(g.lggr, id, NodeResponseEncodingError, "")
+		}
+		return rawResponse, http.StatusOK
+	}
+}
+
+func newError(lggr logger.Logger, id string, errCode ErrorCode, errMsg string) ([]byte, int) {
+	response, err := EncodeNewErrorResponse(id, ToJsonRPCErrorCode(errCode), errMsg, nil)
+	if err != nil {
+		lggr.Errorw("failed to encode error response", "err", err)
+		return []byte("fatal error"), http.StatusInternalServerError
+	}
+	return response, ToHttpErrorCode(errCode)
+}
+
+// ToHttpErrorCode maps an ErrorCode to the HTTP status code of the response
+func ToHttpErrorCode(errorCode ErrorCode) int {
+	switch errorCode {
+	case NoError:
+		return http.StatusOK
+	case UserMessageParseError, UnsupportedDONIdError, HandlerError, ReplayedMessageError:
+		return http.StatusBadRequest
+	case UserMessageAuthError:
+		return http.StatusUnauthorized
+	case RequestTimeoutError:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,310 @@
+package gateway_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/onsi/gomega"
+	"github.com/pelletier/go-toml/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/connector"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+)
+
+const gatewayConfigTemplate = `
+[ConnectionManagerConfig]
+AuthGatewayId = "test_gateway"
+AuthTimestampToleranceSec = 5
+AuthChallengeLen = 32
+
+[UserMessageConfig]
+ReplayWindowSec = 60
+
+[UserServerConfig]
+Host = "127.0.0.1"
+Port = 0
+Path = "/user"
+ContentTypeHeader = "application/jsonrpc"
+ReadTimeoutMillis = 1000
+WriteTimeoutMillis = 5000
+RequestTimeoutMillis = %d
+MaxRequestBytes = 20_000
+
+[NodeServerConfig]
+Host = "127.0.0.1"
+Port = 0
+Path = "/node"
+HandshakeTimeoutMillis = 1000
+ReadTimeoutMillis = 1000
+WriteTimeoutMillis = 1000
+
+[[Dons]]
+DonId = "test_don"
+HandlerName = "dummy"
+
+[[Dons.Members]]
+Name = "node_0"
+Address = "%s"
+`
+
+func parseGatewayConfig(t *testing.T, requestTimeoutMillis int, nodeAddress string) *config.GatewayConfig {
+	var cfg config.GatewayConfig
+	require.NoError(t, toml.Unmarshal([]byte(fmt.Sprintf(gatewayConfigTemplate, requestTimeoutMillis, nodeAddress)), &cfg))
+	return &cfg
+}
+
+// echoNode responds to every request with its own payload
+type echoNode struct {
+	connector connector.GatewayConnector
+}
+
+func (n *echoNode) HandleGatewayMessage(ctx context.Context, gatewayId string, msg *gateway.Message) {
+	_ = n.connector.SendToGateway(ctx, gatewayId, msg)
+}
+
+// silentNode never responds
+type silentNode struct{}
+
+func (silentNode) HandleGatewayMessage(context.Context, string, *gateway.Message) {}
+
+func startGateway(t *testing.T, requestTimeoutMillis int, nodeAddress string) gateway.Gateway {
+	gw, err := gateway.NewGatewayFromConfig(parseGatewayConfig(t, requestTimeoutMillis, nodeAddress), logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, gw.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, gw.Close()) })
+	return gw
+}
+
+func startConnector(t *testing.T, gw gateway.Gateway, key *ecdsa.PrivateKey, handler func(connector.GatewayConnector) connector.GatewayConnectorHandler) {
+	cfg := &connector.ConnectorConfig{
+		DonId: "test_don",
+		Gateways: []connector.ConnectorGatewayConfig{
+			{Id: "test_gateway", URL: fmt.Sprintf("ws://127.0.0.1:%d/node", gw.GetNodePort())},
+		},
+		WsClientConfig:            network.WebSocketClientConfig{HandshakeTimeoutMillis: 1000},
+		AuthMinChallengeLen:       32,
+		AuthTimestampToleranceSec: 5,
+		ReconnectIntervalMillis:   100,
+	}
+	h := &handlerProxy{}
+	conn, err := connector.NewGatewayConnector(cfg, key, h, logger.TestLogger(t))
+	require.NoError(t, err)
+	h.handler = handler(conn)
+	require.NoError(t, conn.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+}
+
+type handlerProxy struct {
+	handler connector.GatewayConnectorHandler
+}
+
+func (p *handlerProxy) HandleGatewayMessage(ctx context.Context, gatewayId string, msg *gateway.Message) {
+	p.handler.HandleGatewayMessage(ctx, gatewayId, msg)
+}
+
+func sendUserRequest(t *testing.T, gw gateway.Gateway, request string) (int, gateway.JsonRPCResponse) {
+	url := fmt.Sprintf("http://127.0.0.1:%d/user", gw.GetUserPort())
+	resp, err := http.Post(url, "application/jsonrpc", bytes.NewBufferString(request)) //nolint:gosec
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var rpcResponse gateway.JsonRPCResponse
+	require.NoError(t, json.Unmarshal(body, &rpcResponse))
+	return resp.StatusCode, rpcResponse
+}
+
+func signedUserRequest(t *testing.T, key *ecdsa.PrivateKey, id string, donId string) string {
+	msg := &gateway.Message{Body: gateway.MessageBody{
+		MessageId: id,
+		Method:    "test_method",
+		DonId:     donId,
+		Payload:   []byte(`{"field": 123}`),
+	}}
+	require.NoError(t, msg.Sign(key))
+	request, err := gateway.EncodeRequest(msg)
+	require.NoError(t, err)
+	return string(request)
+}
+
+func TestGateway_RequestResponse(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	userKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	gw := startGateway(t, 5000, crypto.PubkeyToAddress(key.PublicKey).Hex())
+	startConnector(t, gw, key, func(c connector.GatewayConnector) connector.GatewayConnectorHandler {
+		return &echoNode{connector: c}
+	})
+
+	// the node may take a moment to connect, until then the handler has nobody to forward to
+	var response gateway.JsonRPCResponse
+	var attempt int
+	gomega.NewWithT(t).Eventually(func() int {
+		attempt++
+		var status int
+		status, response = sendUserRequest(t, gw, signedUserRequest(t, userKey, fmt.Sprintf("req-%d", attempt), "test_don"))
+		return status
+	}, testutils.WaitTimeout(t), 100*time.Millisecond).Should(gomega.Equal(http.StatusOK))
+
+	require.NotNil(t, response.Result)
+	assert.Nil(t, response.Error)
+	assert.Equal(t, fmt.Sprintf("req-%d", attempt), response.Id)
+	assert.Equal(t, "test_don", response.Result.Body.DonId)
+	assert.JSONEq(t, `{"field": 123}`, string(response.Result.Body.Payload))
+	// responses are signed by the node
+	require.NoError(t, response.Result.Validate())
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), common.HexToAddress(response.Result.Body.Sender))
+}
+
+func TestGateway_Errors(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	userKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	gw := startGateway(t, 200, crypto.PubkeyToAddress(key.PublicKey).Hex())
+
+	t.Run("malformed request", func(t *testing.T) {
+		status, response := sendUserRequest(t, gw, `{"jsonrpc": "2.0", "id": "req-1", "params": [`)
+		assert.Equal(t, http.StatusBadRequest, status)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32700, response.Error.Code)
+	})
+
+	t.Run("unknown DON", func(t *testing.T) {
+		status, response := sendUserRequest(t, gw, signedUserRequest(t, userKey, "req-2", "other_don"))
+		assert.Equal(t, http.StatusBadRequest, status)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, "req-2", response.Id)
+		assert.Equal(t, -32600, response.Error.Code)
+	})
+
+	t.Run("no connected nodes", func(t *testing.T) {
+		status, response := sendUserRequest(t, gw, signedUserRequest(t, userKey, "req-3", "test_don"))
+		assert.Equal(t, http.StatusBadRequest, status)
+		require.NotNil(t, response.Error)
+		assert.Contains(t, response.Error.Message, "no connected nodes")
+	})
+
+	t.Run("unsigned request", func(t *testing.T) {
+		request := `{"jsonrpc": "2.0", "id": "req-4", "method": "test_method", "params": {"body": {"don_id": "test_don", "payload": {"field": 123}}}}`
+		status, response := sendUserRequest(t, gw, request)
+		assert.Equal(t, http.StatusUnauthorized, status)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, "req-4", response.Id)
+	})
+
+	t.Run("replayed request", func(t *testing.T) {
+		request := signedUserRequest(t, userKey, "req-5", "test_don")
+		_, response := sendUserRequest(t, gw, request)
+		require.NotNil(t, response.Error)
+		assert.Contains(t, response.Error.Message, "no connected nodes")
+
+		status, response := sendUserRequest(t, gw, request)
+		assert.Equal(t, http.StatusBadRequest, status)
+		require.NotNil(t, response.Error)
+		assert.Contains(t, response.Error.Message, gateway.ErrReplayedMessage.Error())
+	})
+
+	t.Run("replayed request with re-encoded sender", func(t *testing.T) {
+		request := signedUserRequest(t, userKey, "req-6", "test_don")
+		msg, err := gateway.DecodeRequest([]byte(request))
+		require.NoError(t, err)
+		_, response := sendUserRequest(t, gw, request)
+		require.NotNil(t, response.Error)
+		assert.Contains(t, response.Error.Message, "no connected nodes")
+
+		for _, sender := range []string{
+			common.HexToAddress(msg.Body.Sender).Hex(),
+			strings.TrimPrefix(msg.Body.Sender, "0x"),
+		} {
+			replayed := *msg
+			replayed.Body.Sender = sender
+			require.NoError(t, replayed.Validate())
+			replayedRequest, err := gateway.EncodeRequest(&replayed)
+			require.NoError(t, err)
+			status, response := sendUserRequest(t, gw, string(replayedRequest))
+			assert.Equal(t, http.StatusBadRequest, status)
+			require.NotNil(t, response.Error)
+			assert.Contains(t, response.Error.Message, gateway.ErrReplayedMessage.Error())
+		}
+	})
+}
+
+func TestGateway_Timeout(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	gw := startGateway(t, 200, crypto.PubkeyToAddress(key.PublicKey).Hex())
+	startConnector(t, gw, key, func(connector.GatewayConnector) connector.GatewayConnectorHandler {
+		return silentNode{}
+	})
+
+	userKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	var attempt int
+	gomega.NewWithT(t).Eventually(func() int {
+		attempt++
+		status, _ := sendUserRequest(t, gw, signedUserRequest(t, userKey, fmt.Sprintf("req-%d", attempt), "test_don"))
+		return status
+	}, testutils.WaitTimeout(t), 100*time.Millisecond).Should(gomega.Equal(http.StatusGatewayTimeout))
+}
+
+func TestGateway_RejectsUnknownNode(t *testing.T) {
+	t.Parallel()
+
+	memberKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	gw := startGateway(t, 200, crypto.PubkeyToAddress(memberKey.PublicKey).Hex())
+	startConnector(t, gw, otherKey, func(c connector.GatewayConnector) connector.GatewayConnectorHandler {
+		return &echoNode{connector: c}
+	})
+
+	// give the connector a chance to (fail to) connect
+	time.Sleep(300 * time.Millisecond)
+	status, response := sendUserRequest(t, gw, signedUserRequest(t, otherKey, "req-1", "test_don"))
+	assert.Equal(t, http.StatusBadRequest, status)
+	require.NotNil(t, response.Error)
+	assert.Contains(t, response.Error.Message, "no connected nodes")
+}
+
+func TestGateway_NewGatewayFromConfig_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := parseGatewayConfig(t, 200, "0x68902d681c28119f9b2531473a417088bf008e59")
+	cfg.Dons[0].HandlerName = "unknown"
+	_, err := gateway.NewGatewayFromConfig(cfg, logger.TestLogger(t))
+	require.ErrorContains(t, err, "unsupported handler type")
+
+	cfg = parseGatewayConfig(t, 200, "not an address")
+	_, err = gateway.NewGatewayFromConfig(cfg, logger.TestLogger(t))
+	require.ErrorContains(t, err, "invalid address")
+
+	cfg = parseGatewayConfig(t, 200, "0x68902d681c28119f9b2531473a417088bf008e59")
+	cfg.Dons = append(cfg.Dons, cfg.Dons[0])
+	_, err = gateway.NewGatewayFromConfig(cfg, logger.TestLogger(t))
+	require.ErrorContains(t, err, "duplicate DON ID")
+}
@@ -0,0 +1,86 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// ErrorCode is returned to users along with an error message
+type ErrorCode int
+
+const (
+	NoError ErrorCode = iota
+	UserMessageParseError
+	UnsupportedDONIdError
+	HandlerError
+	RequestTimeoutError
+	NodeResponseEncodingError
+	UserMessageAuthError
+	ReplayedMessageError
+	FatalError
+)
+
+// ToJsonRPCErrorCode maps an ErrorCode to a JSON-RPC 2.0 error code
+func ToJsonRPCErrorCode(errorCode ErrorCode) int {
+	switch errorCode {
+	case NoError:
+		return 0
+	case UserMessageParseError:
+		return -32700 // Parse Error
+	case UnsupportedDONIdError, HandlerError, UserMessageAuthError, ReplayedMessageError:
+		return -32600 // Invalid Request
+	case RequestTimeoutError:
+		return -32000 // Server Error
+	default:
+		return -32603 // Internal Error
+	}
+}
+
+// UserCallbackPayload is the response to a user message. Exactly one of Msg
+// and ErrCode should be set.
+type UserCallbackPayload struct {
+	Msg     *Message
+	ErrCode ErrorCode
+	ErrMsg  string
+}
+
+// DON is the interface a Handler uses to reach the members of its DON.
+type DON interface {
+	// SendToNode sends msg to the node with the given address, if it is
+	// currently connected.
+	SendToNode(ctx context.Context, nodeAddress string, msg *Message) error
+}
+
+// Handler implements the product-specific logic of a DON, e.g. forwarding
+// user requests to nodes and aggregating their responses. Each DON in the
+// Gateway has exactly one Handler.
+type Handler interface {
+	job.ServiceCtx
+
+	// HandleUserMessage handles a message received from a user. The handler
+	// must send exactly one response to callbackCh, unless it returns an
+	// error. callbackCh is buffered and never blocks.
+	HandleUserMessage(ctx context.Context, msg *Message, callbackCh chan<- UserCallbackPayload) error
+
+	// HandleNodeMessage handles a message received from a member of the DON.
+	HandleNodeMessage(ctx context.Context, msg *Message, nodeAddr string) error
+}
+
+const (
+	DummyHandlerType = "dummy"
+)
+
+// NewHandler returns the Handler named by donConfig.HandlerName.
+func NewHandler(donConfig *config.DONConfig, don DON, lggr logger.Logger) (Handler, error) {
+	switch donConfig.HandlerName {
+	case DummyHandlerType:
+		return NewDummyHandler(donConfig, don, lggr), nil
+	default:
+		return nil, errors.Errorf("unsupported handler type %s", donConfig.HandlerName)
+	}
+}
@@ -0,0 +1,95 @@
+package gateway
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+)
+
+// dummyHandler forwards each user message to all members of the DON and
+// returns the first response received, correlated by MessageId.
+type dummyHandler struct {
+	donConfig *config.DONConfig
+	don       DON
+	mu        sync.Mutex
+	pending   map[string]chan<- UserCallbackPayload
+	lggr      logger.Logger
+}
+
+var _ Handler = (*dummyHandler)(nil)
+
+func NewDummyHandler(donConfig *config.DONConfig, don DON, lggr logger.Logger) Handler {
+	return &dummyHandler{
+		donConfig: donConfig,
+		don:       don,
+		pending:   make(map[string]chan<- UserCallbackPayload),
+		lggr:      lggr.Named("DummyHandler." + donConfig.DonId),
+	}
+}
+
+func (d *dummyHandler) HandleUserMessage(ctx context.Context, msg *Message, callbackCh chan<- UserCallbackPayload) error {
+	id := msg.Body.MessageId
+	d.mu.Lock()
+	if _, ok := d.pending[id]; ok {
+		d.mu.Unlock()
+		return errors.Errorf("request with message ID %q is already in progress", id)
+	}
+	d.pending[id] = callbackCh
+	d.mu.Unlock()
+
+	// forget the request once the user stops waiting for it
+	go func() {
+		<-ctx.Done()
+		d.forget(id, callbackCh)
+	}()
+
+	var sent int
+	for _, member := range d.donConfig.Members {
+		if err := d.don.SendToNode(ctx, member.Address, msg); err != nil {
+			d.lggr.Debugw("failed to send message to node", "node", member.Name, "err", err)
+			continue
+		}
+		sent++
+	}
+	if sent == 0 {
+		d.forget(id, callbackCh)
+		return errors.New("no connected nodes")
+	}
+	return nil
+}
+
+// forget removes the pending request id, unless it was answered already and
+// the ID has been reused by a newer request with another callback channel.
+func (d *dummyHandler) forget(id string, callbackCh chan<- UserCallbackPayload) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.pending[id] == callbackCh {
+		delete(d.pending, id)
+	}
+}
+
+func (d *dummyHandler) HandleNodeMessage(ctx context.Context, msg *Message, nodeAddr string) error {
+	d.mu.Lock()
+	callbackCh, ok := d.pending[msg.Body.MessageId]
+	delete(d.pending, msg.Body.MessageId)
+	d.mu.Unlock()
+	if !ok {
+		// late or duplicate response
+		d.lggr.Debugw("ignoring response for unknown request", "messageId", msg.Body.MessageId, "node", nodeAddr)
+		return nil
+	}
+	callbackCh <- UserCallbackPayload{Msg: msg, ErrCode: NoError}
+	return nil
+}
+
+func (d *dummyHandler) Start(context.Context) error {
+	return nil
+}
+
+func (d *dummyHandler) Close() error {
+	return nil
+}
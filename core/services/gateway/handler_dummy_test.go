@@ -0,0 +1,46 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+)
+
+type sentMessagesDON struct {
+	sent []*Message
+}
+
+func (d *sentMessagesDON) SendToNode(ctx context.Context, nodeAddress string, msg *Message) error {
+	d.sent = append(d.sent, msg)
+	return nil
+}
+
+func TestDummyHandler_ReusedMessageId(t *testing.T) {
+	t.Parallel()
+
+	donConfig := &config.DONConfig{DonId: "don", Members: []config.NodeConfig{{Name: "node", Address: "0x01"}}}
+	handler := NewDummyHandler(donConfig, &sentMessagesDON{}, logger.TestLogger(t)).(*dummyHandler)
+	msg := &Message{Body: MessageBody{MessageId: "1"}}
+
+	ctx1, cancel1 := context.WithCancel(testutils.Context(t))
+	defer cancel1()
+	ch1 := make(chan UserCallbackPayload, 1)
+	require.NoError(t, handler.HandleUserMessage(ctx1, msg, ch1))
+	require.Error(t, handler.HandleUserMessage(testutils.Context(t), msg, make(chan UserCallbackPayload, 1)))
+	require.NoError(t, handler.HandleNodeMessage(testutils.Context(t), msg, "0x01"))
+	assert.Equal(t, msg, (<-ch1).Msg)
+
+	// once answered, the ID may be reused by a newer request, which the first
+	// request must not forget when its user stops waiting
+	ch2 := make(chan UserCallbackPayload, 1)
+	require.NoError(t, handler.HandleUserMessage(testutils.Context(t), msg, ch2))
+	handler.forget(msg.Body.MessageId, ch1)
+	require.NoError(t, handler.HandleNodeMessage(testutils.Context(t), msg, "0x01"))
+	assert.Equal(t, msg, (<-ch2).Msg)
+}
@@ -0,0 +1,125 @@
+package gateway
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/pkg/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	gw_common "github.com/smartcontractkit/chainlink/v2/core/services/gateway/common"
+)
+
+// Nodes authenticate with a Gateway in two steps, both signed with the node's
+// key:
+//  1. The auth header, sent with the websocket upgrade request, identifies the
+//     DON and the Gateway the node wants to connect to. The signer must be a
+//     member of that DON and the timestamp must be recent.
+//  2. The Gateway replies with a random challenge, which the node must sign
+//     and send back as its first message. This prevents replaying a captured
+//     auth header.
+
+const (
+	HandshakeVersion    uint8 = 1
+	maxHandshakeIdBytes       = 255
+)
+
+type AuthHeaderElems struct {
+	Version   uint8
+	Timestamp uint32
+	DonId     string
+	GatewayId string
+}
+
+// packed layout: version (1) | timestamp (4) | len(DonId) (1) | DonId | len(GatewayId) (1) | GatewayId
+func (e *AuthHeaderElems) pack() ([]byte, error) {
+	if len(e.DonId) > maxHandshakeIdBytes || len(e.GatewayId) > maxHandshakeIdBytes {
+		return nil, errors.Errorf("DonId and GatewayId must be at most %d bytes", maxHandshakeIdBytes)
+	}
+	packed := []byte{e.Version}
+	packed = append(packed, gw_common.Uint32ToBytes(e.Timestamp)...)
+	packed = append(packed, byte(len(e.DonId)))
+	packed = append(packed, e.DonId...)
+	packed = append(packed, byte(len(e.GatewayId)))
+	packed = append(packed, e.GatewayId...)
+	return packed, nil
+}
+
+func unpackAuthHeaderElems(data []byte) (elems AuthHeaderElems, err error) {
+	if len(data) < 7 {
+		return elems, errors.New("auth header too short")
+	}
+	elems.Version = data[0]
+	elems.Timestamp = gw_common.BytesToUint32(data[1:5])
+	donIdLen := int(data[5])
+	if len(data) < 6+donIdLen+1 {
+		return elems, errors.New("auth header too short")
+	}
+	elems.DonId = string(data[6 : 6+donIdLen])
+	rest := data[6+donIdLen:]
+	gatewayIdLen := int(rest[0])
+	if len(rest) != 1+gatewayIdLen {
+		return elems, errors.New("invalid auth header length")
+	}
+	elems.GatewayId = string(rest[1:])
+	return elems, nil
+}
+
+// PackAuthHeader returns the signed auth header for elems.
+func PackAuthHeader(elems *AuthHeaderElems, signerKey *ecdsa.PrivateKey) ([]byte, error) {
+	packed, err := elems.pack()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := gw_common.SignData(signerKey, packed)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign auth header")
+	}
+	return append(packed, signature...), nil
+}
+
+// UnpackAuthHeader decodes a signed auth header and recovers its signer.
+func UnpackAuthHeader(data []byte) (elems AuthHeaderElems, signer common.Address, err error) {
+	if len(data) < gw_common.SignatureLength {
+		return elems, signer, errors.New("auth header too short")
+	}
+	packed, signature := data[:len(data)-gw_common.SignatureLength], data[len(data)-gw_common.SignatureLength:]
+	elems, err = unpackAuthHeaderElems(packed)
+	if err != nil {
+		return elems, signer, err
+	}
+	signer, err = gw_common.ExtractSigner(signature, packed)
+	return elems, signer, err
+}
+
+type ChallengeElems struct {
+	Timestamp      uint32
+	GatewayId      string
+	ChallengeBytes []byte
+}
+
+// PackChallenge encodes a challenge as: timestamp (4) | len(GatewayId) (1) | GatewayId | ChallengeBytes
+func PackChallenge(elems *ChallengeElems) ([]byte, error) {
+	if len(elems.GatewayId) > maxHandshakeIdBytes {
+		return nil, errors.Errorf("GatewayId must be at most %d bytes", maxHandshakeIdBytes)
+	}
+	packed := gw_common.Uint32ToBytes(elems.Timestamp)
+	packed = append(packed, byte(len(elems.GatewayId)))
+	packed = append(packed, elems.GatewayId...)
+	packed = append(packed, elems.ChallengeBytes...)
+	return packed, nil
+}
+
+func UnpackChallenge(data []byte) (elems ChallengeElems, err error) {
+	if len(data) < 5 {
+		return elems, errors.New("challenge too short")
+	}
+	elems.Timestamp = gw_common.BytesToUint32(data[0:4])
+	gatewayIdLen := int(data[4])
+	if len(data) < 5+gatewayIdLen {
+		return elems, errors.New("challenge too short")
+	}
+	elems.GatewayId = string(data[5 : 5+gatewayIdLen])
+	elems.ChallengeBytes = data[5+gatewayIdLen:]
+	return elems, nil
+}
@@ -0,0 +1,64 @@
+package gateway_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+)
+
+func TestHandshake_AuthHeader(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	elems := gateway.AuthHeaderElems{
+		Version:   gateway.HandshakeVersion,
+		Timestamp: 1234567890,
+		DonId:     "test_don",
+		GatewayId: "test_gateway",
+	}
+	packed, err := gateway.PackAuthHeader(&elems, key)
+	require.NoError(t, err)
+
+	unpacked, signer, err := gateway.UnpackAuthHeader(packed)
+	require.NoError(t, err)
+	assert.Equal(t, elems, unpacked)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), signer)
+
+	t.Run("tampered", func(t *testing.T) {
+		tampered := append([]byte{}, packed...)
+		tampered[1]++ // timestamp
+		_, signer, err := gateway.UnpackAuthHeader(tampered)
+		if err == nil {
+			assert.NotEqual(t, crypto.PubkeyToAddress(key.PublicKey), signer)
+		}
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		_, _, err := gateway.UnpackAuthHeader(packed[:10])
+		require.Error(t, err)
+	})
+}
+
+func TestHandshake_Challenge(t *testing.T) {
+	t.Parallel()
+
+	elems := gateway.ChallengeElems{
+		Timestamp:      1234567890,
+		GatewayId:      "test_gateway",
+		ChallengeBytes: []byte{1, 2, 3, 4},
+	}
+	packed, err := gateway.PackChallenge(&elems)
+	require.NoError(t, err)
+
+	unpacked, err := gateway.UnpackChallenge(packed)
+	require.NoError(t, err)
+	assert.Equal(t, elems, unpacked)
+
+	_, err = gateway.UnpackChallenge(packed[:3])
+	require.Error(t, err)
+}
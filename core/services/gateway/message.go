@@ -1,11 +1,36 @@
 package gateway
 
-import "encoding/json"
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	gw_common "github.com/smartcontractkit/chainlink/v2/core/services/gateway/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+const (
+	MessageSignatureLen    = gw_common.SignatureLength
+	MessageSignatureHexLen = 2 + 2*MessageSignatureLen
+	MessageIdMaxLen        = 128
+	MessageMethodMaxLen    = 64
+	MessageDonIdMaxLen     = 64
+)
 
 /*
  * Top-level Message structure containing:
  *   - universal fields identifying the request, the sender and the target DON/service
  *   - product-specific payload
+ *
+ * Signature, Sender and Payload are hex/JSON encoded. The signature covers the
+ * canonical serialization of the Body (see SerializeBody), so that it stays
+ * valid as the Message is decoded and re-encoded by Gateways and Nodes.
  */
 type Message struct {
 	Signature string      `json:"signature"`
@@ -21,3 +46,141 @@ type MessageBody struct {
 	// Service-specific payload, decoded inside the Handler.
 	Payload json.RawMessage `json:"payload"`
 }
+
+// SerializeBody returns the canonical serialization of body that is signed:
+// MessageId, Method and DonId zero-padded to their maximum lengths, followed
+// by the lower-cased Sender address and the compacted JSON payload. JSON
+// payloads are compacted and HTML-escaped the same way encoding/json does
+// when re-encoding a json.RawMessage, so whitespace changes in transit don't
+// invalidate the signature.
+func SerializeBody(body *MessageBody) ([]byte, error) {
+	if err := body.validateLengths(); err != nil {
+		return nil, err
+	}
+	serialized := make([]byte, 0, MessageIdMaxLen+MessageMethodMaxLen+MessageDonIdMaxLen+common.AddressLength+len(body.Payload))
+	serialized = append(serialized, padRight(body.MessageId, MessageIdMaxLen)...)
+	serialized = append(serialized, padRight(body.Method, MessageMethodMaxLen)...)
+	serialized = append(serialized, padRight(body.DonId, MessageDonIdMaxLen)...)
+	if body.Sender != "" {
+		if !common.IsHexAddress(body.Sender) {
+			return nil, errors.Errorf("invalid sender address %q", body.Sender)
+		}
+		serialized = append(serialized, common.HexToAddress(body.Sender).Bytes()...)
+	} else {
+		serialized = append(serialized, make([]byte, common.AddressLength)...)
+	}
+	if len(body.Payload) > 0 {
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, body.Payload); err != nil {
+			return nil, errors.Wrap(err, "invalid payload")
+		}
+		var escaped bytes.Buffer
+		json.HTMLEscape(&escaped, compacted.Bytes())
+		serialized = append(serialized, escaped.Bytes()...)
+	}
+	return serialized, nil
+}
+
+func (b *MessageBody) validateLengths() error {
+	if len(b.MessageId) == 0 || len(b.MessageId) > MessageIdMaxLen {
+		return errors.Errorf("message ID must be between 1 and %d bytes", MessageIdMaxLen)
+	}
+	if len(b.Method) == 0 || len(b.Method) > MessageMethodMaxLen {
+		return errors.Errorf("method must be between 1 and %d bytes", MessageMethodMaxLen)
+	}
+	if len(b.DonId) == 0 || len(b.DonId) > MessageDonIdMaxLen {
+		return errors.Errorf("DON ID must be between 1 and %d bytes", MessageDonIdMaxLen)
+	}
+	// the fields are zero-padded when serialized, so a trailing NUL would
+	// yield the same signed bytes as the field without it
+	if !isPrintableASCII(b.MessageId) {
+		return errors.New("message ID must only contain printable ASCII characters")
+	}
+	if !isPrintableASCII(b.Method) {
+		return errors.New("method must only contain printable ASCII characters")
+	}
+	if !isPrintableASCII(b.DonId) {
+		return errors.New("DON ID must only contain printable ASCII characters")
+	}
+	return nil
+}
+
+func isPrintableASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x20 || s[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func padRight(s string, n int) []byte {
+	padded := make([]byte, n)
+	copy(padded, s)
+	return padded
+}
+
+// Sign sets the Sender to the address of privateKey and signs the Message.
+func (m *Message) Sign(privateKey *ecdsa.PrivateKey) error {
+	if m == nil {
+		return errors.New("nil message")
+	}
+	m.Body.Sender = strings.ToLower(crypto.PubkeyToAddress(privateKey.PublicKey).Hex())
+	serialized, err := SerializeBody(&m.Body)
+	if err != nil {
+		return err
+	}
+	signature, err := gw_common.SignData(privateKey, serialized)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign message")
+	}
+	m.Signature = hexutil.Encode(signature)
+	return nil
+}
+
+// SignWithKeystore signs the Message with the eth key for address.
+func (m *Message) SignWithKeystore(ks keystore.Eth, address common.Address) error {
+	key, err := ks.Get(address.Hex())
+	if err != nil {
+		return errors.Wrapf(err, "failed to get key for %s", address)
+	}
+	return m.Sign(key.ToEcdsaPrivKey())
+}
+
+// ExtractSigner recovers the address that signed the Message.
+func (m *Message) ExtractSigner() (common.Address, error) {
+	if m == nil {
+		return common.Address{}, errors.New("nil message")
+	}
+	if len(m.Signature) != MessageSignatureHexLen {
+		return common.Address{}, errors.Errorf("signature must be %d hex characters", MessageSignatureHexLen)
+	}
+	signature, err := hexutil.Decode(m.Signature)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "invalid signature encoding")
+	}
+	serialized, err := SerializeBody(&m.Body)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return gw_common.ExtractSigner(signature, serialized)
+}
+
+// Validate checks the lengths of the Body fields and that the Message was
+// signed by its Sender.
+func (m *Message) Validate() error {
+	if m == nil {
+		return errors.New("nil message")
+	}
+	if !common.IsHexAddress(m.Body.Sender) {
+		return errors.Errorf("invalid sender address %q", m.Body.Sender)
+	}
+	signer, err := m.ExtractSigner()
+	if err != nil {
+		return err
+	}
+	if signer != common.HexToAddress(m.Body.Sender) {
+		return errors.Errorf("message signed by %s does not match sender %s", signer, m.Body.Sender)
+	}
+	return nil
+}
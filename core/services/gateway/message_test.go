@@ -0,0 +1,103 @@
+package gateway_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	ksmocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+)
+
+func newTestMessage() *gateway.Message {
+	return &gateway.Message{Body: gateway.MessageBody{
+		MessageId: "aa-bb",
+		Method:    "upload",
+		DonId:     "functions_local",
+		Payload:   []byte(`{"field": 123, "html": "<b>"}`),
+	}}
+}
+
+func TestMessage_SignAndValidate(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	msg := newTestMessage()
+	require.NoError(t, msg.Sign(key))
+	assert.Equal(t, strings.ToLower(crypto.PubkeyToAddress(key.PublicKey).Hex()), msg.Body.Sender)
+	require.NoError(t, msg.Validate())
+
+	t.Run("survives re-encoding", func(t *testing.T) {
+		encoded, err := gateway.EncodeRequest(msg)
+		require.NoError(t, err)
+		decoded, err := gateway.DecodeRequest(encoded)
+		require.NoError(t, err)
+		require.NoError(t, decoded.Validate())
+
+		encoded, err = gateway.EncodeResponse(decoded)
+		require.NoError(t, err)
+		decoded, err = gateway.DecodeResponse(encoded)
+		require.NoError(t, err)
+		require.NoError(t, decoded.Validate())
+	})
+
+	t.Run("tampered fields", func(t *testing.T) {
+		for _, tamper := range []func(*gateway.Message){
+			func(m *gateway.Message) { m.Body.MessageId = "aa-cc" },
+			func(m *gateway.Message) { m.Body.Method = "download" },
+			func(m *gateway.Message) { m.Body.DonId = "functions_other" },
+			func(m *gateway.Message) { m.Body.Payload = []byte(`{"field": 124, "html": "<b>"}`) },
+			func(m *gateway.Message) {
+				other, err := crypto.GenerateKey()
+				require.NoError(t, err)
+				m.Body.Sender = crypto.PubkeyToAddress(other.PublicKey).Hex()
+			},
+		} {
+			tampered := *msg
+			tamper(&tampered)
+			require.Error(t, tampered.Validate())
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		unsigned := newTestMessage()
+		require.ErrorContains(t, unsigned.Validate(), "invalid sender address")
+
+		unsigned.Body.Sender = msg.Body.Sender
+		require.ErrorContains(t, unsigned.Validate(), "signature must be")
+
+		tooLong := newTestMessage()
+		tooLong.Body.MessageId = strings.Repeat("a", gateway.MessageIdMaxLen+1)
+		require.ErrorContains(t, tooLong.Sign(key), "message ID must be")
+
+		noMethod := newTestMessage()
+		noMethod.Body.Method = ""
+		require.ErrorContains(t, noMethod.Sign(key), "method must be")
+
+		padded := newTestMessage()
+		padded.Body.MessageId += "\x00"
+		require.ErrorContains(t, padded.Sign(key), "message ID must only contain printable ASCII characters")
+
+		nonPrintable := newTestMessage()
+		nonPrintable.Body.DonId = "don\n"
+		require.ErrorContains(t, nonPrintable.Sign(key), "DON ID must only contain printable ASCII characters")
+	})
+}
+
+func TestMessage_SignWithKeystore(t *testing.T) {
+	t.Parallel()
+
+	key := cltest.MustGenerateRandomKey(t)
+	ethKeyStore := ksmocks.NewEth(t)
+	ethKeyStore.On("Get", key.Address.Hex()).Return(key, nil)
+
+	msg := newTestMessage()
+	require.NoError(t, msg.SignWithKeystore(ethKeyStore, key.Address))
+	require.NoError(t, msg.Validate())
+	assert.Equal(t, strings.ToLower(key.Address.Hex()), msg.Body.Sender)
+}
@@ -0,0 +1,164 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// HTTPServer serves user requests to a single path and hands the raw request
+// body to an HTTPRequestHandler.
+type HTTPServer interface {
+	job.ServiceCtx
+
+	// Not thread-safe. Should be called once, before Start() is called.
+	SetHTTPRequestHandler(handler HTTPRequestHandler)
+
+	// Not thread-safe. Can be called after Start() returns.
+	GetPort() int
+}
+
+type HTTPRequestHandler interface {
+	// ProcessRequest returns the raw response body and the HTTP status code
+	// to reply with.
+	ProcessRequest(ctx context.Context, rawRequest []byte) (rawResponse []byte, httpStatusCode int)
+}
+
+type HTTPServerConfig struct {
+	Host                 string
+	Port                 uint16
+	TLSEnabled           bool
+	TLSCertPath          string
+	TLSKeyPath           string
+	Path                 string
+	ContentTypeHeader    string
+	ReadTimeoutMillis    uint32
+	WriteTimeoutMillis   uint32
+	RequestTimeoutMillis uint32
+	MaxRequestBytes      int64
+}
+
+type httpServer struct {
+	utils.StartStopOnce
+	config            *HTTPServerConfig
+	listener          net.Listener
+	server            *http.Server
+	handler           HTTPRequestHandler
+	doneCh            chan struct{}
+	cancelBaseContext context.CancelFunc
+	lggr              logger.Logger
+}
+
+const (
+	HealthCheckPath     = "/health"
+	HealthCheckResponse = "OK"
+)
+
+func NewHTTPServer(config *HTTPServerConfig, lggr logger.Logger) HTTPServer {
+	baseCtx, cancelBaseCtx := context.WithCancel(context.Background())
+	server := &httpServer{
+		config:            config,
+		doneCh:            make(chan struct{}),
+		cancelBaseContext: cancelBaseCtx,
+		lggr:              lggr.Named("HTTPServer"),
+	}
+	mux := http.NewServeMux()
+	mux.Handle(config.Path, http.HandlerFunc(server.handleRequest))
+	mux.Handle(HealthCheckPath, http.HandlerFunc(server.handleHealthCheck))
+	server.server = &http.Server{
+		Addr:              fmt.Sprintf("%s:%d", config.Host, config.Port),
+		Handler:           mux,
+		BaseContext:       func(net.Listener) context.Context { return baseCtx },
+		ReadTimeout:       time.Duration(config.ReadTimeoutMillis) * time.Millisecond,
+		ReadHeaderTimeout: time.Duration(config.ReadTimeoutMillis) * time.Millisecond,
+		WriteTimeout:      time.Duration(config.WriteTimeoutMillis) * time.Millisecond,
+	}
+	return server
+}
+
+func (s *httpServer) handleHealthCheck(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(HealthCheckResponse)); err != nil {
+		s.lggr.Debugw("error when writing response", "err", err)
+	}
+}
+
+func (s *httpServer) handleRequest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	source := http.MaxBytesReader(w, r.Body, s.config.MaxRequestBytes)
+	rawMessage, err := io.ReadAll(source)
+	if err != nil {
+		s.lggr.Errorw("error reading request", "err", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	requestCtx := r.Context()
+	if s.config.RequestTimeoutMillis > 0 {
+		var cancel context.CancelFunc
+		requestCtx, cancel = context.WithTimeout(requestCtx, time.Duration(s.config.RequestTimeoutMillis)*time.Millisecond)
+		defer cancel()
+	}
+	rawResponse, httpStatusCode := s.handler.ProcessRequest(requestCtx, rawMessage)
+
+	w.Header().Set("Content-Type", s.config.ContentTypeHeader)
+	w.WriteHeader(httpStatusCode)
+	if _, err = w.Write(rawResponse); err != nil {
+		s.lggr.Errorw("error when writing response", "err", err)
+	}
+}
+
+func (s *httpServer) SetHTTPRequestHandler(handler HTTPRequestHandler) {
+	s.handler = handler
+}
+
+func (s *httpServer) GetPort() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *httpServer) Start(ctx context.Context) error {
+	return s.StartOnce("GatewayHTTPServer", func() (err error) {
+		if s.handler == nil {
+			return errors.New("request handler not set")
+		}
+		s.lggr.Info("starting gateway HTTP server")
+		s.listener, err = net.Listen("tcp", s.server.Addr)
+		if err != nil {
+			return errors.Wrap(err, "failed to listen")
+		}
+		go func() {
+			defer close(s.doneCh)
+			var serveErr error
+			if s.config.TLSEnabled {
+				serveErr = s.server.ServeTLS(s.listener, s.config.TLSCertPath, s.config.TLSKeyPath)
+			} else {
+				serveErr = s.server.Serve(s.listener)
+			}
+			if serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+				s.lggr.Errorw("gateway HTTP server closed with error", "err", serveErr)
+			}
+		}()
+		return nil
+	})
+}
+
+func (s *httpServer) Close() error {
+	return s.StopOnce("GatewayHTTPServer", func() (err error) {
+		s.lggr.Info("closing gateway HTTP server")
+		s.cancelBaseContext()
+		err = s.server.Shutdown(context.Background())
+		<-s.doneCh
+		return
+	})
+}
@@ -0,0 +1,79 @@
+package network
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+)
+
+// ConnectionInitiator is the client side of the handshake described on
+// ConnectionAcceptor.
+type ConnectionInitiator interface {
+	NewAuthHeader(url *url.URL) ([]byte, error)
+	ChallengeResponse(url *url.URL, challenge []byte) ([]byte, error)
+}
+
+// WebSocketClient dials a WebSocketServer and authenticates with it.
+type WebSocketClient interface {
+	Connect(ctx context.Context, url *url.URL) (*websocket.Conn, error)
+}
+
+type WebSocketClientConfig struct {
+	HandshakeTimeoutMillis uint32
+}
+
+type webSocketClient struct {
+	initiator ConnectionInitiator
+	dialer    *websocket.Dialer
+}
+
+func NewWebSocketClient(config WebSocketClientConfig, initiator ConnectionInitiator) WebSocketClient {
+	dialer := &websocket.Dialer{
+		HandshakeTimeout: time.Duration(config.HandshakeTimeoutMillis) * time.Millisecond,
+	}
+	return &webSocketClient{
+		initiator: initiator,
+		dialer:    dialer,
+	}
+}
+
+func (c *webSocketClient) Connect(ctx context.Context, url *url.URL) (*websocket.Conn, error) {
+	authHeader, err := c.initiator.NewAuthHeader(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create auth header")
+	}
+	hdr := make(http.Header)
+	hdr.Add(WsServerHandshakeAuthHeaderName, base64.StdEncoding.EncodeToString(authHeader))
+
+	conn, resp, err := c.dialer.DialContext(ctx, url.String(), hdr)
+	if resp != nil && resp.Body != nil {
+		resp.Body.Close()
+	}
+	if err != nil {
+		if resp != nil {
+			return nil, errors.Wrapf(err, "failed to dial %s: status %s", url.Redacted(), resp.Status)
+		}
+		return nil, errors.Wrapf(err, "failed to dial %s", url.Redacted())
+	}
+
+	challenge, err := base64.StdEncoding.DecodeString(resp.Header.Get(WsServerHandshakeChallengeHeaderName))
+	if err != nil || len(challenge) == 0 {
+		conn.Close()
+		return nil, errors.New("missing or malformed challenge")
+	}
+	response, err := c.initiator.ChallengeResponse(url, challenge)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to create challenge response")
+	}
+	if err = conn.WriteMessage(websocket.BinaryMessage, response); err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to send challenge response")
+	}
+	return conn, nil
+}
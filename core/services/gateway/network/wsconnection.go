@@ -0,0 +1,133 @@
+package network
+
+import (
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+)
+
+// ErrNoConnection is returned when writing to a wrapper without a live connection
+var ErrNoConnection = errors.New("no active connection")
+
+// ReadItem is a single message read from a websocket connection
+type ReadItem struct {
+	MsgType int
+	Data    []byte
+}
+
+// WSConnectionWrapper is a websocket connection that can be replaced at any
+// time, e.g. when a node reconnects. Writes are serialized, and messages read
+// from the current connection are delivered on a single channel that outlives
+// the connections.
+type WSConnectionWrapper interface {
+	// Reset closes the current connection, if any, and switches to newConn,
+	// which may be nil.
+	Reset(newConn *websocket.Conn)
+	// Write sends a message on the current connection.
+	Write(msgType int, data []byte) error
+	// ReadChannel returns the channel of messages read from all connections.
+	ReadChannel() <-chan ReadItem
+	// IsConnected reports whether there is a live connection.
+	IsConnected() bool
+	// Close closes the current connection and the read channel.
+	Close()
+}
+
+type wsConnectionWrapper struct {
+	mu     sync.Mutex
+	conn   *websocket.Conn
+	readCh chan ReadItem
+	closed bool
+	wg     sync.WaitGroup
+}
+
+func NewWSConnectionWrapper() WSConnectionWrapper {
+	return &wsConnectionWrapper{
+		readCh: make(chan ReadItem),
+	}
+}
+
+func (c *wsConnectionWrapper) Reset(newConn *websocket.Conn) {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		if newConn != nil {
+			newConn.Close()
+		}
+		return
+	}
+	oldConn := c.conn
+	c.conn = newConn
+	if newConn != nil {
+		c.wg.Add(1)
+		go c.readLoop(newConn)
+	}
+	c.mu.Unlock()
+	if oldConn != nil {
+		oldConn.Close()
+	}
+}
+
+func (c *wsConnectionWrapper) Write(msgType int, data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn == nil {
+		return ErrNoConnection
+	}
+	err := c.conn.WriteMessage(msgType, data)
+	if err != nil {
+		c.conn.Close()
+		c.conn = nil
+	}
+	return err
+}
+
+func (c *wsConnectionWrapper) ReadChannel() <-chan ReadItem {
+	return c.readCh
+}
+
+func (c *wsConnectionWrapper) IsConnected() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn != nil
+}
+
+func (c *wsConnectionWrapper) Close() {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return
+	}
+	c.closed = true
+	conn := c.conn
+	c.conn = nil
+	c.mu.Unlock()
+	if conn != nil {
+		conn.Close()
+	}
+	// wait for read loops to exit before closing the channel they write to
+	go func() {
+		for range c.readCh {
+		}
+	}()
+	c.wg.Wait()
+	close(c.readCh)
+}
+
+func (c *wsConnectionWrapper) readLoop(conn *websocket.Conn) {
+	defer c.wg.Done()
+	for {
+		msgType, data, err := conn.ReadMessage()
+		if err != nil {
+			c.mu.Lock()
+			if c.conn == conn {
+				c.conn = nil
+			}
+			c.mu.Unlock()
+			conn.Close()
+			return
+		}
+		c.readCh <- ReadItem{msgType, data}
+	}
+}
@@ -0,0 +1,188 @@
+package network
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	// WsServerHandshakeAuthHeaderName carries the base64-encoded auth header
+	// sent by a connecting node.
+	WsServerHandshakeAuthHeaderName = "Authorization"
+	// WsServerHandshakeChallengeHeaderName carries the base64-encoded
+	// challenge returned by the server.
+	WsServerHandshakeChallengeHeaderName = "Challenge"
+)
+
+// ConnectionAcceptor authenticates incoming websocket connections with a
+// challenge-response handshake:
+//  1. The client sends an auth header with its upgrade request, which is
+//     passed to StartHandshake.
+//  2. The server replies with a challenge in the upgrade response headers.
+//  3. The client sends the challenge response as its first message, which is
+//     passed to FinalizeHandshake along with the connection.
+type ConnectionAcceptor interface {
+	StartHandshake(authHeader []byte) (attemptId string, challenge []byte, err error)
+	FinalizeHandshake(attemptId string, response []byte, conn *websocket.Conn) error
+	AbortHandshake(attemptId string)
+}
+
+// WebSocketServer accepts connections from nodes.
+type WebSocketServer interface {
+	job.ServiceCtx
+
+	// Not thread-safe. Can be called after Start() returns.
+	GetPort() int
+}
+
+type WebSocketServerConfig struct {
+	HTTPServerConfig
+	HandshakeTimeoutMillis uint32
+}
+
+type webSocketServer struct {
+	utils.StartStopOnce
+	config            *WebSocketServerConfig
+	listener          net.Listener
+	server            *http.Server
+	acceptor          ConnectionAcceptor
+	upgrader          *websocket.Upgrader
+	doneCh            chan struct{}
+	cancelBaseContext context.CancelFunc
+	lggr              logger.Logger
+}
+
+func NewWebSocketServer(config *WebSocketServerConfig, acceptor ConnectionAcceptor, lggr logger.Logger) WebSocketServer {
+	baseCtx, cancelBaseCtx := context.WithCancel(context.Background())
+	upgrader := &websocket.Upgrader{
+		HandshakeTimeout: time.Duration(config.HandshakeTimeoutMillis) * time.Millisecond,
+		ReadBufferSize:   1024,
+		WriteBufferSize:  1024,
+	}
+	server := &webSocketServer{
+		config:            config,
+		acceptor:          acceptor,
+		upgrader:          upgrader,
+		doneCh:            make(chan struct{}),
+		cancelBaseContext: cancelBaseCtx,
+		lggr:              lggr.Named("WebSocketServer"),
+	}
+	mux := http.NewServeMux()
+	mux.Handle(config.Path, http.HandlerFunc(server.handleRequest))
+	server.server = &http.Server{
+		Addr:              fmt.Sprintf("%s:%d", config.Host, config.Port),
+		Handler:           mux,
+		BaseContext:       func(net.Listener) context.Context { return baseCtx },
+		ReadTimeout:       time.Duration(config.ReadTimeoutMillis) * time.Millisecond,
+		ReadHeaderTimeout: time.Duration(config.ReadTimeoutMillis) * time.Millisecond,
+		WriteTimeout:      time.Duration(config.WriteTimeoutMillis) * time.Millisecond,
+	}
+	return server
+}
+
+func (s *webSocketServer) handleRequest(w http.ResponseWriter, r *http.Request) {
+	authHeader, err := base64.StdEncoding.DecodeString(r.Header.Get(WsServerHandshakeAuthHeaderName))
+	if err != nil || len(authHeader) == 0 {
+		s.lggr.Debugw("received upgrade request with missing or malformed auth header", "remoteAddr", r.RemoteAddr)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	attemptId, challenge, err := s.acceptor.StartHandshake(authHeader)
+	if err != nil {
+		s.lggr.Debugw("rejected handshake", "remoteAddr", r.RemoteAddr, "err", err)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	respHeader := http.Header{}
+	respHeader.Set(WsServerHandshakeChallengeHeaderName, base64.StdEncoding.EncodeToString(challenge))
+	conn, err := s.upgrader.Upgrade(w, r, respHeader)
+	if err != nil {
+		// the upgrader has already replied with an error
+		s.lggr.Debugw("failed to upgrade connection", "remoteAddr", r.RemoteAddr, "err", err)
+		s.acceptor.AbortHandshake(attemptId)
+		return
+	}
+	// clear any deadlines left by the HTTP server's timeouts, which must not
+	// apply to the long-lived connection
+	if err = conn.UnderlyingConn().SetDeadline(time.Time{}); err != nil {
+		s.abort(attemptId, conn, err)
+		return
+	}
+
+	if s.config.HandshakeTimeoutMillis > 0 {
+		if err = conn.SetReadDeadline(time.Now().Add(time.Duration(s.config.HandshakeTimeoutMillis) * time.Millisecond)); err != nil {
+			s.abort(attemptId, conn, err)
+			return
+		}
+	}
+	_, response, err := conn.ReadMessage()
+	if err != nil {
+		s.abort(attemptId, conn, errors.Wrap(err, "failed to read challenge response"))
+		return
+	}
+	if err = conn.SetReadDeadline(time.Time{}); err != nil {
+		s.abort(attemptId, conn, err)
+		return
+	}
+	if err = s.acceptor.FinalizeHandshake(attemptId, response, conn); err != nil {
+		s.lggr.Debugw("failed to finalize handshake", "remoteAddr", r.RemoteAddr, "err", err)
+		closeMsg := websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "handshake failed")
+		_ = conn.WriteControl(websocket.CloseMessage, closeMsg, time.Now().Add(time.Second))
+		conn.Close()
+	}
+}
+
+func (s *webSocketServer) abort(attemptId string, conn *websocket.Conn, err error) {
+	s.lggr.Debugw("aborting handshake", "attemptId", attemptId, "err", err)
+	s.acceptor.AbortHandshake(attemptId)
+	conn.Close()
+}
+
+func (s *webSocketServer) GetPort() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *webSocketServer) Start(ctx context.Context) error {
+	return s.StartOnce("GatewayWebSocketServer", func() (err error) {
+		s.lggr.Info("starting gateway WebSocket server")
+		s.listener, err = net.Listen("tcp", s.server.Addr)
+		if err != nil {
+			return errors.Wrap(err, "failed to listen")
+		}
+		go func() {
+			defer close(s.doneCh)
+			var serveErr error
+			if s.config.TLSEnabled {
+				serveErr = s.server.ServeTLS(s.listener, s.config.TLSCertPath, s.config.TLSKeyPath)
+			} else {
+				serveErr = s.server.Serve(s.listener)
+			}
+			if serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+				s.lggr.Errorw("gateway WebSocket server closed with error", "err", serveErr)
+			}
+		}()
+		return nil
+	})
+}
+
+func (s *webSocketServer) Close() error {
+	return s.StopOnce("GatewayWebSocketServer", func() (err error) {
+		s.lggr.Info("closing gateway WebSocket server")
+		s.cancelBaseContext()
+		err = s.server.Shutdown(context.Background())
+		<-s.doneCh
+		return
+	})
+}
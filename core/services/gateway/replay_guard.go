@@ -0,0 +1,68 @@
+package gateway
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// ErrReplayedMessage is returned for messages whose ID has already been seen
+// from the same sender within the replay window
+var ErrReplayedMessage = errors.New("message ID has already been used")
+
+// replayGuard remembers the (Sender, MessageId) pairs seen within a window,
+// so that captured signed messages can't be re-submitted. Messages carry no
+// timestamp, so a message is only rejected while its pair is remembered: once
+// the window has passed, or after a restart, it is accepted again.
+type replayGuard struct {
+	mu        sync.Mutex
+	window    time.Duration
+	seen      map[replayKey]time.Time
+	lastPrune time.Time
+	now       func() time.Time
+}
+
+// replayKey identifies a message by its signed fields, so that variants of
+// the same signed message, e.g. with a differently encoded Sender, are
+// recognized as replays.
+type replayKey struct {
+	sender    common.Address
+	messageId string
+}
+
+func newReplayGuard(window time.Duration) *replayGuard {
+	return &replayGuard{
+		window: window,
+		seen:   make(map[replayKey]time.Time),
+		now:    time.Now,
+	}
+}
+
+// check records the message and returns ErrReplayedMessage if it was already
+// seen within the window. A zero window disables the check.
+func (g *replayGuard) check(sender common.Address, messageId string) error {
+	if g.window == 0 {
+		return nil
+	}
+	key := replayKey{sender: sender, messageId: messageId}
+	now := g.now()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	// pruning is amortized, since it scans the whole map
+	if now.Sub(g.lastPrune) > g.window/10 {
+		for k, seenAt := range g.seen {
+			if now.Sub(seenAt) > g.window {
+				delete(g.seen, k)
+			}
+		}
+		g.lastPrune = now
+	}
+	if seenAt, ok := g.seen[key]; ok && now.Sub(seenAt) <= g.window {
+		return ErrReplayedMessage
+	}
+	g.seen[key] = now
+	return nil
+}
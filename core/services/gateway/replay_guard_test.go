@@ -0,0 +1,34 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayGuard(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	guard := newReplayGuard(time.Minute)
+	guard.now = func() time.Time { return now }
+
+	senderA := common.HexToAddress("0xaa")
+	senderB := common.HexToAddress("0xbb")
+
+	require.NoError(t, guard.check(senderA, "1"))
+	require.ErrorIs(t, guard.check(senderA, "1"), ErrReplayedMessage)
+	// IDs are per sender
+	require.NoError(t, guard.check(senderB, "1"))
+
+	now = now.Add(time.Minute + time.Second)
+	require.NoError(t, guard.check(senderA, "1"))
+
+	t.Run("disabled", func(t *testing.T) {
+		guard := newReplayGuard(0)
+		require.NoError(t, guard.check(senderA, "1"))
+		require.NoError(t, guard.check(senderA, "1"))
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 	"gopkg.in/guregu/null.v4"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	clnull "github.com/smartcontractkit/chainlink/v2/core/null"
@@ -132,8 +133,9 @@ type Job struct {
 	JobSpecErrors           []SpecError
 	Type                    Type
 	SchemaVersion           uint32
-	GasLimit                clnull.Uint32 `toml:"gasLimit"`
-	ForwardingAllowed       bool          `toml:"forwardingAllowed"`
+	GasLimit                clnull.Uint32         `toml:"gasLimit"`
+	ForwardingAllowed       bool                  `toml:"forwardingAllowed"`
+	TransactionPriority     txmgrtypes.TxPriority `toml:"transactionPriority"`
 	Name                    null.String
 	MaxTaskDuration         models.Interval
 	Pipeline                pipeline.Pipeline `toml:"observationSource"`
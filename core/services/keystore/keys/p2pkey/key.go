@@ -11,6 +11,8 @@ import (
 	cryptop2p "github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // Key represents a libp2p private key
@@ -102,6 +104,34 @@ func (ep2pk *EncryptedP2PKey) SetID(value string) error {
 	return nil
 }
 
+// ToEncryptedP2PKey returns k encrypted via auth, in the format of the legacy
+// encrypted_p2p_keys table
+func (k Key) ToEncryptedP2PKey(auth string, scryptParams utils.ScryptParams) (s EncryptedP2PKey, err error) {
+	var marshalledPrivK []byte
+	marshalledPrivK, err = cryptop2p.MarshalPrivateKey(k)
+	if err != nil {
+		return s, err
+	}
+	cryptoJSON, err := keystore.EncryptDataV3(marshalledPrivK, []byte(adulteratedPassword(auth)), scryptParams.N, scryptParams.P)
+	if err != nil {
+		return s, errors.Wrapf(err, "could not encrypt P2P key")
+	}
+	encryptedPrivKey, err := json.Marshal(&cryptoJSON)
+	if err != nil {
+		return s, errors.Wrapf(err, "could not encode cryptoJSON")
+	}
+
+	pubKeyBytes, err := k.GetPublic().Raw()
+	if err != nil {
+		return s, errors.Wrapf(err, "could not get raw public key")
+	}
+	return EncryptedP2PKey{
+		PeerID:           k.PeerID(),
+		PubKey:           pubKeyBytes,
+		EncryptedPrivKey: encryptedPrivKey,
+	}, nil
+}
+
 // Decrypt returns the PrivateKey in e, decrypted via auth, or an error
 func (ep2pk EncryptedP2PKey) Decrypt(auth string) (k Key, err error) {
 	var cryptoJSON keystore.CryptoJSON
@@ -110,3 +110,24 @@ func TestP2PKeys_EncryptedP2PKey(t *testing.T) {
 		assert.NotEmpty(t, k)
 	})
 }
+
+func TestP2PKeys_ToEncryptedP2PKey(t *testing.T) {
+	privk, _, err := cryptop2p.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	k := Key{PrivKey: privk}
+	p2pk, err := k.ToEncryptedP2PKey("password", utils.FastScryptParams)
+	require.NoError(t, err)
+
+	pubkr, err := k.GetPublic().Raw()
+	require.NoError(t, err)
+	assert.Equal(t, k.PeerID(), p2pk.PeerID)
+	assert.Equal(t, PublicKeyBytes(pubkr), p2pk.PubKey)
+
+	_, err = p2pk.Decrypt("invalid-pass")
+	assert.Error(t, err)
+
+	decrypted, err := p2pk.Decrypt("password")
+	require.NoError(t, err)
+	assert.Equal(t, k.PeerID(), decrypted.PeerID())
+}
@@ -10,6 +10,7 @@ import (
 	"go.dedis.ch/kyber/v3"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/signatures/secp256k1"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // PrivateKey represents the secret used to construct a VRF proof.
@@ -54,6 +55,20 @@ func (k *PrivateKey) GoString() string {
 	return k.String()
 }
 
+// Encrypt returns the key encrypted via auth, in the format of the legacy
+// encrypted_vrf_keys table
+func (k *PrivateKey) Encrypt(auth string, scryptParams utils.ScryptParams) (*EncryptedVRFKey, error) {
+	keyJSON, err := k.ToV2().ToEncryptedJSON(auth, scryptParams)
+	if err != nil {
+		return nil, err
+	}
+	var export EncryptedVRFKeyExport
+	if err = json.Unmarshal(keyJSON, &export); err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal encrypted VRF key %s", k.PublicKey.String())
+	}
+	return &EncryptedVRFKey{PublicKey: export.PublicKey, VRFKey: export.VRFKey}, nil
+}
+
 // Decrypt returns the PrivateKey in e, decrypted via auth, or an error
 func Decrypt(e EncryptedVRFKey, auth string) (*PrivateKey, error) {
 	// NOTE: We do this shuffle to an anonymous struct
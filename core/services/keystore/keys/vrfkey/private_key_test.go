@@ -38,3 +38,21 @@ func TestVRFKeys_PrivateKey(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("VRFKeyV2{PublicKey: %s}", kv2.PublicKey), kv2.String())
 	assert.Equal(t, fmt.Sprintf("PrivateKey{k: <redacted>, PublicKey: %s}", pk.PublicKey), pk.String())
 }
+
+func TestVRFKeys_PrivateKey_Encrypt(t *testing.T) {
+	k, err := NewV2()
+	require.NoError(t, err)
+	pk := PrivateKey{k: *k.k, PublicKey: k.PublicKey}
+
+	ek, err := pk.Encrypt(testutils.Password, utils.FastScryptParams)
+	require.NoError(t, err)
+	assert.Equal(t, k.PublicKey, ek.PublicKey)
+
+	_, err = Decrypt(*ek, "wrong-password")
+	assert.Error(t, err)
+
+	decrypted, err := Decrypt(*ek, testutils.Password)
+	require.NoError(t, err)
+	assert.Equal(t, k.PublicKey, decrypted.PublicKey)
+	assert.Equal(t, k.ID(), decrypted.ToV2().ID())
+}
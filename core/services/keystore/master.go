@@ -1,6 +1,7 @@
 package keystore
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -13,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/solkey"
 
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/sqlx"
@@ -25,10 +27,15 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
 )
 
 var ErrLocked = errors.New("Keystore is locked")
 
+// ErrPasswordMismatch is returned by RotatePassword if the old password is not
+// the password which unlocked the keystore.
+var ErrPasswordMismatch = errors.New("old keystore password does not match")
+
 // DefaultEVMChainIDFunc is a func for getting a default evm chain ID -
 // necessary because it is lazily evaluated
 type DefaultEVMChainIDFunc func() (defaultEVMChainID *big.Int, err error)
@@ -49,6 +56,9 @@ type Master interface {
 	VRF() VRF
 	Unlock(password string) error
 	Migrate(vrfPassword string, f DefaultEVMChainIDFunc) error
+	// RotatePassword re-encrypts the key ring, and the legacy keys, with
+	// newPassword in a single transaction.
+	RotatePassword(oldPassword, newPassword string) error
 	IsEmpty() (bool, error)
 }
 
@@ -235,6 +245,143 @@ WARNING: This will PERMANENTLY AND IRRECOVERABLY delete any legacy eth keys, so
 	return nil
 }
 
+// RotatePassword re-encrypts the key ring with newPassword. The legacy keys
+// which are decrypted by Migrate are re-encrypted too, so that the keystore can
+// still be migrated with newPassword. Legacy VRF keys have their own password,
+// only those which are encrypted with oldPassword are re-encrypted.
+//
+// Either every key is saved encrypted with newPassword or, if an error is
+// returned, none are.
+func (ks *master) RotatePassword(oldPassword, newPassword string) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	if subtle.ConstantTimeCompare([]byte(oldPassword), []byte(ks.password)) != 1 {
+		return ErrPasswordMismatch
+	}
+	if len(newPassword) == 0 {
+		return errors.New("new keystore password must not be empty")
+	}
+
+	ekr, err := ks.keyRing.Encrypt(newPassword, ks.scryptParams)
+	if err != nil {
+		return errors.Wrap(err, "unable to encrypt keyRing")
+	}
+	legacyKeys, err := ks.reencryptV1Keys(oldPassword, newPassword)
+	if err != nil {
+		return err
+	}
+	err = ks.orm.saveEncryptedKeyRing(&ekr, func(tx pg.Queryer) error {
+		return ks.orm.saveEncryptedV1Keys(tx, legacyKeys)
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to save re-encrypted keys")
+	}
+
+	ks.password = newPassword
+	ks.logger.Infow("Rotated keystore password",
+		"legacyCSAKeys", len(legacyKeys.csa),
+		"legacyEthKeys", len(legacyKeys.eth),
+		"legacyOCRKeys", len(legacyKeys.ocr),
+		"legacyP2PKeys", len(legacyKeys.p2p),
+		"legacyVRFKeys", len(legacyKeys.vrf),
+	)
+	return nil
+}
+
+// caller must hold lock!
+func (ks *master) reencryptV1Keys(oldPassword, newPassword string) (keys v1Keys, err error) {
+	csaKeys, err := ks.orm.GetEncryptedV1CSAKeys()
+	if err != nil {
+		return keys, err
+	}
+	for _, k := range csaKeys {
+		if err = k.Unlock(oldPassword); err != nil {
+			return keys, errors.Wrapf(err, "could not decrypt legacy CSA key %d", k.ID)
+		}
+		privKey, err := k.Unsafe_GetPrivateKey()
+		if err != nil {
+			return keys, err
+		}
+		encryptedPrivKey, err := crypto.NewEncryptedPrivateKey(privKey, newPassword, ks.scryptParams)
+		if err != nil {
+			return keys, errors.Wrapf(err, "could not encrypt legacy CSA key %d", k.ID)
+		}
+		k.EncryptedPrivateKey = *encryptedPrivKey
+		keys.csa = append(keys.csa, k)
+	}
+
+	ethKeys, err := ks.orm.GetEncryptedV1EthKeys()
+	if err != nil {
+		return keys, err
+	}
+	for _, k := range ethKeys {
+		dKey, err := gethkeystore.DecryptKey(k.JSON, oldPassword)
+		if err != nil {
+			return keys, errors.Wrapf(err, "could not decrypt legacy eth key %s", k.Address)
+		}
+		if k.JSON, err = gethkeystore.EncryptKey(dKey, newPassword, ks.scryptParams.N, ks.scryptParams.P); err != nil {
+			return keys, errors.Wrapf(err, "could not encrypt legacy eth key %s", k.Address)
+		}
+		keys.eth = append(keys.eth, k)
+	}
+
+	ocrKeys, err := ks.orm.GetEncryptedV1OCRKeys()
+	if err != nil {
+		return keys, err
+	}
+	for _, k := range ocrKeys {
+		kb, err := k.Decrypt(oldPassword)
+		if err != nil {
+			return keys, err
+		}
+		reencrypted, err := kb.Encrypt(newPassword, ks.scryptParams)
+		if err != nil {
+			return keys, err
+		}
+		k.EncryptedPrivateKeys = reencrypted.EncryptedPrivateKeys
+		keys.ocr = append(keys.ocr, k)
+	}
+
+	p2pKeys, err := ks.orm.GetEncryptedV1P2PKeys()
+	if err != nil {
+		return keys, err
+	}
+	for _, k := range p2pKeys {
+		pk, err := k.Decrypt(oldPassword)
+		if err != nil {
+			return keys, err
+		}
+		reencrypted, err := pk.ToEncryptedP2PKey(newPassword, ks.scryptParams)
+		if err != nil {
+			return keys, err
+		}
+		k.EncryptedPrivKey = reencrypted.EncryptedPrivKey
+		keys.p2p = append(keys.p2p, k)
+	}
+
+	vrfKeys, err := ks.orm.GetEncryptedV1VRFKeys()
+	if err != nil {
+		return keys, err
+	}
+	for _, k := range vrfKeys {
+		pk, err := vrfkey.Decrypt(k, oldPassword)
+		if err != nil {
+			ks.logger.Infow("Not re-encrypting legacy VRF key, it is not encrypted with the keystore password", "publicKey", k.PublicKey)
+			continue
+		}
+		reencrypted, err := pk.Encrypt(newPassword, ks.scryptParams)
+		if err != nil {
+			return keys, err
+		}
+		k.VRFKey = reencrypted.VRFKey
+		keys.vrf = append(keys.vrf, k)
+	}
+	return keys, nil
+}
+
 type keyManager struct {
 	orm          ksORM
 	scryptParams utils.ScryptParams
@@ -1,15 +1,24 @@
 package keystore_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	configtest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest/v2"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestMasterKeystore_Unlock_Save(t *testing.T) {
@@ -61,3 +70,133 @@ func TestMasterKeystore_Unlock_Save(t *testing.T) {
 		require.NoError(t, keyStore.Unlock(cltest.Password))
 	})
 }
+
+func TestMasterKeystore_RotatePassword(t *testing.T) {
+	t.Parallel()
+
+	const newPassword = "n3wP4SsW0rD1!@#_"
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+
+	csaKey, err := keyStore.CSA().Create()
+	require.NoError(t, err)
+	ethKey, err := keyStore.Eth().Create(&cltest.FixtureChainID)
+	require.NoError(t, err)
+	ocrKey, err := keyStore.OCR().Create()
+	require.NoError(t, err)
+	ocr2Key, err := keyStore.OCR2().Create(chaintype.EVM)
+	require.NoError(t, err)
+	p2pKey, err := keyStore.P2P().Create()
+	require.NoError(t, err)
+	solanaKey, err := keyStore.Solana().Create()
+	require.NoError(t, err)
+	cosmosKey, err := keyStore.Cosmos().Create()
+	require.NoError(t, err)
+	starknetKey, err := keyStore.StarkNet().Create()
+	require.NoError(t, err)
+	vrfKey, err := keyStore.VRF().Create()
+	require.NoError(t, err)
+	dkgSignKey, err := keyStore.DKGSign().Create()
+	require.NoError(t, err)
+	dkgEncryptKey, err := keyStore.DKGEncrypt().Create()
+	require.NoError(t, err)
+
+	// Legacy keys, which are decrypted on every boot by Migrate
+	legacyCSAKey, err := csakey.New(cltest.Password, utils.FastScryptParams)
+	require.NoError(t, err)
+	require.NoError(t, utils.JustError(db.Exec(`INSERT INTO csa_keys (public_key, encrypted_private_key, created_at, updated_at) VALUES ($1, $2, NOW(), NOW())`, legacyCSAKey.PublicKey, legacyCSAKey.EncryptedPrivateKey)))
+	legacyVRFKey, err := vrfkey.NewV2()
+	require.NoError(t, err)
+	legacyVRFKeyJSON, err := legacyVRFKey.ToEncryptedJSON(cltest.Password, utils.FastScryptParams)
+	require.NoError(t, err)
+	var legacyVRFKeyExport vrfkey.EncryptedVRFKeyExport
+	require.NoError(t, json.Unmarshal(legacyVRFKeyJSON, &legacyVRFKeyExport))
+	encryptedLegacyVRFKey, err := json.Marshal(legacyVRFKeyExport.VRFKey)
+	require.NoError(t, err)
+	require.NoError(t, utils.JustError(db.Exec(`INSERT INTO encrypted_vrf_keys (public_key, vrf_key, created_at, updated_at, deleted_at) VALUES ($1, $2, NOW(), NOW(), NULL)`, legacyVRFKeyExport.PublicKey, string(encryptedLegacyVRFKey))))
+
+	t.Run("fails if the old password does not match", func(t *testing.T) {
+		err := keyStore.RotatePassword("wrong password", newPassword)
+		require.ErrorIs(t, err, keystore.ErrPasswordMismatch)
+	})
+
+	t.Run("fails if the new password is empty", func(t *testing.T) {
+		require.Error(t, keyStore.RotatePassword(cltest.Password, ""))
+	})
+
+	require.NoError(t, keyStore.RotatePassword(cltest.Password, newPassword))
+
+	rotated := keystore.ExposedNewMaster(t, db, cfg)
+	require.Error(t, rotated.Unlock(cltest.Password))
+	require.NoError(t, rotated.Unlock(newPassword))
+
+	t.Run("all key types remain usable", func(t *testing.T) {
+		gotCSA, err := rotated.CSA().Get(csaKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, csaKey.Raw(), gotCSA.Raw())
+
+		tx := types.NewTransaction(0, testutils.NewAddress(), big.NewInt(1), 21000, big.NewInt(1), nil)
+		signed, err := rotated.Eth().SignTx(ethKey.Address, tx, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		sender, err := types.Sender(types.LatestSignerForChainID(&cltest.FixtureChainID), signed)
+		require.NoError(t, err)
+		assert.Equal(t, ethKey.Address, sender)
+
+		gotOCR, err := rotated.OCR().Get(ocrKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, ocrKey.Raw(), gotOCR.Raw())
+
+		gotOCR2, err := rotated.OCR2().Get(ocr2Key.ID())
+		require.NoError(t, err)
+		assert.Equal(t, ocr2Key.Raw(), gotOCR2.Raw())
+
+		gotP2P, err := rotated.P2P().Get(p2pKey.PeerID())
+		require.NoError(t, err)
+		assert.Equal(t, p2pKey.Raw(), gotP2P.Raw())
+
+		msg := []byte("hello")
+		sig, err := rotated.Solana().Sign(testutils.Context(t), solanaKey.ID(), msg)
+		require.NoError(t, err)
+		expected, err := solanaKey.Sign(msg)
+		require.NoError(t, err)
+		assert.Equal(t, expected, sig)
+
+		gotCosmos, err := rotated.Cosmos().Get(cosmosKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, cosmosKey.Raw(), gotCosmos.Raw())
+
+		gotStarkNet, err := rotated.StarkNet().Get(starknetKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, starknetKey.Raw(), gotStarkNet.Raw())
+
+		proof, err := rotated.VRF().GenerateProof(vrfKey.ID(), big.NewInt(1))
+		require.NoError(t, err)
+		assert.Equal(t, vrfKey.PublicKey, proof.PublicKey)
+
+		gotDKGSign, err := rotated.DKGSign().Get(dkgSignKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, dkgSignKey.Raw(), gotDKGSign.Raw())
+
+		gotDKGEncrypt, err := rotated.DKGEncrypt().Get(dkgEncryptKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, dkgEncryptKey.Raw(), gotDKGEncrypt.Raw())
+	})
+
+	t.Run("legacy keys are re-encrypted", func(t *testing.T) {
+		csaKeys, err := rotated.CSA().GetV1KeysAsV2()
+		require.NoError(t, err)
+		require.Len(t, csaKeys, 1)
+		assert.Equal(t, legacyCSAKey.PublicKey.String(), csaKeys[0].PublicKeyString())
+
+		vrfKeys, err := rotated.VRF().GetV1KeysAsV2(newPassword)
+		require.NoError(t, err)
+		require.Len(t, vrfKeys, 1)
+		assert.Equal(t, legacyVRFKey.ID(), vrfKeys[0].ID())
+
+		require.NoError(t, rotated.Migrate(newPassword, func() (*big.Int, error) { return &cltest.FixtureChainID, nil }))
+	})
+}
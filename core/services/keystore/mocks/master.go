@@ -178,6 +178,20 @@ func (_m *Master) P2P() keystore.P2P {
 	return r0
 }
 
+// RotatePassword provides a mock function with given fields: oldPassword, newPassword
+func (_m *Master) RotatePassword(oldPassword string, newPassword string) error {
+	ret := _m.Called(oldPassword, newPassword)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(oldPassword, newPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Solana provides a mock function with given fields:
 func (_m *Master) Solana() keystore.Solana {
 	ret := _m.Called()
@@ -2,6 +2,7 @@ package keystore
 
 import (
 	"database/sql"
+	"encoding/json"
 	"math/big"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -114,3 +115,46 @@ func (orm ksORM) GetEncryptedV1P2PKeys() (retrieved []p2pkey.EncryptedP2PKey, er
 func (orm ksORM) GetEncryptedV1VRFKeys() (retrieved []vrfkey.EncryptedVRFKey, err error) {
 	return retrieved, orm.q.Select(&retrieved, `SELECT * FROM encrypted_vrf_keys WHERE deleted_at IS NULL`)
 }
+
+// v1Keys are the legacy keys which are stored encrypted in their own tables
+type v1Keys struct {
+	csa []csakey.Key
+	eth []ethkey.Key
+	ocr []ocrkey.EncryptedKeyBundle
+	p2p []p2pkey.EncryptedP2PKey
+	vrf []vrfkey.EncryptedVRFKey
+}
+
+// saveEncryptedV1Keys updates the encrypted private keys of the legacy keys
+func (orm ksORM) saveEncryptedV1Keys(tx pg.Queryer, keys v1Keys) error {
+	for _, k := range keys.csa {
+		if _, err := tx.Exec(`UPDATE csa_keys SET encrypted_private_key = $1, updated_at = NOW() WHERE id = $2`, k.EncryptedPrivateKey, k.ID); err != nil {
+			return errors.Wrapf(err, "while saving legacy CSA key %d", k.ID)
+		}
+	}
+	for _, k := range keys.eth {
+		if _, err := tx.Exec(`UPDATE keys SET json = $1, updated_at = NOW() WHERE id = $2`, k.JSON, k.ID); err != nil {
+			return errors.Wrapf(err, "while saving legacy eth key %s", k.Address)
+		}
+	}
+	for _, k := range keys.ocr {
+		if _, err := tx.Exec(`UPDATE encrypted_ocr_key_bundles SET encrypted_private_keys = $1, updated_at = NOW() WHERE id = $2`, k.EncryptedPrivateKeys, k.ID); err != nil {
+			return errors.Wrapf(err, "while saving legacy OCR key %s", k.ID)
+		}
+	}
+	for _, k := range keys.p2p {
+		if _, err := tx.Exec(`UPDATE encrypted_p2p_keys SET encrypted_priv_key = $1, updated_at = NOW() WHERE id = $2`, k.EncryptedPrivKey, k.ID); err != nil {
+			return errors.Wrapf(err, "while saving legacy P2P key %s", k.PeerID)
+		}
+	}
+	for _, k := range keys.vrf {
+		vrfKey, err := json.Marshal(k.VRFKey)
+		if err != nil {
+			return errors.Wrapf(err, "while encoding legacy VRF key %s", k.PublicKey)
+		}
+		if _, err = tx.Exec(`UPDATE encrypted_vrf_keys SET vrf_key = $1, updated_at = NOW() WHERE public_key = $2`, string(vrfKey), k.PublicKey); err != nil {
+			return errors.Wrapf(err, "while saving legacy VRF key %s", k.PublicKey)
+		}
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strconv"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -20,6 +21,8 @@ import (
 
 	"github.com/smartcontractkit/chainlink-relay/pkg/loop"
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -52,6 +55,7 @@ import (
 type Delegate struct {
 	db                    *sqlx.DB
 	jobORM                job.ORM
+	bridgeORM             bridges.ORM
 	pipelineRunner        pipeline.Runner
 	peerWrapper           *ocrcommon.SingletonPeerWrapper
 	monitoringEndpointGen telemetry.MonitoringEndpointGenerator
@@ -77,6 +81,7 @@ var _ job.Delegate = (*Delegate)(nil)
 func NewDelegate(
 	db *sqlx.DB,
 	jobORM job.ORM,
+	bridgeORM bridges.ORM,
 	pipelineRunner pipeline.Runner,
 	peerWrapper *ocrcommon.SingletonPeerWrapper,
 	monitoringEndpointGen telemetry.MonitoringEndpointGenerator,
@@ -93,6 +98,7 @@ func NewDelegate(
 	return &Delegate{
 		db:                    db,
 		jobORM:                jobORM,
+		bridgeORM:             bridgeORM,
 		pipelineRunner:        pipelineRunner,
 		peerWrapper:           peerWrapper,
 		monitoringEndpointGen: monitoringEndpointGen,
@@ -219,6 +225,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 		FeedID:     spec.FeedID,
 	}
 	lggr := logger.Sugared(d.lggr.Named("OCR2").With(ctxVals.Args()...))
+	telemChainID := telemetryChainID(spec.RelayConfig)
 	feedID := spec.FeedID
 	if feedID != (common.Hash{}) {
 		lggr = logger.Sugared(lggr.With("feedID", spec.FeedID))
@@ -268,6 +275,9 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 		}
 	}
 	spec.RelayConfig["effectiveTransmitterID"] = effectiveTransmitterID
+	if jb.TransactionPriority != txmgrtypes.TxPriorityNormal {
+		spec.RelayConfig["transactionPriority"] = jb.TransactionPriority
+	}
 
 	ocrDB := NewDB(d.db, spec.ID, lggr, d.cfg)
 	peerWrapper := d.peerWrapper
@@ -353,7 +363,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 			// but mercury runs multiple feeds per contract.
 			// How can we scope this to a more granular level?
 			// https://smartcontract-it.atlassian.net/browse/MERC-227
-			MonitoringEndpoint:     d.monitoringEndpointGen.GenMonitoringEndpoint(spec.ContractID, synchronization.OCR2Mercury),
+			MonitoringEndpoint:     d.monitoringEndpointGen.GenMonitoringEndpoint(spec.ContractID, synchronization.OCR2Mercury, jb.ID, telemChainID),
 			OffchainConfigDigester: mercuryProvider.OffchainConfigDigester(),
 			OffchainKeyring:        kb,
 			OnchainKeyring:         kb,
@@ -363,7 +373,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 		mercuryServices, err2 := mercury.NewServices(jb, mercuryProvider, d.pipelineRunner, runResults, lggr, oracleArgsNoPlugin, d.cfg, chEnhancedTelem, chain)
 
 		if ocrcommon.ShouldCollectEnhancedTelemetryMercury(&jb) {
-			enhancedTelemService := ocrcommon.NewEnhancedTelemetryService(&jb, chEnhancedTelem, make(chan struct{}), d.monitoringEndpointGen.GenMonitoringEndpoint(spec.ContractID, synchronization.EnhancedEAMercury), lggr.Named("Enhanced Telemetry Mercury"))
+			enhancedTelemService := ocrcommon.NewEnhancedTelemetryService(&jb, chEnhancedTelem, make(chan struct{}), d.monitoringEndpointGen.GenMonitoringEndpoint(spec.ContractID, synchronization.EnhancedEAMercury, jb.ID, telemChainID), lggr.Named("Enhanced Telemetry Mercury"))
 			mercuryServices = append(mercuryServices, enhancedTelemService)
 		}
 
@@ -376,7 +386,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 			Database:                     ocrDB,
 			LocalConfig:                  lc,
 			Logger:                       ocrLogger,
-			MonitoringEndpoint:           d.monitoringEndpointGen.GenMonitoringEndpoint(spec.ContractID, synchronization.OCR2Median),
+			MonitoringEndpoint:           d.monitoringEndpointGen.GenMonitoringEndpoint(spec.ContractID, synchronization.OCR2Median, jb.ID, telemChainID),
 			OffchainKeyring:              kb,
 			OnchainKeyring:               kb,
 		}
@@ -386,7 +396,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 		medianServices, err2 := median.NewMedianServices(ctx, jb, d.isNewlyCreatedJob, relayer, d.pipelineRunner, runResults, lggr, oracleArgsNoPlugin, d.cfg, enhancedTelemChan, errorLog)
 
 		if ocrcommon.ShouldCollectEnhancedTelemetry(&jb) {
-			enhancedTelemService := ocrcommon.NewEnhancedTelemetryService(&jb, enhancedTelemChan, make(chan struct{}), d.monitoringEndpointGen.GenMonitoringEndpoint(spec.ContractID, synchronization.EnhancedEA), lggr.Named("Enhanced Telemetry"))
+			enhancedTelemService := ocrcommon.NewEnhancedTelemetryService(&jb, enhancedTelemChan, make(chan struct{}), d.monitoringEndpointGen.GenMonitoringEndpoint(spec.ContractID, synchronization.EnhancedEA, jb.ID, telemChainID), lggr.Named("Enhanced Telemetry"))
 			medianServices = append(medianServices, enhancedTelemService)
 		}
 
@@ -576,7 +586,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 			VRFContractTransmitter:       vrfProvider.ContractTransmitter(),
 			VRFDatabase:                  ocrDB,
 			VRFLocalConfig:               lc,
-			VRFMonitoringEndpoint:        d.monitoringEndpointGen.GenMonitoringEndpoint(spec.ContractID, synchronization.OCR2VRF),
+			VRFMonitoringEndpoint:        d.monitoringEndpointGen.GenMonitoringEndpoint(spec.ContractID, synchronization.OCR2VRF, jb.ID, telemChainID),
 			DKGContractConfigTracker:     dkgProvider.ContractConfigTracker(),
 			DKGOffchainConfigDigester:    dkgProvider.OffchainConfigDigester(),
 			DKGContract:                  dkgpkg.NewOnchainContract(dkgContract, &altbn_128.G2{}),
@@ -645,7 +655,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 			KeepersDatabase:              ocrDB,
 			LocalConfig:                  lc,
 			Logger:                       ocrLogger,
-			MonitoringEndpoint:           d.monitoringEndpointGen.GenMonitoringEndpoint(spec.ContractID, synchronization.OCR2Automation),
+			MonitoringEndpoint:           d.monitoringEndpointGen.GenMonitoringEndpoint(spec.ContractID, synchronization.OCR2Automation, jb.ID, telemChainID),
 			OffchainConfigDigester:       keeperProvider.OffchainConfigDigester(),
 			OffchainKeyring:              kb,
 			OnchainKeyring:               kb,
@@ -723,7 +733,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 			Database:                     ocrDB,
 			LocalConfig:                  lc,
 			Logger:                       ocrLogger,
-			MonitoringEndpoint:           d.monitoringEndpointGen.GenMonitoringEndpoint(spec.ContractID, synchronization.OCR2Functions),
+			MonitoringEndpoint:           d.monitoringEndpointGen.GenMonitoringEndpoint(spec.ContractID, synchronization.OCR2Functions, jb.ID, telemChainID),
 			OffchainConfigDigester:       functionsProvider.OffchainConfigDigester(),
 			OffchainKeyring:              kb,
 			OnchainKeyring:               kb,
@@ -734,13 +744,14 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 			Job:             jb,
 			PipelineRunner:  d.pipelineRunner,
 			JobORM:          d.jobORM,
+			BridgeORM:       d.bridgeORM,
 			OCR2JobConfig:   d.cfg,
 			DB:              d.db,
 			Chain:           chain,
 			ContractID:      spec.ContractID,
 			Lggr:            lggr,
 			MailMon:         d.mailMon,
-			URLsMonEndpoint: d.monitoringEndpointGen.GenMonitoringEndpoint(spec.ContractID, synchronization.FunctionsRequests),
+			URLsMonEndpoint: d.monitoringEndpointGen.GenMonitoringEndpoint(spec.ContractID, synchronization.FunctionsRequests, jb.ID, telemChainID),
 		}
 
 		functionsServices, err := functions.NewFunctionsServices(&sharedOracleArgs, &functionsServicesConfig)
@@ -774,3 +785,15 @@ type errorLog struct {
 func (l *errorLog) SaveError(ctx context.Context, msg string) error {
 	return l.recordError(l.jobID, msg)
 }
+
+// telemetryChainID returns the chain ID from the relay config to tag telemetry with, or an empty string if there is none.
+func telemetryChainID(relayConfig job.JSONConfig) string {
+	switch id := relayConfig["chainID"].(type) {
+	case string:
+		return id
+	case float64:
+		return strconv.FormatInt(int64(id), 10)
+	default:
+		return ""
+	}
+}
@@ -10,6 +10,10 @@ type PluginConfig struct {
 	RequestTimeoutBatchLookupSize   uint32 `json:"requestTimeoutBatchLookupSize"`
 	ListenerEventHandlerTimeoutSec  uint32 `json:"listenerEventHandlerTimeoutSec"`
 	MaxRequestSizeBytes             uint32 `json:"maxRequestSizeBytes"`
+	MaxResponseSizeBytes            uint32 `json:"maxResponseSizeBytes"`
+	MaxAdapterResponseSizeBytes     uint32 `json:"maxAdapterResponseSizeBytes"`
+	// UsePipelineExecutor falls back to running requests through a pipeline instead of calling the external adapter directly.
+	UsePipelineExecutor bool `json:"usePipelineExecutor"`
 }
 
 func ValidatePluginConfig(config PluginConfig) error {
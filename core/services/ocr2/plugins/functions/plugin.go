@@ -10,6 +10,7 @@ import (
 	"github.com/smartcontractkit/libocr/commontypes"
 	libocr2 "github.com/smartcontractkit/libocr/offchainreporting2"
 
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/ocr2dr_oracle"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -19,12 +20,14 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	clhttp "github.com/smartcontractkit/chainlink/v2/core/utils/http"
 )
 
 type FunctionsServicesConfig struct {
 	Job             job.Job
 	PipelineRunner  pipeline.Runner
 	JobORM          job.ORM
+	BridgeORM       bridges.ORM
 	OCR2JobConfig   validate.Config
 	DB              *sqlx.DB
 	Chain           evm.Chain
@@ -60,7 +63,18 @@ func NewFunctionsServices(sharedOracleArgs *libocr2.OracleArgs, conf *FunctionsS
 			"jobID", conf.Job.PipelineSpec.JobID,
 			"externalJobID", conf.Job.ExternalJobID,
 		)
-	functionsListener := functions.NewFunctionsListener(oracleContract, conf.Job, conf.PipelineRunner, conf.JobORM, pluginORM, pluginConfig, conf.Chain.LogBroadcaster(), svcLogger, conf.MailMon, conf.URLsMonEndpoint)
+	var executor functions.Executor
+	if pluginConfig.UsePipelineExecutor {
+		executor = functions.NewPipelineExecutor(conf.Job, conf.PipelineRunner, conf.JobORM, pluginConfig.MaxResponseSizeBytes, svcLogger)
+	} else {
+		executor = functions.NewExternalAdapterExecutor(functions.ExternalAdapterExecutorConfig{
+			JobName:                     conf.Job.Name.ValueOrZero(),
+			MaxAdapterResponseSizeBytes: pluginConfig.MaxAdapterResponseSizeBytes,
+			MaxResponseSizeBytes:        pluginConfig.MaxResponseSizeBytes,
+			Timeout:                     conf.Job.MaxTaskDuration.Duration(),
+		}, conf.BridgeORM, clhttp.NewUnrestrictedHTTPClient(), svcLogger)
+	}
+	functionsListener := functions.NewFunctionsListener(oracleContract, conf.Job, executor, pluginORM, pluginConfig, conf.Chain.LogBroadcaster(), svcLogger, conf.MailMon, conf.URLsMonEndpoint)
 
 	sharedOracleArgs.ReportingPluginFactory = FunctionsReportingPluginFactory{
 		Logger:    sharedOracleArgs.Logger,
@@ -3,6 +3,7 @@ package functions
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
@@ -354,7 +355,7 @@ func (r *functionsReporting) ShouldAcceptFinalizedReport(ctx context.Context, ts
 			needTransmissionIds = append(needTransmissionIds, reqIdStr)
 			continue
 		}
-		err = r.pluginORM.SetFinalized(id, item.Result, item.Error, pg.WithParentCtx(ctx)) // validates state transition
+		err = r.pluginORM.SetFinalized(id, item.Result, item.Error, time.Now(), pg.WithParentCtx(ctx)) // validates state transition
 		if err != nil {
 			r.logger.Debug("FunctionsReporting ShouldAcceptFinalizedReport: state couldn't be changed to FINALIZED. Not transmitting.", commontypes.LogFields{"requestID": reqIdStr, "err": err})
 			continue
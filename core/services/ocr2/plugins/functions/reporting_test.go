@@ -285,11 +285,11 @@ func TestDRReporting_ShouldAcceptFinalizedReport(t *testing.T) {
 
 	orm.On("FindById", req1.RequestID, mock.Anything).Return(nil, errors.New("nonexistent ID"))
 	orm.On("FindById", req2.RequestID, mock.Anything).Return(&req2, nil)
-	orm.On("SetFinalized", req2.RequestID, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	orm.On("SetFinalized", req2.RequestID, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	orm.On("FindById", req3.RequestID, mock.Anything).Return(&req3, nil)
-	orm.On("SetFinalized", req3.RequestID, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("same state"))
+	orm.On("SetFinalized", req3.RequestID, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("same state"))
 	orm.On("FindById", req4.RequestID, mock.Anything).Return(&req4, nil)
-	orm.On("SetFinalized", req4.RequestID, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("already timed out"))
+	orm.On("SetFinalized", req4.RequestID, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("already timed out"))
 
 	// Attempting to transmit 2 requests, out of which:
 	//   - one was already accepted for transmission earlier
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
+	"sort"
 
 	pkgerrors "github.com/pkg/errors"
 
@@ -17,24 +18,41 @@ import (
 type PluginConfig struct {
 	RawServerURL string              `json:"serverURL" toml:"serverURL"`
 	ServerPubKey utils.PlainHexBytes `json:"serverPubKey" toml:"serverPubKey"`
+	// Servers maps the URLs of the Mercury servers to transmit reports to, to
+	// their public keys. It is mutually exclusive with serverURL and
+	// serverPubKey.
+	Servers map[string]utils.PlainHexBytes `json:"servers" toml:"servers"`
+}
+
+// Server is a Mercury server reports are transmitted to.
+type Server struct {
+	URL    string
+	PubKey utils.PlainHexBytes
 }
 
 func ValidatePluginConfig(config PluginConfig) (merr error) {
+	if len(config.Servers) > 0 {
+		if config.RawServerURL != "" || len(config.ServerPubKey) != 0 {
+			merr = errors.New("Mercury: Servers and RawServerURL/ServerPubKey may not be specified together")
+		}
+		// servers are identified by their URL without the optional wss://
+		// scheme, so URLs differing only by it are the same server
+		seen := make(map[string]string, len(config.Servers))
+		for _, serverURL := range sortedServerURLs(config.Servers) {
+			merr = errors.Join(merr, validateServer(serverURL, config.Servers[serverURL]))
+			normalized := wssRegexp.ReplaceAllString(serverURL, "")
+			if other, ok := seen[normalized]; ok {
+				merr = errors.Join(merr, pkgerrors.Errorf("Mercury: Servers %q and %q are the same server", other, serverURL))
+			}
+			seen[normalized] = serverURL
+		}
+		return merr
+	}
+
 	if config.RawServerURL == "" {
 		merr = errors.New("Mercury: ServerURL must be specified")
 	} else {
-		var normalizedURI string
-		if schemeRegexp.MatchString(config.RawServerURL) {
-			normalizedURI = config.RawServerURL
-		} else {
-			normalizedURI = fmt.Sprintf("wss://%s", config.RawServerURL)
-		}
-		uri, err := url.ParseRequestURI(normalizedURI)
-		if err != nil {
-			merr = pkgerrors.Wrap(err, "Mercury: invalid value for ServerURL")
-		} else if !(uri.Scheme == "" || uri.Scheme == "wss") {
-			merr = pkgerrors.Errorf(`Mercury: invalid scheme specified for MercuryServer, got: %q (scheme: %q) but expected a websocket url e.g. "192.0.2.2:4242" or "wss://192.0.2.2:4242"`, config.RawServerURL, uri.Scheme)
-		}
+		merr = validateServerURL(config.RawServerURL)
 	}
 	if len(config.ServerPubKey) != 32 {
 		merr = errors.Join(merr, errors.New("Mercury: ServerPubKey is required and must be a 32-byte hex string"))
@@ -42,9 +60,55 @@ func ValidatePluginConfig(config PluginConfig) (merr error) {
 	return merr
 }
 
+func validateServer(serverURL string, pubKey utils.PlainHexBytes) (merr error) {
+	merr = validateServerURL(serverURL)
+	if len(pubKey) != 32 {
+		merr = errors.Join(merr, pkgerrors.Errorf("Mercury: ServerPubKey for %q must be a 32-byte hex string", serverURL))
+	}
+	return merr
+}
+
+func validateServerURL(rawServerURL string) error {
+	var normalizedURI string
+	if schemeRegexp.MatchString(rawServerURL) {
+		normalizedURI = rawServerURL
+	} else {
+		normalizedURI = fmt.Sprintf("wss://%s", rawServerURL)
+	}
+	uri, err := url.ParseRequestURI(normalizedURI)
+	if err != nil {
+		return pkgerrors.Wrap(err, "Mercury: invalid value for ServerURL")
+	} else if !(uri.Scheme == "" || uri.Scheme == "wss") {
+		return pkgerrors.Errorf(`Mercury: invalid scheme specified for MercuryServer, got: %q (scheme: %q) but expected a websocket url e.g. "192.0.2.2:4242" or "wss://192.0.2.2:4242"`, rawServerURL, uri.Scheme)
+	}
+	return nil
+}
+
+func sortedServerURLs(servers map[string]utils.PlainHexBytes) []string {
+	urls := make([]string, 0, len(servers))
+	for u := range servers {
+		urls = append(urls, u)
+	}
+	sort.Strings(urls)
+	return urls
+}
+
 var schemeRegexp = regexp.MustCompile(`^(.*)://`)
 var wssRegexp = regexp.MustCompile(`^wss://`)
 
 func (p PluginConfig) ServerURL() string {
 	return wssRegexp.ReplaceAllString(p.RawServerURL, "")
 }
+
+// GetServers returns the servers to transmit reports to, sorted by URL, with
+// the wss:// scheme stripped from the URLs.
+func (p PluginConfig) GetServers() []Server {
+	if len(p.Servers) == 0 {
+		return []Server{{URL: p.ServerURL(), PubKey: p.ServerPubKey}}
+	}
+	servers := make([]Server, 0, len(p.Servers))
+	for _, u := range sortedServerURLs(p.Servers) {
+		servers = append(servers, Server{URL: wssRegexp.ReplaceAllString(u, ""), PubKey: p.Servers[u]})
+	}
+	return servers
+}
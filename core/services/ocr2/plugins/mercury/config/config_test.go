@@ -43,6 +43,68 @@ ServerPubKey = "4242"
 	})
 }
 
+func Test_PluginConfig_Servers(t *testing.T) {
+	t.Run("with valid values", func(t *testing.T) {
+		rawToml := `
+[servers]
+"wss://primary.example.com:80" = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+"secondary.example.com:80" = "9b50ffd2a3e0ab7ab4a67f0ad02e8d4ae2aae1dd8f3e3c4e5e8c0b8d4c3b1a10"
+`
+
+		var mc PluginConfig
+		err := toml.Unmarshal([]byte(rawToml), &mc)
+		require.NoError(t, err)
+		require.NoError(t, ValidatePluginConfig(mc))
+
+		servers := mc.GetServers()
+		require.Len(t, servers, 2)
+		assert.Equal(t, "secondary.example.com:80", servers[0].URL)
+		assert.Equal(t, "9b50ffd2a3e0ab7ab4a67f0ad02e8d4ae2aae1dd8f3e3c4e5e8c0b8d4c3b1a10", servers[0].PubKey.String())
+		assert.Equal(t, "primary.example.com:80", servers[1].URL)
+		assert.Equal(t, "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93", servers[1].PubKey.String())
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		rawToml := `
+ServerURL = "example.com:80"
+[servers]
+"http://primary.example.com" = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+"secondary.example.com:80" = "4242"
+`
+
+		var mc PluginConfig
+		err := toml.Unmarshal([]byte(rawToml), &mc)
+		require.NoError(t, err)
+
+		err = ValidatePluginConfig(mc)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Mercury: Servers and RawServerURL/ServerPubKey may not be specified together")
+		assert.Contains(t, err.Error(), `Mercury: invalid scheme specified for MercuryServer, got: "http://primary.example.com"`)
+		assert.Contains(t, err.Error(), `Mercury: ServerPubKey for "secondary.example.com:80" must be a 32-byte hex string`)
+	})
+
+	t.Run("duplicate servers", func(t *testing.T) {
+		rawToml := `
+[servers]
+"wss://primary.example.com:80" = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+"primary.example.com:80" = "9b50ffd2a3e0ab7ab4a67f0ad02e8d4ae2aae1dd8f3e3c4e5e8c0b8d4c3b1a10"
+`
+
+		var mc PluginConfig
+		err := toml.Unmarshal([]byte(rawToml), &mc)
+		require.NoError(t, err)
+
+		err = ValidatePluginConfig(mc)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `Mercury: Servers "primary.example.com:80" and "wss://primary.example.com:80" are the same server`)
+	})
+
+	t.Run("single server", func(t *testing.T) {
+		pc := PluginConfig{RawServerURL: "wss://example.com", ServerPubKey: []byte{1}}
+		assert.Equal(t, []Server{{URL: "example.com", PubKey: []byte{1}}}, pc.GetServers())
+	})
+}
+
 func Test_PluginConfig_ServerURL(t *testing.T) {
 	pc := PluginConfig{RawServerURL: "example.com"}
 	assert.Equal(t, "example.com", pc.ServerURL())
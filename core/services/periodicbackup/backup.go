@@ -2,7 +2,10 @@ package periodicbackup
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"os/exec"
@@ -10,6 +13,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -19,7 +23,9 @@ import (
 )
 
 var (
-	filePattern        = "cl_backup_%s.dump"
+	filePattern        = "cl_backup_%s_%s.dump"
+	encryptedSuffix    = ".enc"
+	timestampFormat    = "20060102T150405Z"
 	minBackupFrequency = time.Minute
 
 	excludedDataFromTables = []string{
@@ -47,22 +53,44 @@ type (
 		mode            config.DatabaseBackupMode
 		frequency       time.Duration
 		outputParentDir string
+		destination     BackupDestination
+		retention       RetentionPolicy
+		encrypt         bool
+		password        string
+		scryptParams    utils.ScryptParams
 		done            chan bool
 		utils.StartStopOnce
 	}
 
 	Config interface {
+		utils.ScryptConfigReader
 		DatabaseBackupMode() config.DatabaseBackupMode
 		DatabaseBackupFrequency() time.Duration
 		DatabaseBackupURL() *url.URL
 		DatabaseBackupDir() string
+		DatabaseBackupMaxCount() uint32
+		DatabaseBackupMaxAge() time.Duration
+		DatabaseBackupEncrypt() bool
 		DatabaseURL() url.URL
+		KeystorePassword() string
 		RootDir() string
 	}
 )
 
-// NewDatabaseBackup instantiates a *databaseBackup
+// NewDatabaseBackup instantiates a *databaseBackup that stores backups in the
+// local backup directory.
 func NewDatabaseBackup(config Config, lggr logger.Logger) (DatabaseBackup, error) {
+	outputParentDir, err := OutputDir(config)
+	if err != nil {
+		return nil, err
+	}
+	return NewDatabaseBackupWithDestination(config, NewLocalDestination(outputParentDir), lggr)
+}
+
+// NewDatabaseBackupWithDestination instantiates a *databaseBackup that stores
+// backups in dest. The local backup directory is still used for the dump
+// before it is handed over to dest.
+func NewDatabaseBackupWithDestination(config Config, dest BackupDestination, lggr logger.Logger) (DatabaseBackup, error) {
 	lggr = lggr.Named("DatabaseBackup")
 	dbUrl := config.DatabaseURL()
 	dbBackupUrl := config.DatabaseBackupURL()
@@ -70,26 +98,50 @@ func NewDatabaseBackup(config Config, lggr logger.Logger) (DatabaseBackup, error
 		dbUrl = *dbBackupUrl
 	}
 
-	outputParentDir := filepath.Join(config.RootDir(), "backup")
-	if config.DatabaseBackupDir() != "" {
-		dir, err := filepath.Abs(config.DatabaseBackupDir())
-		if err != nil {
-			return nil, errors.Errorf("failed to get path for Database.Backup.Dir (%s) - please set it to a valid directory path", config.DatabaseBackupDir())
+	outputParentDir, err := OutputDir(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var password string
+	if config.DatabaseBackupEncrypt() {
+		password = config.KeystorePassword()
+		if password == "" {
+			return nil, errors.New("Database.Backup.Encrypt requires the keystore password to be set")
 		}
-		outputParentDir = dir
 	}
 
 	return &databaseBackup{
-		lggr,
-		dbUrl,
-		config.DatabaseBackupMode(),
-		config.DatabaseBackupFrequency(),
-		outputParentDir,
-		make(chan bool),
-		utils.StartStopOnce{},
+		logger:          lggr,
+		databaseURL:     dbUrl,
+		mode:            config.DatabaseBackupMode(),
+		frequency:       config.DatabaseBackupFrequency(),
+		outputParentDir: outputParentDir,
+		destination:     dest,
+		retention: RetentionPolicy{
+			MaxCount: int(config.DatabaseBackupMaxCount()),
+			MaxAge:   config.DatabaseBackupMaxAge(),
+		},
+		encrypt:      config.DatabaseBackupEncrypt(),
+		password:     password,
+		scryptParams: utils.GetScryptParams(config),
+		done:         make(chan bool),
 	}, nil
 }
 
+// OutputDir returns the local backup directory: Database.Backup.Dir if set,
+// otherwise the backup directory under the root directory.
+func OutputDir(config Config) (string, error) {
+	if config.DatabaseBackupDir() == "" {
+		return filepath.Join(config.RootDir(), "backup"), nil
+	}
+	dir, err := filepath.Abs(config.DatabaseBackupDir())
+	if err != nil {
+		return "", errors.Errorf("failed to get path for Database.Backup.Dir (%s) - please set it to a valid directory path", config.DatabaseBackupDir())
+	}
+	return dir, nil
+}
+
 // Start starts DatabaseBackup.
 func (backup *databaseBackup) Start(context.Context) error {
 	return backup.StartOnce("DatabaseBackup", func() (err error) {
@@ -140,7 +192,7 @@ func (backup *databaseBackup) frequencyIsTooSmall() bool {
 }
 
 func (backup *databaseBackup) RunBackup(version string) error {
-	backup.logger.Debugw("Starting backup", "mode", backup.mode, "directory", backup.outputParentDir)
+	backup.logger.Debugw("Starting backup", "mode", backup.mode, "destination", backup.destination)
 	startAt := time.Now()
 	result, err := backup.runBackup(version)
 	duration := time.Since(startAt)
@@ -150,6 +202,10 @@ func (backup *databaseBackup) RunBackup(version string) error {
 		return err
 	}
 	backup.logger.Infow("Backup completed successfully.", "duration", duration, "fileSize", result.size, "filePath", result.path)
+
+	if err = backup.retention.apply(backup.destination, time.Now(), backup.logger); err != nil {
+		backup.logger.Errorw("Failed to delete expired backups", "err", err)
+	}
 	return nil
 }
 
@@ -209,26 +265,82 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 		return partialResult, errors.Wrap(err, "pg_dump failed")
 	}
 
+	defer os.Remove(tmpFile.Name())
+
 	if version == "" {
 		version = "unknown"
 	}
-	finalFilePath := filepath.Join(backup.outputParentDir, fmt.Sprintf(filePattern, version))
-	_ = os.Remove(finalFilePath)
-	err = os.Rename(tmpFile.Name(), finalFilePath)
-	if err != nil {
-		_ = os.Remove(tmpFile.Name())
-		return nil, errors.Wrap(err, "Failed to rename the temp file to the final backup file")
+	createdAt := time.Now().UTC()
+	b := Backup{
+		Name:      fmt.Sprintf(filePattern, version, createdAt.Format(timestampFormat)),
+		Version:   version,
+		Mode:      backup.mode,
+		CreatedAt: createdAt,
 	}
 
-	file, err := os.Stat(finalFilePath)
+	path := tmpFile.Name()
+	if backup.encrypt {
+		path, err = backup.encryptDump(tmpFile.Name())
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(path)
+		b.Name += encryptedSuffix
+		b.Encrypted = true
+	}
+
+	b.Size, b.SHA256, err = checksum(path)
 	if err != nil {
-		return nil, errors.Wrap(err, "Failed to access the final backup file")
+		return nil, err
+	}
+	if err = backup.destination.Store(b, path); err != nil {
+		return nil, errors.Wrapf(err, "Failed to store the backup in %s", backup.destination)
 	}
 
 	return &backupResult{
-		size:            file.Size(),
-		path:            finalFilePath,
+		size:            b.Size,
+		path:            filepath.Join(backup.destination.String(), b.Name),
 		maskedArguments: maskedArgs,
 		pgDumpArguments: args,
 	}, nil
 }
+
+// encryptDump encrypts the dump at path into a new temp file and returns its
+// path.
+func (backup *databaseBackup) encryptDump(path string) (string, error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to open the dump for encryption")
+	}
+	defer src.Close()
+
+	dst, err := os.CreateTemp(backup.outputParentDir, "cl_backup_tmp_")
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to create a tmp file")
+	}
+
+	err = encrypt(dst, src, backup.password, backup.scryptParams)
+	err = multierr.Append(err, dst.Close())
+	if err != nil {
+		_ = os.Remove(dst.Name())
+		return "", errors.Wrap(err, "Failed to encrypt the dump")
+	}
+	return dst.Name(), nil
+}
+
+// checksum returns the size and hex encoded SHA256 checksum of the file at
+// path.
+func checksum(path string) (size int64, sum string, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, "", errors.Wrap(err, "Failed to open the backup file")
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	size, err = io.Copy(h, f)
+	if err != nil {
+		return 0, "", errors.Wrap(err, "Failed to read the backup file")
+	}
+	return size, hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -4,6 +4,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -118,16 +119,49 @@ func TestPeriodicBackup_AlternativeOutputDir(t *testing.T) {
 	require.NoError(t, err, "error not nil when checking for output file")
 
 	assert.Greater(t, file.Size(), int64(0))
-	assert.Contains(t, result.path, "/alternative/cl_backup_0.9.9.dump")
+	assert.Contains(t, result.path, "/alternative/cl_backup_0.9.9_")
+	assert.True(t, strings.HasSuffix(result.path, ".dump"))
 
 }
 
+func TestPeriodicBackup_RunEncryptedBackup(t *testing.T) {
+	backupConfig := newTestConfig(time.Minute, nil, must(t, string(v2.EnvDatabaseURL.Get())), os.TempDir(),
+		filepath.Join(t.TempDir(), "encrypted"), config.DatabaseBackupModeLite)
+	backupConfig.databaseBackupEncrypt = true
+	backupConfig.keystorePassword = "p4SsW0rD1!@#_"
+	periodicBackup := mustNewDatabaseBackup(t, backupConfig)
+
+	result, err := periodicBackup.runBackup("0.9.9")
+	require.NoError(t, err, "error not nil for backup")
+	assert.True(t, strings.HasSuffix(result.path, ".dump.enc"))
+
+	b, err := FindBackup(periodicBackup.destination, "")
+	require.NoError(t, err)
+	assert.True(t, b.Encrypted)
+	assert.Equal(t, result.size, b.Size)
+
+	require.NoError(t, Validate(periodicBackup.destination, b, backupConfig.keystorePassword))
+	require.Error(t, Validate(periodicBackup.destination, b, "wrong password"))
+}
+
+func TestPeriodicBackup_EncryptRequiresPassword(t *testing.T) {
+	backupConfig := newTestConfig(time.Minute, nil, must(t, "postgresql://localhost/db"), os.TempDir(), "", config.DatabaseBackupModeLite)
+	backupConfig.databaseBackupEncrypt = true
+
+	_, err := NewDatabaseBackup(backupConfig, logger.TestLogger(t))
+	require.ErrorContains(t, err, "Database.Backup.Encrypt requires the keystore password")
+}
+
 type testConfig struct {
 	databaseBackupFrequency time.Duration
 	databaseBackupMode      config.DatabaseBackupMode
 	databaseBackupURL       *url.URL
 	databaseBackupDir       string
+	databaseBackupMaxCount  uint32
+	databaseBackupMaxAge    time.Duration
+	databaseBackupEncrypt   bool
 	databaseURL             url.URL
+	keystorePassword        string
 	rootDir                 string
 }
 
@@ -143,6 +177,21 @@ func (config testConfig) DatabaseBackupURL() *url.URL {
 func (config testConfig) DatabaseBackupDir() string {
 	return config.databaseBackupDir
 }
+func (config testConfig) DatabaseBackupMaxCount() uint32 {
+	return config.databaseBackupMaxCount
+}
+func (config testConfig) DatabaseBackupMaxAge() time.Duration {
+	return config.databaseBackupMaxAge
+}
+func (config testConfig) DatabaseBackupEncrypt() bool {
+	return config.databaseBackupEncrypt
+}
+func (config testConfig) KeystorePassword() string {
+	return config.keystorePassword
+}
+func (config testConfig) InsecureFastScrypt() bool {
+	return true
+}
 func (config testConfig) DatabaseURL() url.URL {
 	return config.databaseURL
 }
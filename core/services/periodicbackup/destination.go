@@ -0,0 +1,169 @@
+package periodicbackup
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+const manifestFileName = "manifest.json"
+
+type (
+	// Backup describes a database dump held by a BackupDestination.
+	Backup struct {
+		Name      string                    `json:"name"`
+		Version   string                    `json:"version"`
+		Mode      config.DatabaseBackupMode `json:"mode"`
+		CreatedAt time.Time                 `json:"createdAt"`
+		Size      int64                     `json:"size"`
+		// SHA256 is the hex encoded checksum of the stored file, which is the
+		// ciphertext for encrypted backups.
+		SHA256    string `json:"sha256"`
+		Encrypted bool   `json:"encrypted"`
+	}
+
+	// BackupDestination stores database dumps, along with a manifest of the
+	// backups it holds.
+	BackupDestination interface {
+		// Store moves the dump at path into the destination and records b in
+		// the manifest.
+		Store(b Backup, path string) error
+		// List returns the backups in the manifest, oldest first.
+		List() ([]Backup, error)
+		// Open returns the stored dump of the named backup.
+		Open(name string) (io.ReadCloser, error)
+		// Delete removes the named backup and its manifest entry.
+		Delete(name string) error
+		// String describes the destination for logging.
+		String() string
+	}
+
+	manifest struct {
+		Backups []Backup `json:"backups"`
+	}
+
+	localDestination struct {
+		dir string
+		mu  sync.Mutex
+	}
+)
+
+var _ BackupDestination = (*localDestination)(nil)
+
+// NewLocalDestination returns a BackupDestination that stores backups in dir,
+// with the manifest kept alongside them in manifest.json.
+func NewLocalDestination(dir string) BackupDestination {
+	return &localDestination{dir: dir}
+}
+
+func (d *localDestination) String() string {
+	return d.dir
+}
+
+func (d *localDestination) Store(b Backup, path string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err := os.MkdirAll(d.dir, os.ModePerm); err != nil {
+		return errors.Wrapf(err, "Failed to create directories on the path: %s", d.dir)
+	}
+	m, err := d.readManifest()
+	if err != nil {
+		return err
+	}
+	if err = os.Rename(path, d.path(b.Name)); err != nil {
+		return errors.Wrapf(err, "failed to move backup to %s", d.path(b.Name))
+	}
+
+	backups := m.Backups[:0]
+	for _, existing := range m.Backups {
+		if existing.Name != b.Name {
+			backups = append(backups, existing)
+		}
+	}
+	m.Backups = append(backups, b)
+	return d.writeManifest(m)
+}
+
+func (d *localDestination) List() ([]Backup, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	m, err := d.readManifest()
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(m.Backups, func(i, j int) bool {
+		return m.Backups[i].CreatedAt.Before(m.Backups[j].CreatedAt)
+	})
+	return m.Backups, nil
+}
+
+func (d *localDestination) Open(name string) (io.ReadCloser, error) {
+	f, err := os.Open(d.path(name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open backup %s", name)
+	}
+	return f, nil
+}
+
+func (d *localDestination) Delete(name string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	m, err := d.readManifest()
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(d.path(name)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to delete backup %s", name)
+	}
+	backups := m.Backups[:0]
+	for _, b := range m.Backups {
+		if b.Name != name {
+			backups = append(backups, b)
+		}
+	}
+	m.Backups = backups
+	return d.writeManifest(m)
+}
+
+func (d *localDestination) path(name string) string {
+	return filepath.Join(d.dir, filepath.Base(name))
+}
+
+func (d *localDestination) readManifest() (m manifest, err error) {
+	b, err := os.ReadFile(filepath.Join(d.dir, manifestFileName))
+	if os.IsNotExist(err) {
+		return m, nil
+	} else if err != nil {
+		return m, errors.Wrap(err, "failed to read backup manifest")
+	}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return m, errors.Wrap(err, "failed to parse backup manifest")
+	}
+	return m, nil
+}
+
+// writeManifest replaces the manifest atomically, so that a crash never leaves
+// a partially written manifest behind.
+func (d *localDestination) writeManifest(m manifest) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to encode backup manifest")
+	}
+	tmp := filepath.Join(d.dir, fmt.Sprintf(".%s.tmp", manifestFileName))
+	if err = os.WriteFile(tmp, b, 0o600); err != nil {
+		return errors.Wrap(err, "failed to write backup manifest")
+	}
+	return errors.Wrap(os.Rename(tmp, filepath.Join(d.dir, manifestFileName)), "failed to write backup manifest")
+}
@@ -0,0 +1,148 @@
+package periodicbackup
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+// mustStore stores content in dest as a backup with the given name and
+// creation time.
+func mustStore(t *testing.T, dest BackupDestination, name string, createdAt time.Time, content []byte) Backup {
+	path := filepath.Join(t.TempDir(), "dump")
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+	sum := sha256.Sum256(content)
+	b := Backup{
+		Name:      name,
+		Version:   "2.0.0",
+		Mode:      config.DatabaseBackupModeFull,
+		CreatedAt: createdAt.UTC(),
+		Size:      int64(len(content)),
+		SHA256:    hex.EncodeToString(sum[:]),
+	}
+	require.NoError(t, dest.Store(b, path))
+	return b
+}
+
+func TestLocalDestination(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "backup")
+	dest := NewLocalDestination(dir)
+	now := time.Now()
+
+	_, err := FindBackup(dest, "")
+	require.ErrorContains(t, err, "no backups found")
+
+	b2 := mustStore(t, dest, "b2.dump", now, []byte("second"))
+	b1 := mustStore(t, dest, "b1.dump", now.Add(-time.Hour), []byte("first"))
+
+	t.Run("lists backups oldest first", func(t *testing.T) {
+		backups, err := dest.List()
+		require.NoError(t, err)
+		assert.Equal(t, []Backup{b1, b2}, backups)
+
+		// the manifest is read back from disk
+		backups, err = NewLocalDestination(dir).List()
+		require.NoError(t, err)
+		assert.Equal(t, []Backup{b1, b2}, backups)
+	})
+
+	t.Run("finds backups", func(t *testing.T) {
+		b, err := FindBackup(dest, "")
+		require.NoError(t, err)
+		assert.Equal(t, b2, b)
+
+		b, err = FindBackup(dest, "b1.dump")
+		require.NoError(t, err)
+		assert.Equal(t, b1, b)
+
+		_, err = FindBackup(dest, "missing.dump")
+		require.ErrorContains(t, err, "backup missing.dump not found")
+	})
+
+	t.Run("finds legacy backups by name", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "cl_backup_1.2.3.dump"), []byte("legacy"), 0o600))
+
+		b, err := FindBackup(dest, "cl_backup_1.2.3.dump")
+		require.NoError(t, err)
+		assert.Equal(t, Backup{Name: "cl_backup_1.2.3.dump", Version: "1.2.3"}, b)
+		assert.True(t, b.isLegacy())
+
+		var out bytes.Buffer
+		require.NoError(t, copyVerified(&out, bytes.NewReader([]byte("legacy")), b, ""))
+		assert.Equal(t, "legacy", out.String())
+
+		// legacy backups are never picked as the newest
+		b, err = FindBackup(dest, "")
+		require.NoError(t, err)
+		assert.Equal(t, b2, b)
+
+		_, err = FindBackup(dest, "cl_backup_1.2.3_20230101T000000Z.dump")
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("opens backups", func(t *testing.T) {
+		r, err := dest.Open("b1.dump")
+		require.NoError(t, err)
+		defer r.Close()
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "first", string(content))
+	})
+
+	t.Run("deletes backups", func(t *testing.T) {
+		require.NoError(t, dest.Delete("b1.dump"))
+
+		backups, err := dest.List()
+		require.NoError(t, err)
+		assert.Equal(t, []Backup{b2}, backups)
+		assert.NoFileExists(t, filepath.Join(dir, "b1.dump"))
+	})
+}
+
+func TestCopyVerified(t *testing.T) {
+	t.Parallel()
+
+	dest := NewLocalDestination(t.TempDir())
+	b := mustStore(t, dest, "b.dump", time.Now(), []byte("dump"))
+
+	t.Run("valid", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, copyVerified(&out, bytes.NewReader([]byte("dump")), b, ""))
+		assert.Equal(t, "dump", out.String())
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		err := copyVerified(io.Discard, bytes.NewReader([]byte("pump")), b, "")
+		require.ErrorContains(t, err, "checksum mismatch")
+	})
+
+	t.Run("size mismatch", func(t *testing.T) {
+		err := copyVerified(io.Discard, bytes.NewReader([]byte("dump!")), b, "")
+		require.ErrorContains(t, err, "size mismatch")
+	})
+
+	t.Run("encrypted", func(t *testing.T) {
+		encrypted := mustEncrypt(t, []byte("dump"), "password")
+		eb := mustStore(t, dest, "b.dump.enc", time.Now(), encrypted)
+		eb.Encrypted = true
+
+		var out bytes.Buffer
+		require.NoError(t, copyVerified(&out, bytes.NewReader(encrypted), eb, "password"))
+		assert.Equal(t, "dump", out.String())
+
+		err := copyVerified(io.Discard, bytes.NewReader(encrypted), eb, "")
+		require.ErrorContains(t, err, "no keystore password was provided")
+	})
+}
@@ -0,0 +1,176 @@
+package periodicbackup
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// Encrypted backups are split into chunks that are sealed independently with
+// AES-256-GCM, so that dumps of any size can be streamed. The file layout is:
+//
+//	magic | scrypt N (uint32) | scrypt P (uint32) | salt | nonce prefix | chunk...
+//
+// and every chunk is:
+//
+//	final flag (1 byte) | ciphertext length (uint32) | ciphertext
+//
+// The nonce of a chunk is the nonce prefix followed by the chunk index, and the
+// final flag is authenticated as additional data, so reordered, truncated or
+// extended files fail to decrypt.
+const (
+	encryptionMagic   = "CLBKENC1"
+	scryptR           = 8
+	keyLen            = 32
+	saltLen           = 32
+	noncePrefixLen    = 4
+	encryptChunkSize  = 64 * 1024
+	maxCiphertextSize = encryptChunkSize + 16 // chunk plus GCM tag
+)
+
+var errWrongPasswordOrCorrupted = errors.New("failed to decrypt backup: wrong password or corrupted file")
+
+// encrypt reads the plaintext dump from src and writes it encrypted with a key
+// derived from password to dst.
+func encrypt(dst io.Writer, src io.Reader, password string, params utils.ScryptParams) error {
+	if password == "" {
+		return errors.New("cannot encrypt backup with an empty password")
+	}
+	header := make([]byte, len(encryptionMagic)+8+saltLen+noncePrefixLen)
+	copy(header, encryptionMagic)
+	binary.BigEndian.PutUint32(header[len(encryptionMagic):], uint32(params.N))
+	binary.BigEndian.PutUint32(header[len(encryptionMagic)+4:], uint32(params.P))
+	saltAndPrefix := header[len(encryptionMagic)+8:]
+	if _, err := rand.Read(saltAndPrefix); err != nil {
+		return errors.Wrap(err, "failed to generate salt")
+	}
+	salt, noncePrefix := saltAndPrefix[:saltLen], saltAndPrefix[saltLen:]
+
+	aead, err := newAEAD(password, salt, params)
+	if err != nil {
+		return err
+	}
+	if _, err = dst.Write(header); err != nil {
+		return errors.Wrap(err, "failed to write header")
+	}
+
+	r := bufio.NewReaderSize(src, encryptChunkSize)
+	buf := make([]byte, encryptChunkSize)
+	for index := uint64(0); ; index++ {
+		n, err := io.ReadFull(r, buf)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return errors.Wrap(err, "failed to read dump")
+		}
+		final := err != nil
+		if !final {
+			// a full chunk is only the last one if nothing follows it
+			if _, perr := r.Peek(1); errors.Is(perr, io.EOF) {
+				final = true
+			}
+		}
+
+		prefix := chunkPrefix(final)
+		ciphertext := aead.Seal(nil, chunkNonce(noncePrefix, index), buf[:n], prefix[:1])
+		binary.BigEndian.PutUint32(prefix[1:], uint32(len(ciphertext)))
+		if _, err = dst.Write(prefix); err != nil {
+			return errors.Wrap(err, "failed to write chunk")
+		}
+		if _, err = dst.Write(ciphertext); err != nil {
+			return errors.Wrap(err, "failed to write chunk")
+		}
+		if final {
+			return nil
+		}
+	}
+}
+
+// decrypt reads a dump encrypted by encrypt from src and writes the plaintext
+// to dst.
+func decrypt(dst io.Writer, src io.Reader, password string) error {
+	header := make([]byte, len(encryptionMagic)+8+saltLen+noncePrefixLen)
+	if _, err := io.ReadFull(src, header); err != nil {
+		return errors.Wrap(err, "failed to read header")
+	}
+	if !bytes.Equal(header[:len(encryptionMagic)], []byte(encryptionMagic)) {
+		return errors.New("not an encrypted backup")
+	}
+	params := utils.ScryptParams{
+		N: int(binary.BigEndian.Uint32(header[len(encryptionMagic):])),
+		P: int(binary.BigEndian.Uint32(header[len(encryptionMagic)+4:])),
+	}
+	salt := header[len(encryptionMagic)+8 : len(encryptionMagic)+8+saltLen]
+	noncePrefix := header[len(encryptionMagic)+8+saltLen:]
+
+	aead, err := newAEAD(password, salt, params)
+	if err != nil {
+		return err
+	}
+
+	prefix := make([]byte, 5)
+	ciphertext := make([]byte, maxCiphertextSize)
+	for index := uint64(0); ; index++ {
+		if _, err = io.ReadFull(src, prefix); err != nil {
+			return errors.Wrap(err, "failed to read chunk: backup is truncated")
+		}
+		size := binary.BigEndian.Uint32(prefix[1:])
+		if size > maxCiphertextSize {
+			return errWrongPasswordOrCorrupted
+		}
+		if _, err = io.ReadFull(src, ciphertext[:size]); err != nil {
+			return errors.Wrap(err, "failed to read chunk: backup is truncated")
+		}
+		plaintext, err := aead.Open(nil, chunkNonce(noncePrefix, index), ciphertext[:size], prefix[:1])
+		if err != nil {
+			return errWrongPasswordOrCorrupted
+		}
+		if _, err = dst.Write(plaintext); err != nil {
+			return errors.Wrap(err, "failed to write dump")
+		}
+		if prefix[0] == 1 {
+			break
+		}
+	}
+
+	if _, err = io.ReadFull(src, prefix[:1]); err == nil {
+		return errors.New("failed to decrypt backup: unexpected data after the final chunk")
+	}
+	return nil
+}
+
+func newAEAD(password string, salt []byte, params utils.ScryptParams) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(password), salt, params.N, scryptR, params.P, keyLen)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive backup encryption key")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkPrefix returns a chunk prefix with the final flag set, leaving room for
+// the ciphertext length.
+func chunkPrefix(final bool) []byte {
+	prefix := make([]byte, 5)
+	if final {
+		prefix[0] = 1
+	}
+	return prefix
+}
+
+func chunkNonce(prefix []byte, index uint64) []byte {
+	nonce := make([]byte, noncePrefixLen+8)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint64(nonce[noncePrefixLen:], index)
+	return nonce
+}
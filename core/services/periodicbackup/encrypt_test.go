@@ -0,0 +1,79 @@
+package periodicbackup
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func mustEncrypt(t *testing.T, plaintext []byte, password string) []byte {
+	var encrypted bytes.Buffer
+	require.NoError(t, encrypt(&encrypted, bytes.NewReader(plaintext), password, utils.FastScryptParams))
+	return encrypted.Bytes()
+}
+
+func TestEncrypt_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, size := range []int{0, 1, encryptChunkSize - 1, encryptChunkSize, 3*encryptChunkSize + 7} {
+		plaintext := make([]byte, size)
+		_, err := rand.Read(plaintext)
+		require.NoError(t, err)
+
+		encrypted := mustEncrypt(t, plaintext, "password")
+		if size > 16 {
+			assert.False(t, bytes.Contains(encrypted, plaintext[:16]), "size %d", size)
+		}
+
+		var decrypted bytes.Buffer
+		require.NoError(t, decrypt(&decrypted, bytes.NewReader(encrypted), "password"), "size %d", size)
+		assert.True(t, bytes.Equal(plaintext, decrypted.Bytes()), "size %d", size)
+	}
+}
+
+func TestEncrypt_Errors(t *testing.T) {
+	t.Parallel()
+
+	plaintext := bytes.Repeat([]byte("chainlink"), encryptChunkSize/4)
+	encrypted := mustEncrypt(t, plaintext, "password")
+
+	t.Run("empty password", func(t *testing.T) {
+		err := encrypt(&bytes.Buffer{}, bytes.NewReader(plaintext), "", utils.FastScryptParams)
+		require.EqualError(t, err, "cannot encrypt backup with an empty password")
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		err := decrypt(&bytes.Buffer{}, bytes.NewReader(encrypted), "wrong")
+		require.ErrorIs(t, err, errWrongPasswordOrCorrupted)
+	})
+
+	t.Run("not encrypted", func(t *testing.T) {
+		err := decrypt(&bytes.Buffer{}, bytes.NewReader(plaintext), "password")
+		require.EqualError(t, err, "not an encrypted backup")
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		corrupted := bytes.Clone(encrypted)
+		corrupted[len(corrupted)-1] ^= 0xff
+		err := decrypt(&bytes.Buffer{}, bytes.NewReader(corrupted), "password")
+		require.ErrorIs(t, err, errWrongPasswordOrCorrupted)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		// drop the final chunk
+		truncated := encrypted[:len(encryptionMagic)+8+saltLen+noncePrefixLen+5+maxCiphertextSize]
+		err := decrypt(&bytes.Buffer{}, bytes.NewReader(truncated), "password")
+		require.ErrorContains(t, err, "backup is truncated")
+	})
+
+	t.Run("extended", func(t *testing.T) {
+		extended := append(bytes.Clone(encrypted), 0)
+		err := decrypt(&bytes.Buffer{}, bytes.NewReader(extended), "password")
+		require.ErrorContains(t, err, "unexpected data after the final chunk")
+	})
+}
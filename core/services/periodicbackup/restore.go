@@ -0,0 +1,182 @@
+package periodicbackup
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// legacyBackupRegexp matches the names of the dumps written before backups
+// were recorded in the manifest: cl_backup_<version>.dump.
+var legacyBackupRegexp = regexp.MustCompile(`^cl_backup_([^_]*)\.dump$`)
+
+// FindBackup returns the named backup from dest, or the newest one in the
+// manifest if name is empty. Legacy dumps named cl_backup_<version>.dump,
+// which have no manifest entry, can only be found by name.
+func FindBackup(dest BackupDestination, name string) (Backup, error) {
+	backups, err := dest.List()
+	if err != nil {
+		return Backup{}, err
+	}
+	if name == "" {
+		if len(backups) == 0 {
+			return Backup{}, errors.Errorf("no backups found in %s", dest)
+		}
+		return backups[len(backups)-1], nil
+	}
+	for _, b := range backups {
+		if b.Name == name {
+			return b, nil
+		}
+	}
+	if m := legacyBackupRegexp.FindStringSubmatch(name); m != nil {
+		// only the version is known: legacy dumps were never encrypted, and
+		// their mode, size and checksum weren't recorded
+		return Backup{Name: name, Version: m[1]}, nil
+	}
+	return Backup{}, errors.Errorf("backup %s not found in %s", name, dest)
+}
+
+// isLegacy reports whether b is a legacy dump without a manifest entry, whose
+// integrity can't be verified.
+func (b Backup) isLegacy() bool {
+	return b.SHA256 == ""
+}
+
+// Validate checks that backup b in dest matches its manifest checksum, can be
+// decrypted with password, and is a dump that pg_restore can read. Legacy
+// dumps have no checksum, so only the last check applies to them.
+func Validate(dest BackupDestination, b Backup, password string) error {
+	path, err := extract(dest, b, password)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(path)
+	return listDump(path)
+}
+
+// Restore validates backup b in dest and restores it into the database at
+// dbURL with pg_restore. Existing objects in the database are dropped before
+// they are recreated, and the restore runs in a single transaction, so the
+// database is left untouched if it fails.
+func Restore(dest BackupDestination, b Backup, dbURL url.URL, password string, lggr logger.Logger) error {
+	path, err := extract(dest, b, password)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(path)
+	if err = listDump(path); err != nil {
+		return err
+	}
+
+	args := []string{
+		"--clean",
+		"--if-exists",
+		"--no-owner",
+		"--single-transaction",
+		"-d", dbURL.String(),
+		path,
+	}
+	lggr.Debugw("Running pg_restore", "backup", b.Name, "database", dbURL.Redacted())
+	if _, err = exec.Command("pg_restore", args...).Output(); err != nil {
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			return errors.Wrapf(err, "pg_restore failed with output: %s", string(ee.Stderr))
+		}
+		return errors.Wrap(err, "pg_restore failed")
+	}
+	return nil
+}
+
+// extract verifies the checksum of backup b while copying it out of dest into
+// a temp file, decrypting it if needed. It returns the path of the plaintext
+// dump, which the caller must remove.
+func extract(dest BackupDestination, b Backup, password string) (path string, err error) {
+	src, err := dest.Open(b.Name)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	dst, err := os.CreateTemp("", "cl_restore_")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create a tmp file")
+	}
+	defer func() {
+		err = multierr.Append(err, dst.Close())
+		if err != nil {
+			_ = os.Remove(dst.Name())
+		}
+	}()
+
+	if err = copyVerified(dst, src, b, password); err != nil {
+		return "", errors.Wrapf(err, "backup %s is invalid", b.Name)
+	}
+	return dst.Name(), nil
+}
+
+// copyVerified copies the dump of b from src to dst, decrypting it if needed,
+// and returns an error if src does not match the checksum of b. Legacy dumps
+// are copied as is.
+func copyVerified(dst io.Writer, src io.Reader, b Backup, password string) error {
+	if b.isLegacy() {
+		_, err := io.Copy(dst, src)
+		return err
+	}
+	h := sha256.New()
+	counter := &countingReader{r: io.TeeReader(src, h)}
+
+	var err error
+	if b.Encrypted {
+		if password == "" {
+			return errors.New("backup is encrypted, but no keystore password was provided")
+		}
+		err = decrypt(dst, counter, password)
+	} else {
+		_, err = io.Copy(dst, counter)
+	}
+	if err != nil {
+		return err
+	}
+
+	if counter.n != b.Size {
+		return errors.Errorf("size mismatch: expected %d bytes, got %d", b.Size, counter.n)
+	}
+	if sum := hex.EncodeToString(h.Sum(nil)); sum != b.SHA256 {
+		return errors.Errorf("checksum mismatch: expected %s, got %s", b.SHA256, sum)
+	}
+	return nil
+}
+
+// listDump checks that pg_restore can read the table of contents of the dump
+// at path.
+func listDump(path string) error {
+	if _, err := exec.Command("pg_restore", "--list", path).Output(); err != nil {
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			return errors.Wrapf(err, "pg_restore could not read the dump: %s", string(ee.Stderr))
+		}
+		return errors.Wrap(err, "pg_restore could not read the dump")
+	}
+	return nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
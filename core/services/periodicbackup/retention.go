@@ -0,0 +1,54 @@
+package periodicbackup
+
+import (
+	"time"
+
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// RetentionPolicy limits the backups kept by a BackupDestination. Backups
+// beyond the newest MaxCount, or older than MaxAge, are deleted. A zero value
+// disables the respective limit.
+//
+// The newest backup of every node version is always kept, so that the backup
+// taken right before an upgrade can be used to roll back to an older version.
+type RetentionPolicy struct {
+	MaxCount int
+	MaxAge   time.Duration
+}
+
+// expired returns the backups that should be deleted. backups must be sorted
+// oldest first.
+func (p RetentionPolicy) expired(backups []Backup, now time.Time) (expired []Backup) {
+	newestOfVersion := make(map[string]string)
+	for _, b := range backups {
+		newestOfVersion[b.Version] = b.Name
+	}
+
+	for i, b := range backups {
+		if newestOfVersion[b.Version] == b.Name {
+			continue
+		}
+		tooMany := p.MaxCount > 0 && len(backups)-i > p.MaxCount
+		tooOld := p.MaxAge > 0 && now.Sub(b.CreatedAt) > p.MaxAge
+		if tooMany || tooOld {
+			expired = append(expired, b)
+		}
+	}
+	return
+}
+
+// apply deletes the backups in dest that are expired according to p.
+func (p RetentionPolicy) apply(dest BackupDestination, now time.Time, lggr logger.Logger) (err error) {
+	backups, err := dest.List()
+	if err != nil {
+		return err
+	}
+	for _, b := range p.expired(backups, now) {
+		lggr.Infow("Deleting expired backup", "name", b.Name, "version", b.Version, "createdAt", b.CreatedAt)
+		err = multierr.Append(err, dest.Delete(b.Name))
+	}
+	return err
+}
@@ -0,0 +1,64 @@
+package periodicbackup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestRetentionPolicy(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	backup := func(name, version string, age time.Duration) Backup {
+		return Backup{Name: name, Version: version, CreatedAt: now.Add(-age)}
+	}
+	backups := []Backup{
+		backup("a", "1.0.0", 5*time.Hour),
+		backup("b", "1.0.0", 4*time.Hour), // newest of 1.0.0
+		backup("c", "2.0.0", 3*time.Hour),
+		backup("d", "2.0.0", 2*time.Hour),
+		backup("e", "2.0.0", time.Hour), // newest of 2.0.0
+	}
+
+	names := func(backups []Backup) (names []string) {
+		for _, b := range backups {
+			names = append(names, b.Name)
+		}
+		return
+	}
+
+	for _, tt := range []struct {
+		name     string
+		policy   RetentionPolicy
+		expected []string
+	}{
+		{"unlimited", RetentionPolicy{}, nil},
+		{"max count", RetentionPolicy{MaxCount: 2}, []string{"a", "c"}},
+		{"max count keeps newest of each version", RetentionPolicy{MaxCount: 1}, []string{"a", "c", "d"}},
+		{"max age", RetentionPolicy{MaxAge: 150 * time.Minute}, []string{"a", "c"}},
+		{"max count and age", RetentionPolicy{MaxCount: 4, MaxAge: 4*time.Hour + time.Minute}, []string{"a"}},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, names(tt.policy.expired(backups, now)))
+		})
+	}
+
+	t.Run("apply", func(t *testing.T) {
+		dest := NewLocalDestination(t.TempDir())
+		for _, b := range backups {
+			mustStore(t, dest, b.Name, b.CreatedAt, []byte(b.Name))
+		}
+		// mustStore stores every backup as the same version
+		require.NoError(t, RetentionPolicy{MaxCount: 2}.apply(dest, now, logger.TestLogger(t)))
+
+		remaining, err := dest.List()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"d", "e"}, names(remaining))
+	})
+}
@@ -0,0 +1,230 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promCircuitBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_task_circuit_breaker_state",
+		Help: "State of the circuit breaker for an http or bridge endpoint: 0 = closed, 1 = open, 2 = half-open",
+	},
+		[]string{"task_type", "endpoint"},
+	)
+	promCircuitBreakerRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_circuit_breaker_rejections_total",
+		Help: "Number of task runs short-circuited because the circuit breaker for the endpoint was open",
+	},
+		[]string{"task_type", "endpoint"},
+	)
+)
+
+// ErrCircuitBreakerOpen is returned by http and bridge tasks that were not
+// attempted because the endpoint has been failing for every run.
+var ErrCircuitBreakerOpen = errors.New("circuit breaker open")
+
+type CircuitBreakerState int
+
+const (
+	CircuitBreakerClosed CircuitBreakerState = iota
+	CircuitBreakerOpen
+	CircuitBreakerHalfOpen
+)
+
+func (s CircuitBreakerState) String() string {
+	switch s {
+	case CircuitBreakerClosed:
+		return "closed"
+	case CircuitBreakerOpen:
+		return "open"
+	case CircuitBreakerHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("CircuitBreakerState(%d)", s)
+	}
+}
+
+type circuitBreaker struct {
+	state               CircuitBreakerState
+	consecutiveFailures uint32
+	openedAt            time.Time
+	// trialInFlight is set while the single request allowed through a
+	// half-open breaker is running
+	trialInFlight bool
+}
+
+// circuitBreakers tracks the health of the endpoints called by http and
+// bridge tasks. It is shared by all runs of a runner, so that an endpoint
+// which fails for every job is only hammered by one trial request per
+// cooldown instead of by every run.
+//
+// A breaker opens after failureThreshold consecutive retryable failures and
+// short-circuits every request until cooldown has elapsed. It then lets a
+// single trial request through (half-open): if it succeeds the breaker
+// closes, otherwise it opens again for another cooldown.
+//
+// A nil *circuitBreakers or a zero failureThreshold allows every request.
+type circuitBreakers struct {
+	failureThreshold uint32
+	cooldown         time.Duration
+	now              func() time.Time
+
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+func newCircuitBreakers(failureThreshold uint32, cooldown time.Duration) *circuitBreakers {
+	return &circuitBreakers{
+		failureThreshold: failureThreshold,
+		cooldown:         cooldown,
+		now:              time.Now,
+		breakers:         make(map[string]*circuitBreaker),
+	}
+}
+
+func (c *circuitBreakers) enabled() bool {
+	return c != nil && c.failureThreshold > 0
+}
+
+// allow returns an error wrapping ErrCircuitBreakerOpen if a request to
+// endpoint must not be made. Every call that returns nil must be followed by
+// a call to record with the outcome of the request, or to release.
+func (c *circuitBreakers) allow(taskType TaskType, endpoint string) error {
+	if !c.enabled() {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.breakers[breakerKey(taskType, endpoint)]
+	if !ok {
+		return nil
+	}
+	switch b.state {
+	case CircuitBreakerClosed:
+		return nil
+	case CircuitBreakerOpen:
+		retryAt := b.openedAt.Add(c.cooldown)
+		if c.now().Before(retryAt) {
+			promCircuitBreakerRejections.WithLabelValues(string(taskType), endpoint).Inc()
+			return errors.Wrapf(ErrCircuitBreakerOpen, "%s %s failed %d consecutive times, next attempt allowed after %s",
+				taskType, endpoint, b.consecutiveFailures, retryAt.UTC().Format(time.RFC3339))
+		}
+		c.setState(b, taskType, endpoint, CircuitBreakerHalfOpen)
+		b.trialInFlight = true
+		return nil
+	case CircuitBreakerHalfOpen:
+		if b.trialInFlight {
+			promCircuitBreakerRejections.WithLabelValues(string(taskType), endpoint).Inc()
+			return errors.Wrapf(ErrCircuitBreakerOpen, "%s %s is half-open and a trial request is already in flight", taskType, endpoint)
+		}
+		b.trialInFlight = true
+		return nil
+	}
+	return nil
+}
+
+// record updates the breaker for endpoint with the outcome of a request.
+// failed should only be true for errors that indicate the endpoint itself is
+// unhealthy, i.e. those that are worth retrying.
+func (c *circuitBreakers) record(taskType TaskType, endpoint string, failed bool) {
+	if !c.enabled() {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := breakerKey(taskType, endpoint)
+	b, ok := c.breakers[key]
+	if !ok {
+		if !failed {
+			return
+		}
+		b = &circuitBreaker{}
+		c.breakers[key] = b
+	}
+	b.trialInFlight = false
+	if !failed {
+		b.consecutiveFailures = 0
+		c.setState(b, taskType, endpoint, CircuitBreakerClosed)
+		return
+	}
+	b.consecutiveFailures++
+	if b.state == CircuitBreakerHalfOpen || b.consecutiveFailures >= c.failureThreshold {
+		b.openedAt = c.now()
+		c.setState(b, taskType, endpoint, CircuitBreakerOpen)
+	}
+}
+
+// recordHTTPRequest records the outcome of an http request made with ctx, or
+// only releases the breaker if the request was cut short because ctx was
+// cancelled or timed out, e.g. on shutdown or when the run times out, which
+// says nothing about the endpoint.
+func (c *circuitBreakers) recordHTTPRequest(ctx context.Context, taskType TaskType, endpoint string, statusCode int, err error) {
+	if err != nil && ctx.Err() != nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+		c.release(taskType, endpoint)
+		return
+	}
+	c.record(taskType, endpoint, err != nil && isRetryableHTTPError(statusCode, err))
+}
+
+// release ends a request allowed by allow without recording its outcome.
+func (c *circuitBreakers) release(taskType TaskType, endpoint string) {
+	if !c.enabled() {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if b, ok := c.breakers[breakerKey(taskType, endpoint)]; ok {
+		b.trialInFlight = false
+	}
+}
+
+// state returns the current state of the breaker for endpoint.
+func (c *circuitBreakers) state(taskType TaskType, endpoint string) CircuitBreakerState {
+	if !c.enabled() {
+		return CircuitBreakerClosed
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if b, ok := c.breakers[breakerKey(taskType, endpoint)]; ok {
+		return b.state
+	}
+	return CircuitBreakerClosed
+}
+
+// runInfoState returns the state of the breaker for endpoint to report in
+// the RunInfo of a task, or nil if circuit breaking is disabled.
+func (c *circuitBreakers) runInfoState(taskType TaskType, endpoint string) *CircuitBreakerState {
+	if !c.enabled() {
+		return nil
+	}
+	state := c.state(taskType, endpoint)
+	return &state
+}
+
+func (c *circuitBreakers) setState(b *circuitBreaker, taskType TaskType, endpoint string, state CircuitBreakerState) {
+	b.state = state
+	promCircuitBreakerState.WithLabelValues(string(taskType), endpoint).Set(float64(state))
+}
+
+func breakerKey(taskType TaskType, endpoint string) string {
+	return string(taskType) + "|" + endpoint
+}
+
+// httpEndpoint identifies the endpoint a URL points to for the purposes of
+// circuit breaking. Query, fragment and credentials are dropped so that jobs
+// requesting different resources from the same failing endpoint share a
+// breaker, and secrets don't end up in metric labels.
+func httpEndpoint(u URLParam) string {
+	return (&url.URL{Scheme: u.Scheme, Host: u.Host, Path: u.Path}).String()
+}
@@ -67,6 +67,8 @@ type (
 		DefaultHTTPLimit() int64
 		DefaultHTTPTimeout() models.Duration
 		TriggerFallbackDBPollInterval() time.Duration
+		JobPipelineCircuitBreakerCooldown() time.Duration
+		JobPipelineCircuitBreakerFailureThreshold() uint32
 		JobPipelineMaxRunDuration() time.Duration
 		JobPipelineReaperInterval() time.Duration
 		JobPipelineReaperThreshold() time.Duration
@@ -102,6 +104,10 @@ const (
 type RunInfo struct {
 	IsRetryable bool
 	IsPending   bool
+	// CircuitBreakerState is the state of the circuit breaker of the endpoint
+	// called by an http or bridge task after it ran, or nil if the task has
+	// no circuit breaker
+	CircuitBreakerState *CircuitBreakerState
 }
 
 // retryableMeta should be returned if the error is non-deterministic; i.e. a
@@ -200,6 +206,9 @@ type TaskRunResult struct {
 	Attempts   uint
 	CreatedAt  time.Time
 	FinishedAt null.Time
+	// CircuitBreakerState is the state of the circuit breaker of the endpoint
+	// called by an http or bridge task, if any. It is never persisted.
+	CircuitBreakerState *CircuitBreakerState
 	// runInfo is never persisted
 	runInfo RunInfo
 }
@@ -380,6 +389,7 @@ const (
 	TaskTypeLessThan         TaskType = "lessthan"
 	TaskTypeLookup           TaskType = "lookup"
 	TaskTypeLowercase        TaskType = "lowercase"
+	TaskTypeMap              TaskType = "map"
 	TaskTypeMean             TaskType = "mean"
 	TaskTypeMedian           TaskType = "median"
 	TaskTypeMerge            TaskType = "merge"
@@ -465,6 +475,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &FailTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMerge:
 		task = &MergeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMap:
+		task = &MapTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeLength:
 		task = &LengthTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeLessThan:
@@ -56,7 +56,7 @@ func makeHTTPRequest(
 	start := time.Now()
 	responseBytes, statusCode, respHeaders, err := httpRequest.SendRequest()
 	if ctx.Err() != nil {
-		return nil, 0, nil, 0, errors.New("http request timed out or interrupted")
+		return nil, 0, nil, 0, errors.Wrap(ctx.Err(), "http request timed out or interrupted")
 	}
 	if err != nil {
 		return nil, 0, nil, 0, errors.Wrapf(err, "error making http request")
@@ -208,6 +208,17 @@ func (p *Pipeline) ByDotID(id string) Task {
 	return nil
 }
 
+// isElementTaskRun returns true if dotID belongs to the task run of a map
+// task element, rather than to a task of the pipeline.
+func (p *Pipeline) isElementTaskRun(dotID string) bool {
+	prefix, _, found := strings.Cut(dotID, ".")
+	if !found {
+		return false
+	}
+	task := p.ByDotID(prefix)
+	return task != nil && task.Type() == TaskTypeMap
+}
+
 func Parse(text string) (*Pipeline, error) {
 	if strings.TrimSpace(text) == "" {
 		return nil, errors.New("empty pipeline")
@@ -271,5 +282,13 @@ func Parse(text string) (*Pipeline, error) {
 		ids[node.ID()] = id
 	}
 
+	for _, task := range p.Tasks {
+		if mapTask, ok := task.(*MapTask); ok {
+			if err := mapTask.validateTemplate(p); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return p, nil
 }
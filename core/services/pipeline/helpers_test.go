@@ -2,6 +2,7 @@ package pipeline
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -48,6 +49,10 @@ func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, un
 	t.unrestrictedHTTPClient = unrestrictedHTTPClient
 }
 
+func (t *HTTPTask) HelperSetCircuitBreakers(failureThreshold uint32, cooldown time.Duration) {
+	t.circuitBreakers = newCircuitBreakers(failureThreshold, cooldown)
+}
+
 func (t *ETHCallTask) HelperSetDependencies(cc evm.ChainSet, config Config, specGasLimit *uint32, jobType string) {
 	t.chainSet = cc
 	t.config = config
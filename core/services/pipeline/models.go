@@ -14,16 +14,18 @@ import (
 	"go.uber.org/multierr"
 	"gopkg.in/guregu/null.v4"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 type Spec struct {
-	ID                int32
-	DotDagSource      string          `json:"dotDagSource"`
-	CreatedAt         time.Time       `json:"-"`
-	MaxTaskDuration   models.Interval `json:"-"`
-	GasLimit          *uint32         `json:"-"`
-	ForwardingAllowed bool            `json:"-"`
+	ID                  int32
+	DotDagSource        string                `json:"dotDagSource"`
+	CreatedAt           time.Time             `json:"-"`
+	MaxTaskDuration     models.Interval       `json:"-"`
+	GasLimit            *uint32               `json:"-"`
+	ForwardingAllowed   bool                  `json:"-"`
+	TransactionPriority txmgrtypes.TxPriority `json:"-"`
 
 	JobID   int32  `json:"-"`
 	JobName string `json:"-"`
@@ -252,16 +254,17 @@ func (rr ResumeRequest) ToResult() (Result, error) {
 }
 
 type TaskRun struct {
-	ID            uuid.UUID        `json:"id"`
-	Type          TaskType         `json:"type"`
-	PipelineRun   Run              `json:"-"`
-	PipelineRunID int64            `json:"-"`
-	Output        JSONSerializable `json:"output"`
-	Error         null.String      `json:"error"`
-	CreatedAt     time.Time        `json:"createdAt"`
-	FinishedAt    null.Time        `json:"finishedAt"`
-	Index         int32            `json:"index"`
-	DotID         string           `json:"dotId"`
+	ID                  uuid.UUID        `json:"id"`
+	Type                TaskType         `json:"type"`
+	PipelineRun         Run              `json:"-"`
+	PipelineRunID       int64            `json:"-"`
+	Output              JSONSerializable `json:"output"`
+	Error               null.String      `json:"error"`
+	CreatedAt           time.Time        `json:"createdAt"`
+	FinishedAt          null.Time        `json:"finishedAt"`
+	Index               int32            `json:"index"`
+	DotID               string           `json:"dotId"`
+	CircuitBreakerState null.String      `json:"circuitBreakerState"`
 
 	// Used internally for sorting completed results
 	task Task
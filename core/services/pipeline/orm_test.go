@@ -164,12 +164,13 @@ func TestInsertFinishedRuns(t *testing.T) {
 
 		r.PipelineTaskRuns = []pipeline.TaskRun{
 			{
-				ID:            uuid.New(),
-				PipelineRunID: r.ID,
-				Type:          "bridge",
-				DotID:         "ds1",
-				CreatedAt:     now,
-				FinishedAt:    null.TimeFrom(now.Add(100 * time.Millisecond)),
+				ID:                  uuid.New(),
+				PipelineRunID:       r.ID,
+				Type:                "bridge",
+				DotID:               "ds1",
+				CreatedAt:           now,
+				FinishedAt:          null.TimeFrom(now.Add(100 * time.Millisecond)),
+				CircuitBreakerState: null.StringFrom(pipeline.CircuitBreakerOpen.String()),
 			},
 			{
 				ID:            uuid.New(),
@@ -194,6 +195,12 @@ func TestInsertFinishedRuns(t *testing.T) {
 	err = orm.InsertFinishedRuns(runs, true)
 	require.NoError(t, err)
 
+	var states []null.String
+	require.NoError(t, db.Select(&states, `SELECT circuit_breaker_state FROM pipeline_task_runs WHERE dot_id = 'ds1'`))
+	require.Len(t, states, 3)
+	for _, state := range states {
+		assert.Equal(t, null.StringFrom("open"), state)
+	}
 }
 
 // Tests that inserting run results, then later updating the run results via upsert will work correctly.
@@ -61,6 +61,7 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	circuitBreakers        *circuitBreakers
 
 	// test helper
 	runFinished func(*Run)
@@ -114,6 +115,7 @@ func NewRunner(orm ORM, btORM bridges.ORM, cfg Config, chainSet evm.ChainSet, et
 		lggr:                   lggr.Named("PipelineRunner"),
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		circuitBreakers:        newCircuitBreakers(cfg.JobPipelineCircuitBreakerFailureThreshold(), cfg.JobPipelineCircuitBreakerCooldown()),
 	}
 	r.runReaperWorker = utils.NewSleeperTask(
 		utils.SleeperFuncTask(r.runReaper, "PipelineRunnerReaper"),
@@ -241,46 +243,14 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 
 	// initialize certain task params
 	for _, task := range pipeline.Tasks {
-		task.Base().uuid = uuid.New()
-
-		switch task.Type() {
-		case TaskTypeHTTP:
-			task.(*HTTPTask).config = r.config
-			task.(*HTTPTask).httpClient = r.httpClient
-			task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
-		case TaskTypeBridge:
-			task.(*BridgeTask).config = r.config
-			task.(*BridgeTask).orm = r.btORM
-			task.(*BridgeTask).specId = run.PipelineSpec.ID
-			// URL is "safe" because it comes from the node's own database. We
-			// must use the unrestrictedHTTPClient because some node operators
-			// may run external adapters on their own hardware
-			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
-		case TaskTypeETHCall:
-			task.(*ETHCallTask).chainSet = r.chainSet
-			task.(*ETHCallTask).config = r.config
-			task.(*ETHCallTask).specGasLimit = run.PipelineSpec.GasLimit
-			task.(*ETHCallTask).jobType = run.PipelineSpec.JobType
-		case TaskTypeVRF:
-			task.(*VRFTask).keyStore = r.vrfKeyStore
-		case TaskTypeVRFV2:
-			task.(*VRFTaskV2).keyStore = r.vrfKeyStore
-		case TaskTypeEstimateGasLimit:
-			task.(*EstimateGasLimitTask).chainSet = r.chainSet
-			task.(*EstimateGasLimitTask).specGasLimit = run.PipelineSpec.GasLimit
-			task.(*EstimateGasLimitTask).jobType = run.PipelineSpec.JobType
-		case TaskTypeETHTx:
-			task.(*ETHTxTask).keyStore = r.ethKeyStore
-			task.(*ETHTxTask).chainSet = r.chainSet
-			task.(*ETHTxTask).specGasLimit = run.PipelineSpec.GasLimit
-			task.(*ETHTxTask).jobType = run.PipelineSpec.JobType
-			task.(*ETHTxTask).forwardingAllowed = run.PipelineSpec.ForwardingAllowed
-		default:
-		}
+		r.initializeTask(task, run.PipelineSpec)
 	}
 
 	// retain old UUID values
 	for _, taskRun := range run.PipelineTaskRuns {
+		if pipeline.isElementTaskRun(taskRun.DotID) {
+			continue
+		}
 		task := pipeline.ByDotID(taskRun.DotID)
 		if task != nil && task.Base() != nil {
 			task.Base().uuid = taskRun.ID
@@ -292,6 +262,54 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 	return pipeline, nil
 }
 
+// initializeTask sets the dependencies of task, which is part of a
+// pipeline for spec
+func (r *runner) initializeTask(task Task, spec Spec) {
+	task.Base().uuid = uuid.New()
+
+	switch task.Type() {
+	case TaskTypeHTTP:
+		task.(*HTTPTask).config = r.config
+		task.(*HTTPTask).httpClient = r.httpClient
+		task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
+		task.(*HTTPTask).circuitBreakers = r.circuitBreakers
+	case TaskTypeBridge:
+		task.(*BridgeTask).config = r.config
+		task.(*BridgeTask).orm = r.btORM
+		task.(*BridgeTask).specId = spec.ID
+		// URL is "safe" because it comes from the node's own database. We
+		// must use the unrestrictedHTTPClient because some node operators
+		// may run external adapters on their own hardware
+		task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+		task.(*BridgeTask).circuitBreakers = r.circuitBreakers
+	case TaskTypeETHCall:
+		task.(*ETHCallTask).chainSet = r.chainSet
+		task.(*ETHCallTask).config = r.config
+		task.(*ETHCallTask).specGasLimit = spec.GasLimit
+		task.(*ETHCallTask).jobType = spec.JobType
+	case TaskTypeVRF:
+		task.(*VRFTask).keyStore = r.vrfKeyStore
+	case TaskTypeVRFV2:
+		task.(*VRFTaskV2).keyStore = r.vrfKeyStore
+	case TaskTypeEstimateGasLimit:
+		task.(*EstimateGasLimitTask).chainSet = r.chainSet
+		task.(*EstimateGasLimitTask).specGasLimit = spec.GasLimit
+		task.(*EstimateGasLimitTask).jobType = spec.JobType
+	case TaskTypeETHTx:
+		task.(*ETHTxTask).keyStore = r.ethKeyStore
+		task.(*ETHTxTask).chainSet = r.chainSet
+		task.(*ETHTxTask).specGasLimit = spec.GasLimit
+		task.(*ETHTxTask).jobType = spec.JobType
+		task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
+		task.(*ETHTxTask).transactionPriority = spec.TransactionPriority
+	case TaskTypeMap:
+		task.(*MapTask).runSubPipeline = func(ctx context.Context, p *Pipeline, vars Vars, l logger.Logger) TaskRunResults {
+			return r.runSubPipeline(ctx, spec, p, vars, l)
+		}
+	default:
+	}
+}
+
 func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars, l logger.Logger) TaskRunResults {
 	l = l.With("jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	l.Debug("Initiating tasks for pipeline run of spec")
@@ -299,36 +317,13 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
-	// This is "just in case" for cleaning up any stray reports.
-	// Normally the scheduler loop doesn't stop until all in progress runs report back
-	reportCtx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
 	if pipelineTimeout := r.config.JobPipelineMaxRunDuration(); pipelineTimeout != 0 {
+		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, pipelineTimeout)
 		defer cancel()
 	}
 
-	for taskRun := range scheduler.taskCh {
-		taskRun := taskRun
-		// execute
-		go recovery.WrapRecoverHandle(l, func() {
-			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
-
-			logTaskRunToPrometheus(result, run.PipelineSpec)
-
-			scheduler.report(reportCtx, result)
-		}, func(err interface{}) {
-			t := time.Now()
-			scheduler.report(reportCtx, TaskRunResult{
-				ID:         uuid.New(),
-				Task:       taskRun.task,
-				Result:     Result{Error: ErrRunPanicked{err}},
-				FinishedAt: null.TimeFrom(t),
-				CreatedAt:  t, // TODO: more accurate start time
-			})
-		})
-	}
+	r.executeScheduledTasks(ctx, scheduler, run.PipelineSpec, l)
 
 	// if the run is suspended, awaiting resumption
 	run.Pending = scheduler.pending
@@ -349,17 +344,22 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	run.PipelineTaskRuns = nil
 	for _, result := range scheduler.results {
 		output := result.Result.OutputDB()
+		var breakerState null.String
+		if result.CircuitBreakerState != nil {
+			breakerState = null.StringFrom(result.CircuitBreakerState.String())
+		}
 		run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
-			ID:            result.ID,
-			PipelineRunID: run.ID,
-			Type:          result.Task.Type(),
-			Index:         result.Task.OutputIndex(),
-			Output:        output,
-			Error:         result.Result.ErrorDB(),
-			DotID:         result.Task.DotID(),
-			CreatedAt:     result.CreatedAt,
-			FinishedAt:    result.FinishedAt,
-			task:          result.Task,
+			ID:                  result.ID,
+			PipelineRunID:       run.ID,
+			Type:                result.Task.Type(),
+			Index:               result.Task.OutputIndex(),
+			Output:              output,
+			Error:               result.Result.ErrorDB(),
+			DotID:               result.Task.DotID(),
+			CreatedAt:           result.CreatedAt,
+			FinishedAt:          result.FinishedAt,
+			CircuitBreakerState: breakerState,
+			task:                result.Task,
 		})
 
 		sort.Slice(run.PipelineTaskRuns, func(i, j int) bool {
@@ -395,6 +395,18 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		}
 	}
 
+	// Persist the task runs of map task elements along with the run. They
+	// are appended last as they are not part of the pipeline itself, so
+	// must not be taken into account for the run outputs and errors.
+	for _, result := range scheduler.results {
+		if mapTask, ok := result.Task.(*MapTask); ok {
+			for _, taskRun := range mapTask.elementTaskRuns {
+				taskRun.PipelineRunID = run.ID
+				run.PipelineTaskRuns = append(run.PipelineTaskRuns, taskRun)
+			}
+		}
+	}
+
 	// TODO: drop this once we stop using TaskRunResults
 	var taskRunResults TaskRunResults
 	for _, result := range scheduler.results {
@@ -416,6 +428,58 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	return taskRunResults
 }
 
+// executeScheduledTasks executes the task runs handed out by scheduler until
+// it is done.
+func (r *runner) executeScheduledTasks(ctx context.Context, scheduler *scheduler, spec Spec, l logger.Logger) {
+	// This is "just in case" for cleaning up any stray reports.
+	// Normally the scheduler loop doesn't stop until all in progress runs report back
+	reportCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for taskRun := range scheduler.taskCh {
+		taskRun := taskRun
+		// execute
+		go recovery.WrapRecoverHandle(l, func() {
+			result := r.executeTaskRun(ctx, spec, taskRun, l)
+
+			logTaskRunToPrometheus(result, spec)
+
+			scheduler.report(reportCtx, result)
+		}, func(err interface{}) {
+			t := time.Now()
+			scheduler.report(reportCtx, TaskRunResult{
+				ID:         uuid.New(),
+				Task:       taskRun.task,
+				Result:     Result{Error: ErrRunPanicked{err}},
+				FinishedAt: null.TimeFrom(t),
+				CreatedAt:  t, // TODO: more accurate start time
+			})
+		})
+	}
+}
+
+// runSubPipeline executes a pipeline nested in a task of a run for spec,
+// such as the template of a map task, and returns the results of all its
+// tasks. The sub-pipeline shares ctx, and so the timeouts, of the task.
+func (r *runner) runSubPipeline(ctx context.Context, spec Spec, pipeline *Pipeline, vars Vars, l logger.Logger) TaskRunResults {
+	for _, task := range pipeline.Tasks {
+		r.initializeTask(task, spec)
+	}
+
+	scheduler := newScheduler(pipeline, &Run{PipelineSpec: spec}, vars, l)
+	go scheduler.Run()
+	r.executeScheduledTasks(ctx, scheduler, spec, l)
+
+	results := make(TaskRunResults, 0, len(scheduler.results))
+	for _, result := range scheduler.results {
+		results = append(results, result)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Task.ID() < results[j].Task.ID()
+	})
+	return results
+}
+
 func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger) TaskRunResult {
 	start := time.Now()
 	l = l.With("taskName", taskRun.task.DotID(),
@@ -463,12 +527,13 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		finishedAt = null.TimeFrom(now)
 	}
 	return TaskRunResult{
-		ID:         taskRun.task.Base().uuid,
-		Task:       taskRun.task,
-		Result:     result,
-		CreatedAt:  start,
-		FinishedAt: finishedAt,
-		runInfo:    runInfo,
+		ID:                  taskRun.task.Base().uuid,
+		Task:                taskRun.task,
+		Result:              result,
+		CreatedAt:           start,
+		FinishedAt:          finishedAt,
+		CircuitBreakerState: runInfo.CircuitBreakerState,
+		runInfo:             runInfo,
 	}
 }
 
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 
 	"github.com/smartcontractkit/sqlx"
@@ -501,6 +503,54 @@ succeed2 -> final;
 	assert.Equal(t, "10.5", finalResult.Values[0].(decimal.Decimal).String())
 }
 
+func Test_PipelineRunner_CircuitBreakerState(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.JobPipeline.CircuitBreaker.FailureThreshold = ptr[uint32](1)
+		c.JobPipeline.CircuitBreaker.Cooldown = models.MustNewDuration(time.Hour)
+	})
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	r, _ := newRunner(t, db, bridgesMocks.NewORM(t), cfg)
+	spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`
+ds [type=http method="GET" url="%s"]
+ds_parse [type=jsonparse path="data"]
+ds->ds_parse;`, server.URL)}
+	lggr := logger.TestLogger(t)
+
+	findResult := func(trrs pipeline.TaskRunResults, dotID string) pipeline.TaskRunResult {
+		for _, trr := range trrs {
+			if trr.Task.DotID() == dotID {
+				return trr
+			}
+		}
+		t.Fatalf("no result for task %s", dotID)
+		return pipeline.TaskRunResult{}
+	}
+
+	// the failing request opens the breaker
+	_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), lggr)
+	require.NoError(t, err)
+	ds := findResult(trrs, "ds")
+	require.Error(t, ds.Result.Error)
+	require.NotNil(t, ds.CircuitBreakerState)
+	assert.Equal(t, pipeline.CircuitBreakerOpen, *ds.CircuitBreakerState)
+	assert.Nil(t, findResult(trrs, "ds_parse").CircuitBreakerState)
+
+	// the next run is short-circuited
+	_, trrs, err = r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), lggr)
+	require.NoError(t, err)
+	ds = findResult(trrs, "ds")
+	require.NotNil(t, ds.CircuitBreakerState)
+	assert.Equal(t, pipeline.CircuitBreakerOpen, *ds.CircuitBreakerState)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
 func Test_PipelineRunner_MultipleOutputs(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	cfg := configtest.NewTestGeneralConfig(t)
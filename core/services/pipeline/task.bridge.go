@@ -63,10 +63,11 @@ type BridgeTask struct {
 	CacheTTL          string `json:"cacheTTL"`
 	Headers           string `json:"headers"`
 
-	specId     int32
-	orm        bridges.ORM
-	config     Config
-	httpClient *http.Client
+	specId          int32
+	orm             bridges.ORM
+	config          Config
+	httpClient      *http.Client
+	circuitBreakers *circuitBreakers
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -164,12 +165,25 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		cacheDuration = stalenessCap
 	}
 
-	var cachedResponse bool
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", URLParam(url), reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	var (
+		cachedResponse bool
+		responseBytes  []byte
+		statusCode     int
+		headers        http.Header
+		elapsed        time.Duration
+	)
+	// while the breaker is open the request is skipped, but the cache can
+	// still serve a response
+	if err = t.circuitBreakers.allow(TaskTypeBridge, string(name)); err == nil {
+		responseBytes, statusCode, headers, elapsed, err = makeHTTPRequest(requestCtx, lggr, "POST", URLParam(url), reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+		t.circuitBreakers.recordHTTPRequest(ctx, TaskTypeBridge, string(name), statusCode, err)
+	}
+	runInfo.CircuitBreakerState = t.circuitBreakers.runInfoState(TaskTypeBridge, string(name))
 	if err != nil {
 		promBridgeErrors.WithLabelValues(t.Name).Inc()
 		if cacheTTL == 0 {
-			return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+			runInfo.IsRetryable = isRetryableHTTPError(statusCode, err)
+			return Result{Error: err}, runInfo
 		}
 
 		var cacheErr error
@@ -182,7 +196,8 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 					"url", url.String(),
 				)
 			}
-			return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+			runInfo.IsRetryable = isRetryableHTTPError(statusCode, err)
+			return Result{Error: err}, runInfo
 		}
 		promBridgeCacheHits.WithLabelValues(t.Name).Inc()
 		lggr.Debugw("Bridge task: request failed, falling back to cache",
@@ -197,14 +212,16 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	if t.Async == "true" {
 		// Look for a `pending` flag. This check is case-insensitive because http.Header normalizes header names
 		if _, ok := headers["X-Chainlink-Pending"]; ok {
-			return result, pendingRunInfo()
+			runInfo.IsPending = true
+			return result, runInfo
 		}
 
 		var response struct {
 			Pending bool `json:"pending"`
 		}
 		if err := json.Unmarshal(responseBytes, &response); err == nil && response.Pending {
-			return Result{}, pendingRunInfo()
+			runInfo.IsPending = true
+			return Result{}, runInfo
 		}
 	}
 
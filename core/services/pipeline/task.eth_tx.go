@@ -12,6 +12,7 @@ import (
 	"go.uber.org/multierr"
 	"gopkg.in/guregu/null.v4"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -37,11 +38,12 @@ type ETHTxTask struct {
 	EVMChainID      string `json:"evmChainID" mapstructure:"evmChainID"`
 	TransmitChecker string `json:"transmitChecker"`
 
-	forwardingAllowed bool
-	specGasLimit      *uint32
-	keyStore          ETHKeyStore
-	chainSet          evm.ChainSet
-	jobType           string
+	forwardingAllowed   bool
+	transactionPriority txmgrtypes.TxPriority
+	specGasLimit        *uint32
+	keyStore            ETHKeyStore
+	chainSet            evm.ChainSet
+	jobType             string
 }
 
 type ETHKeyStore interface {
@@ -124,7 +126,7 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 	}
 
 	// TODO(sc-55115): Allow job specs to pass in the strategy that they want
-	strategy := txmgr.NewSendEveryStrategy()
+	strategy := txmgr.NewPriorityStrategy(txmgr.NewSendEveryStrategy(), t.transactionPriority)
 
 	var forwarderAddress common.Address
 	if t.forwardingAllowed {
@@ -28,6 +28,7 @@ type HTTPTask struct {
 	config                 Config
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	circuitBreakers        *circuitBreakers
 }
 
 var _ Task = (*HTTPTask)(nil)
@@ -103,12 +104,20 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	} else {
 		client = t.httpClient
 	}
+	endpoint := httpEndpoint(url)
+	if err = t.circuitBreakers.allow(TaskTypeHTTP, endpoint); err != nil {
+		runInfo.CircuitBreakerState = t.circuitBreakers.runInfoState(TaskTypeHTTP, endpoint)
+		return Result{Error: err}, runInfo
+	}
 	responseBytes, statusCode, respHeaders, elapsed, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+	t.circuitBreakers.recordHTTPRequest(ctx, TaskTypeHTTP, endpoint, statusCode, err)
+	runInfo.CircuitBreakerState = t.circuitBreakers.runInfoState(TaskTypeHTTP, endpoint)
 	if err != nil {
 		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
 			err = errors.Wrap(err, `connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess="true" in the pipeline task spec, e.g. fetch [type="http" method=GET url="$(decode_cbor.url)" allowUnrestrictedNetworkAccess="true"]`)
 		}
-		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+		runInfo.IsRetryable = isRetryableHTTPError(statusCode, err)
+		return Result{Error: err}, runInfo
 	}
 
 	lggr.Debugw("HTTP task got response",
@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -320,6 +321,45 @@ func TestHTTPTask_OnlyErrorMessage(t *testing.T) {
 	require.Nil(t, result.Value)
 }
 
+func TestHTTPTask_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	var requests atomic.Int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	task := pipeline.HTTPTask{
+		Method: "GET",
+		URL:    server.URL + "/price?symbol=ETH",
+	}
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	task.HelperSetDependencies(config, c, c)
+	task.HelperSetCircuitBreakers(2, time.Hour)
+
+	for i := 0; i < 2; i++ {
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		require.NotErrorIs(t, result.Error, pipeline.ErrCircuitBreakerOpen)
+		assert.True(t, runInfo.IsRetryable)
+		require.NotNil(t, runInfo.CircuitBreakerState)
+		assert.Equal(t, []pipeline.CircuitBreakerState{pipeline.CircuitBreakerClosed, pipeline.CircuitBreakerOpen}[i], *runInfo.CircuitBreakerState)
+	}
+
+	// requests for other resources on the same endpoint are short-circuited too
+	task.URL = server.URL + "/price?symbol=BTC"
+	result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.ErrorIs(t, result.Error, pipeline.ErrCircuitBreakerOpen)
+	require.NotNil(t, runInfo.CircuitBreakerState)
+	assert.Equal(t, pipeline.CircuitBreakerOpen, *runInfo.CircuitBreakerState)
+	assert.Contains(t, result.Error.Error(), server.URL+"/price failed 2 consecutive times")
+	assert.Equal(t, int32(2), requests.Load())
+}
+
 func TestHTTPTask_Headers(t *testing.T) {
 	allHeaders := func(headers http.Header) (s []string) {
 		var keys []string
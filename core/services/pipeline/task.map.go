@@ -0,0 +1,219 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	// MapElementKey and MapIndexKey are the variables holding the current
+	// element and its index inside a map task template.
+	MapElementKey = "element"
+	MapIndexKey   = "index"
+
+	defaultMapMaxParallel = 10
+)
+
+// MapTask runs a sub-pipeline for every element of an array input and
+// returns the array of results. The sub-pipeline is given as a DOT fragment
+// in the template attribute and must have exactly one terminal task, whose
+// value becomes the result for the element. Inside the template, the
+// element and its index are available as $(element) and $(index), as well as
+// all the variables of the enclosing run. For example:
+//
+//	prices [type=map input="$(assets)" maxParallel=4
+//	        template="fetch [type=bridge name=\"price\" requestData=<{\"data\": {\"asset\": $(element)}}>];
+//	                  parse [type=jsonparse path=\"data,result\"];
+//	                  fetch -> parse"];
+//
+// Quotes inside the template must be escaped. Template task names must not
+// clash with the task names of the enclosing pipeline, and map tasks can't be
+// nested. Async bridge and ethtx tasks are not supported inside a template.
+//
+// The task runs of every element are persisted with the run, with dot IDs
+// prefixed by the map task's dot ID and the element index, e.g.
+// "prices.0.fetch".
+//
+// Return types:
+//
+//	[]interface{}
+type MapTask struct {
+	BaseTask    `mapstructure:",squash"`
+	Input       string `json:"input"`
+	Template    string `json:"template"`
+	MaxParallel string `json:"maxParallel"`
+
+	runSubPipeline func(ctx context.Context, p *Pipeline, vars Vars, l logger.Logger) TaskRunResults
+	// elementTaskRuns holds the task runs of all the elements of the latest
+	// attempt, so that the runner can persist them with the run
+	elementTaskRuns []TaskRun
+}
+
+var _ Task = (*MapTask)(nil)
+
+func (t *MapTask) Type() TaskType {
+	return TaskTypeMap
+}
+
+func (t *MapTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		elements    SliceParam
+		maxParallel Uint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&elements, From(VarExpr(t.Input, vars), JSONWithVarExprs(t.Input, vars, false), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&maxParallel, From(NonemptyString(t.MaxParallel), defaultMapMaxParallel)), "maxParallel"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if maxParallel == 0 {
+		return Result{Error: errors.Wrap(ErrBadInput, "maxParallel must be greater than 0")}, runInfo
+	}
+	if t.runSubPipeline == nil {
+		return Result{Error: errors.New("map task is not initialized")}, runInfo
+	}
+
+	var (
+		values   = make([]interface{}, len(elements))
+		errs     = make([]error, len(elements))
+		taskRuns = make([][]TaskRun, len(elements))
+		sem      = make(chan struct{}, maxParallel)
+		wg       sync.WaitGroup
+	)
+	for i, element := range elements {
+		if ctx.Err() == nil {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+			}
+		}
+		if ctx.Err() != nil {
+			errs[i] = errors.Wrap(ErrTimeout, "map task timed out before running all elements")
+			continue
+		}
+		wg.Add(1)
+		go func(i int, element interface{}) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			values[i], taskRuns[i], errs[i] = t.runElement(ctx, lggr, vars, i, element)
+		}(i, element)
+	}
+	wg.Wait()
+
+	t.elementTaskRuns = nil
+	for _, trs := range taskRuns {
+		t.elementTaskRuns = append(t.elementTaskRuns, trs...)
+	}
+	for i, err := range errs {
+		if err != nil {
+			return Result{Error: errors.Wrapf(err, "element %d", i)}, runInfo
+		}
+	}
+	return Result{Value: values}, runInfo
+}
+
+func (t *MapTask) runElement(ctx context.Context, lggr logger.Logger, vars Vars, index int, element interface{}) (interface{}, []TaskRun, error) {
+	// every element gets its own copy of the template tasks, since tasks
+	// hold per-run state
+	p, err := t.parseTemplate()
+	if err != nil {
+		return nil, nil, err
+	}
+	elementVars := vars.Copy()
+	err = multierr.Combine(
+		elementVars.Set(MapElementKey, element),
+		elementVars.Set(MapIndexKey, index),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	trrs := t.runSubPipeline(ctx, p, elementVars, lggr.With("mapIndex", index))
+
+	var (
+		value    interface{}
+		runErr   error
+		taskRuns = make([]TaskRun, 0, len(trrs))
+	)
+	for _, trr := range trrs {
+		taskRuns = append(taskRuns, TaskRun{
+			ID:         trr.ID,
+			Type:       trr.Task.Type(),
+			Index:      trr.Task.OutputIndex(),
+			Output:     trr.Result.OutputDB(),
+			Error:      trr.Result.ErrorDB(),
+			DotID:      fmt.Sprintf("%s.%d.%s", t.DotID(), index, trr.Task.DotID()),
+			CreatedAt:  trr.CreatedAt,
+			FinishedAt: trr.FinishedAt,
+			task:       trr.Task,
+		})
+		if trr.runInfo.IsPending {
+			runErr = multierr.Append(runErr, errors.Errorf("task %s is async, async tasks are not supported in map templates", trr.Task.DotID()))
+		} else if trr.IsTerminal() {
+			value = trr.Result.Value
+			runErr = multierr.Append(runErr, trr.Result.Error)
+		}
+	}
+	return value, taskRuns, runErr
+}
+
+// parseTemplate parses the template into a new Pipeline.
+func (t *MapTask) parseTemplate() (*Pipeline, error) {
+	template := t.Template
+	// The DOT decoder only unquotes attribute values that are valid Go
+	// string literals, so multi-line templates are still quoted here.
+	if len(template) >= 2 && strings.HasPrefix(template, `"`) && strings.HasSuffix(template, `"`) {
+		template = strings.ReplaceAll(template[1:len(template)-1], `\"`, `"`)
+	}
+	p, err := Parse(template)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid template")
+	}
+	return p, nil
+}
+
+// validateTemplate checks that the template is a valid sub-pipeline of
+// parent.
+func (t *MapTask) validateTemplate(parent *Pipeline) error {
+	p, err := t.parseTemplate()
+	if err != nil {
+		return err
+	}
+	var terminal int
+	for _, task := range p.Tasks {
+		switch task.Type() {
+		case TaskTypeMap:
+			return errors.Errorf("map task %s: map tasks can't be nested", t.DotID())
+		case TaskTypeETHTx:
+			return errors.Errorf("map task %s: ethtx tasks are not supported in templates", t.DotID())
+		case TaskTypeBridge:
+			if task.(*BridgeTask).Async == "true" {
+				return errors.Errorf("map task %s: async bridge tasks are not supported in templates", t.DotID())
+			}
+		default:
+		}
+		if parent.ByDotID(task.DotID()) != nil {
+			return errors.Errorf("map task %s: template task %s clashes with a task of the pipeline", t.DotID(), task.DotID())
+		}
+		if len(task.Outputs()) == 0 {
+			terminal++
+		}
+	}
+	if terminal != 1 {
+		return errors.Errorf("map task %s: template must have exactly one terminal task, got %d", t.DotID(), terminal)
+	}
+	return nil
+}
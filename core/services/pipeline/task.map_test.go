@@ -0,0 +1,183 @@
+package pipeline_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	configtest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest/v2"
+	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+)
+
+// priceServer responds to {"asset": "..."} requests with the price of the
+// asset, or a 500 for unknown assets, and tracks the number of concurrent
+// requests
+type priceServer struct {
+	*httptest.Server
+	prices map[string]int
+
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+func newPriceServer(t *testing.T, prices map[string]int) *priceServer {
+	s := &priceServer{prices: prices}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.inFlight++
+		if s.inFlight > s.maxInFlight {
+			s.maxInFlight = s.inFlight
+		}
+		s.mu.Unlock()
+		defer func() {
+			s.mu.Lock()
+			s.inFlight--
+			s.mu.Unlock()
+		}()
+
+		var request struct {
+			Asset string `json:"asset"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		price, ok := s.prices[request.Asset]
+		if !ok {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, err := fmt.Fprintf(w, `{"price": %d}`, price)
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func mapPipeline(url string) string {
+	return fmt.Sprintf(`
+prices [type=map input="$(assets)" maxParallel=2
+        template="fetch [type=http method=POST url=\"%s\" requestData=<{\"asset\": $(element)}>];
+                  parse [type=jsonparse path=\"price\"];
+                  fetch -> parse"];
+`, url)
+}
+
+func TestMapTask(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	orm := mocks.NewORM(t)
+	r := pipeline.NewRunner(orm, nil, cfg, nil, nil, nil, lggr, c, c)
+
+	server := newPriceServer(t, map[string]int{"ETH": 1800, "BTC": 27000, "LINK": 7, "DOT": 5})
+
+	t.Run("runs the template for every element", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: mapPipeline(server.URL)}
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"assets": []interface{}{"ETH", "BTC", "LINK", "DOT"},
+		})
+
+		var run *pipeline.Run
+		orm.On("InsertFinishedRun", mock.Anything, true).Run(func(args mock.Arguments) {
+			run = args.Get(0).(*pipeline.Run)
+		}).Return(nil).Once()
+
+		_, finalResult, err := r.ExecuteAndInsertFinishedRun(testutils.Context(t), spec, vars, lggr, true)
+		require.NoError(t, err)
+		require.False(t, finalResult.HasErrors())
+		require.Len(t, finalResult.Values, 1)
+		assert.Equal(t, []interface{}{int64(1800), int64(27000), int64(7), int64(5)}, finalResult.Values[0])
+
+		server.mu.Lock()
+		assert.LessOrEqual(t, server.maxInFlight, 2)
+		server.mu.Unlock()
+
+		// the task runs of every element are persisted with the run
+		require.NotNil(t, run)
+		dotIDs := make(map[string]pipeline.TaskRun)
+		for _, tr := range run.PipelineTaskRuns {
+			dotIDs[tr.DotID] = tr
+		}
+		require.Len(t, dotIDs, 9)
+		assert.Contains(t, dotIDs, "prices")
+		for i := 0; i < 4; i++ {
+			require.Contains(t, dotIDs, fmt.Sprintf("prices.%d.fetch", i))
+			require.Contains(t, dotIDs, fmt.Sprintf("prices.%d.parse", i))
+		}
+		assert.Equal(t, pipeline.TaskTypeJSONParse, dotIDs["prices.2.parse"].Type)
+		assert.Equal(t, int64(7), dotIDs["prices.2.parse"].Output.Val)
+		// only the map task counts towards the run outputs
+		assert.Len(t, run.FatalErrors, 1)
+		assert.Len(t, run.Outputs.Val, 1)
+	})
+
+	t.Run("fails if an element fails", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: mapPipeline(server.URL)}
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"assets": []interface{}{"ETH", "UNKNOWN"},
+		})
+
+		run, trrs, err := r.ExecuteRun(testutils.Context(t), spec, vars, lggr)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		require.Error(t, trrs[0].Result.Error)
+		assert.Contains(t, trrs[0].Result.Error.Error(), "element 1")
+		assert.True(t, run.HasFatalErrors())
+
+		var elementErr bool
+		for _, tr := range run.PipelineTaskRuns {
+			if tr.DotID == "prices.1.fetch" {
+				elementErr = tr.Error.Valid
+			}
+		}
+		assert.True(t, elementErr)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: mapPipeline(server.URL)}
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"assets": []interface{}{},
+		})
+
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, vars, lggr)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		require.NoError(t, trrs[0].Result.Error)
+		assert.Equal(t, []interface{}{}, trrs[0].Result.Value)
+	})
+}
+
+func TestMapTask_InvalidTemplate(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name     string
+		spec     string
+		errorMsg string
+	}{
+		{"not DOT", `m [type=map template="a [type=memo"]`, "invalid template"},
+		{"nested", `m [type=map template="n [type=map template=\"a [type=memo value=1]\"]"]`, "can't be nested"},
+		{"ethtx", `m [type=map template="tx [type=ethtx]"]`, "ethtx tasks are not supported"},
+		{"async bridge", `m [type=map template="b [type=bridge name=foo async=true]"]`, "async bridge tasks are not supported"},
+		{"clashing names", `fetch [type=memo value=1]; m [type=map template="fetch [type=memo value=1]"]`, "clashes with a task of the pipeline"},
+		{"multiple terminal tasks", `m [type=map template="a [type=memo value=1]; b [type=memo value=2]"]`, "exactly one terminal task"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pipeline.Parse(tt.spec)
+			require.ErrorContains(t, err, tt.errorMsg)
+		})
+	}
+}
@@ -30,17 +30,21 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 	mercuryconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/mercury/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/reportcodec"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
+	"github.com/smartcontractkit/chainlink/v2/core/services/telemetry"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 var _ relaytypes.Relayer = &Relayer{}
 
 type RelayerConfig interface {
+	pg.QConfig
 }
 
 type Relayer struct {
@@ -50,16 +54,19 @@ type Relayer struct {
 	cfg         RelayerConfig
 	ks          keystore.Master
 	mercuryPool wsrpc.Pool
+
+	monitoringEndpointGen telemetry.MonitoringEndpointGenerator
 }
 
-func NewRelayer(db *sqlx.DB, chainSet evm.ChainSet, lggr logger.Logger, cfg RelayerConfig, ks keystore.Master) *Relayer {
+func NewRelayer(db *sqlx.DB, chainSet evm.ChainSet, lggr logger.Logger, cfg RelayerConfig, ks keystore.Master, monitoringEndpointGen telemetry.MonitoringEndpointGenerator) *Relayer {
 	return &Relayer{
-		db:          db,
-		chainSet:    chainSet,
-		lggr:        lggr.Named("Relayer"),
-		cfg:         cfg,
-		ks:          ks,
-		mercuryPool: wsrpc.NewPool(lggr.Named("Mercury.WSRPCPool")),
+		db:                    db,
+		chainSet:              chainSet,
+		lggr:                  lggr.Named("Relayer"),
+		cfg:                   cfg,
+		ks:                    ks,
+		mercuryPool:           wsrpc.NewPool(lggr.Named("Mercury.WSRPCPool")),
+		monitoringEndpointGen: monitoringEndpointGen,
 	}
 }
 
@@ -118,11 +125,21 @@ func (r *Relayer) NewMercuryProvider(rargs relaytypes.RelayArgs, pargs relaytype
 		return nil, errors.Wrap(err, "failed to get CSA key for mercury connection")
 	}
 
-	client, err := r.mercuryPool.Checkout(context.Background(), privKey, mercuryConfig.ServerPubKey, mercuryConfig.ServerURL())
-	if err != nil {
-		return nil, err
+	clients := make(map[string]wsrpc.Client)
+	for _, server := range mercuryConfig.GetServers() {
+		client, err := r.mercuryPool.Checkout(context.Background(), privKey, server.PubKey, server.URL)
+		if err != nil {
+			// return the clients checked out for the previous servers to the pool
+			for _, c := range clients {
+				err = multierr.Append(err, c.Close())
+			}
+			return nil, err
+		}
+		clients[server.URL] = client
 	}
-	transmitter := mercury.NewTransmitter(r.lggr, configWatcher.ContractConfigTracker(), client, privKey.PublicKey, *relayConfig.FeedID)
+	orm := mercury.NewORM(r.db, r.lggr, r.cfg)
+	monitoringEndpoint := r.monitoringEndpointGen.GenMonitoringEndpoint(rargs.ContractID, synchronization.MercuryTransmit, rargs.JobID, relayConfig.ChainID.String())
+	transmitter := mercury.NewTransmitter(r.lggr, configWatcher.ContractConfigTracker(), clients, privKey.PublicKey, rargs.JobID, *relayConfig.FeedID, orm, monitoringEndpoint)
 
 	return NewMercuryProvider(configWatcher, transmitter, reportCodec, r.lggr), nil
 }
@@ -326,7 +343,7 @@ func newContractTransmitter(lggr logger.Logger, rargs relaytypes.RelayArgs, tran
 	}
 
 	scoped := configWatcher.chain.Config()
-	strategy := txm.NewQueueingTxStrategy(rargs.ExternalJobID, scoped.OCRDefaultTransactionQueueDepth(), scoped.DatabaseDefaultQueryTimeout())
+	strategy := txm.NewPriorityStrategy(txm.NewQueueingTxStrategy(rargs.ExternalJobID, scoped.OCRDefaultTransactionQueueDepth(), scoped.DatabaseDefaultQueryTimeout()), relayConfig.TransactionPriority)
 
 	var checker txm.EvmTransmitCheckerSpec
 	if configWatcher.chain.Config().OCRSimulateTransactions() {
@@ -376,7 +393,7 @@ func newPipelineContractTransmitter(lggr logger.Logger, rargs relaytypes.RelayAr
 	effectiveTransmitterAddress := common.HexToAddress(relayConfig.EffectiveTransmitterID.String)
 	transmitterAddress := common.HexToAddress(transmitterID)
 	scoped := configWatcher.chain.Config()
-	strategy := txm.NewQueueingTxStrategy(rargs.ExternalJobID, scoped.OCRDefaultTransactionQueueDepth(), scoped.DatabaseDefaultQueryTimeout())
+	strategy := txm.NewPriorityStrategy(txm.NewQueueingTxStrategy(rargs.ExternalJobID, scoped.OCRDefaultTransactionQueueDepth(), scoped.DatabaseDefaultQueryTimeout()), relayConfig.TransactionPriority)
 
 	var checker txm.EvmTransmitCheckerSpec
 	if configWatcher.chain.Config().OCRSimulateTransactions() {
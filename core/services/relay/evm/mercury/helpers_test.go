@@ -53,6 +53,8 @@ func buildSamplePayload() []byte {
 }
 
 var (
+	sampleJobID         = int32(42)
+	sampleServerURL     = "mercury.example.com:443"
 	sampleFeedID        = [32]uint8{28, 145, 107, 74, 167, 229, 124, 167, 182, 138, 225, 191, 69, 101, 63, 86, 182, 86, 253, 58, 163, 53, 239, 127, 174, 105, 107, 102, 63, 27, 132, 114}
 	sampleReport        = buildSampleReport()
 	sampleReportHex     = hexutil.Encode(sampleReport)
@@ -0,0 +1,110 @@
+package mercury
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+// ORM persists the reports waiting to be transmitted to the Mercury server,
+// so that they survive node restarts. Reports are identified by job and
+// report context (config digest, epoch and round).
+type ORM interface {
+	// InsertTransmitRequest persists a report. Inserting a report with the
+	// same report context as one already persisted for the job is a no-op.
+	InsertTransmitRequest(jobID int32, t *Transmission, qopts ...pg.QOpt) error
+	// DeleteTransmitRequest removes a report once it has been transmitted or
+	// evicted.
+	DeleteTransmitRequest(jobID int32, reportCtx ocrtypes.ReportContext, qopts ...pg.QOpt) error
+	// GetTransmitRequests returns up to limit of the job's reports, latest
+	// (by epoch and round) first.
+	GetTransmitRequests(jobID int32, limit int, qopts ...pg.QOpt) ([]*Transmission, error)
+	// PruneTransmitRequests deletes all but the latest maxSize of the job's
+	// reports.
+	PruneTransmitRequests(jobID int32, maxSize int, qopts ...pg.QOpt) error
+}
+
+// Transmission is a report waiting to be transmitted.
+type Transmission struct {
+	Req       *pb.TransmitRequest
+	ReportCtx ocrtypes.ReportContext
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) ORM {
+	return &orm{q: pg.NewQ(db, lggr.Named("MercuryORM"), cfg)}
+}
+
+func (o *orm) InsertTransmitRequest(jobID int32, t *Transmission, qopts ...pg.QOpt) error {
+	err := o.q.WithOpts(qopts...).ExecQ(`
+INSERT INTO mercury_transmit_requests (job_id, config_digest, epoch, round, extra_hash, payload, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (job_id, config_digest, epoch, round) DO NOTHING
+`, jobID, t.ReportCtx.ConfigDigest[:], t.ReportCtx.Epoch, t.ReportCtx.Round, t.ReportCtx.ExtraHash[:], t.Req.Payload, time.Now())
+	return errors.Wrap(err, "failed to insert mercury transmit request")
+}
+
+func (o *orm) DeleteTransmitRequest(jobID int32, reportCtx ocrtypes.ReportContext, qopts ...pg.QOpt) error {
+	err := o.q.WithOpts(qopts...).ExecQ(`
+DELETE FROM mercury_transmit_requests
+WHERE job_id = $1 AND config_digest = $2 AND epoch = $3 AND round = $4
+`, jobID, reportCtx.ConfigDigest[:], reportCtx.Epoch, reportCtx.Round)
+	return errors.Wrap(err, "failed to delete mercury transmit request")
+}
+
+func (o *orm) GetTransmitRequests(jobID int32, limit int, qopts ...pg.QOpt) ([]*Transmission, error) {
+	var rows []struct {
+		ConfigDigest []byte `db:"config_digest"`
+		Epoch        uint32 `db:"epoch"`
+		Round        uint8  `db:"round"`
+		ExtraHash    []byte `db:"extra_hash"`
+		Payload      []byte `db:"payload"`
+	}
+	err := o.q.WithOpts(qopts...).Select(&rows, `
+SELECT config_digest, epoch, round, extra_hash, payload
+FROM mercury_transmit_requests
+WHERE job_id = $1
+ORDER BY epoch DESC, round DESC
+LIMIT $2
+`, jobID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get mercury transmit requests")
+	}
+
+	transmissions := make([]*Transmission, len(rows))
+	for i, row := range rows {
+		t := &Transmission{Req: &pb.TransmitRequest{Payload: row.Payload}}
+		t.ReportCtx.Epoch = row.Epoch
+		t.ReportCtx.Round = row.Round
+		copy(t.ReportCtx.ConfigDigest[:], row.ConfigDigest)
+		copy(t.ReportCtx.ExtraHash[:], row.ExtraHash)
+		transmissions[i] = t
+	}
+	return transmissions, nil
+}
+
+func (o *orm) PruneTransmitRequests(jobID int32, maxSize int, qopts ...pg.QOpt) error {
+	err := o.q.WithOpts(qopts...).ExecQ(`
+DELETE FROM mercury_transmit_requests
+WHERE job_id = $1 AND (config_digest, epoch, round) NOT IN (
+	SELECT config_digest, epoch, round
+	FROM mercury_transmit_requests
+	WHERE job_id = $1
+	ORDER BY epoch DESC, round DESC
+	LIMIT $2
+)
+`, jobID, maxSize)
+	return errors.Wrap(err, "failed to prune mercury transmit requests")
+}
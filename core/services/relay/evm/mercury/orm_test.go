@@ -0,0 +1,85 @@
+package mercury_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+func newTransmission(configDigest ocrtypes.ConfigDigest, epoch uint32, round uint8) *mercury.Transmission {
+	t := &mercury.Transmission{Req: &pb.TransmitRequest{Payload: []byte{byte(epoch), round}}}
+	t.ReportCtx.ConfigDigest = configDigest
+	t.ReportCtx.Epoch = epoch
+	t.ReportCtx.Round = round
+	t.ReportCtx.ExtraHash = testutils.Random32Byte()
+	return t
+}
+
+func TestORM(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	orm := mercury.NewORM(db, logger.TestLogger(t), pgtest.NewQConfig(true))
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+	otherJob, _ := cltest.MustInsertWebhookSpec(t, db)
+
+	cd := ocrtypes.ConfigDigest(testutils.Random32Byte())
+	t1 := newTransmission(cd, 1, 1)
+	t2 := newTransmission(cd, 1, 2)
+	t3 := newTransmission(cd, 2, 1)
+
+	for _, tr := range []*mercury.Transmission{t1, t3, t2} {
+		require.NoError(t, orm.InsertTransmitRequest(jb.ID, tr))
+	}
+	require.NoError(t, orm.InsertTransmitRequest(otherJob.ID, t1))
+
+	t.Run("returns the latest reports first", func(t *testing.T) {
+		transmissions, err := orm.GetTransmitRequests(jb.ID, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []*mercury.Transmission{t3, t2, t1}, transmissions)
+
+		transmissions, err = orm.GetTransmitRequests(jb.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []*mercury.Transmission{t3}, transmissions)
+	})
+
+	t.Run("ignores duplicate report contexts", func(t *testing.T) {
+		duplicate := newTransmission(cd, 1, 1)
+		duplicate.Req.Payload = []byte("other payload")
+		require.NoError(t, orm.InsertTransmitRequest(jb.ID, duplicate))
+
+		transmissions, err := orm.GetTransmitRequests(jb.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, transmissions, 3)
+		assert.Equal(t, t1.Req.Payload, transmissions[2].Req.Payload)
+	})
+
+	t.Run("deletes a report", func(t *testing.T) {
+		require.NoError(t, orm.DeleteTransmitRequest(jb.ID, t2.ReportCtx))
+
+		transmissions, err := orm.GetTransmitRequests(jb.ID, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []*mercury.Transmission{t3, t1}, transmissions)
+	})
+
+	t.Run("prunes all but the latest reports", func(t *testing.T) {
+		require.NoError(t, orm.PruneTransmitRequests(jb.ID, 1))
+
+		transmissions, err := orm.GetTransmitRequests(jb.ID, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []*mercury.Transmission{t3}, transmissions)
+
+		// other jobs are not affected
+		transmissions, err = orm.GetTransmitRequests(otherJob.ID, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []*mercury.Transmission{t1}, transmissions)
+	})
+}
@@ -0,0 +1,149 @@
+package mercury
+
+import (
+	"container/heap"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// TransmitQueue is a bounded priority queue of reports waiting to be
+// transmitted. The latest report (by epoch and round) is popped first, since
+// it is the most valuable to the Mercury server, and the oldest report is
+// evicted when the queue is full. A report with the same report context as
+// one already queued is ignored.
+type TransmitQueue struct {
+	lggr      logger.Logger
+	feedID    string
+	serverURL string
+	maxlen    int
+	load      prometheus.Gauge
+
+	mu     sync.Mutex
+	cond   *sync.Cond
+	pq     priorityQueue
+	queued map[ocrtypes.ReportTimestamp]struct{}
+	closed bool
+}
+
+func NewTransmitQueue(lggr logger.Logger, feedID, serverURL string, maxlen int) *TransmitQueue {
+	tq := &TransmitQueue{
+		lggr:      lggr.Named("TransmitQueue"),
+		feedID:    feedID,
+		serverURL: serverURL,
+		maxlen:    maxlen,
+		load:      transmitQueueLoad.WithLabelValues(feedID, serverURL),
+		queued:    make(map[ocrtypes.ReportTimestamp]struct{}),
+	}
+	tq.cond = sync.NewCond(&tq.mu)
+	return tq
+}
+
+// Push adds a transmission to the queue and wakes up a blocked BlockingPop.
+// If the queue was full, the oldest transmission is removed and returned,
+// which may be t itself. ok is false if t was not queued because it is a
+// duplicate or the queue is closed.
+func (tq *TransmitQueue) Push(t *Transmission) (evicted *Transmission, ok bool) {
+	tq.mu.Lock()
+	defer tq.mu.Unlock()
+
+	if tq.closed {
+		return nil, false
+	}
+	key := t.ReportCtx.ReportTimestamp
+	if _, exists := tq.queued[key]; exists {
+		return nil, false
+	}
+
+	heap.Push(&tq.pq, t)
+	tq.queued[key] = struct{}{}
+	if tq.maxlen > 0 && tq.pq.Len() > tq.maxlen {
+		evicted = heap.Remove(&tq.pq, tq.pq.oldest()).(*Transmission)
+		delete(tq.queued, evicted.ReportCtx.ReportTimestamp)
+		tq.lggr.Warnw("Transmit queue is full; evicted oldest report", "maxlen", tq.maxlen, "evictedReportCtx", evicted.ReportCtx)
+		transmitQueueEvictions.WithLabelValues(tq.feedID, tq.serverURL).Inc()
+	}
+	tq.load.Set(float64(tq.pq.Len()))
+	tq.cond.Signal()
+	return evicted, evicted != t
+}
+
+// BlockingPop removes and returns the latest transmission, waiting for one
+// to be pushed if the queue is empty. It returns nil once the queue is
+// closed.
+func (tq *TransmitQueue) BlockingPop() *Transmission {
+	tq.mu.Lock()
+	defer tq.mu.Unlock()
+
+	for tq.pq.Len() == 0 && !tq.closed {
+		tq.cond.Wait()
+	}
+	if tq.closed {
+		return nil
+	}
+	t := heap.Pop(&tq.pq).(*Transmission)
+	delete(tq.queued, t.ReportCtx.ReportTimestamp)
+	tq.load.Set(float64(tq.pq.Len()))
+	return t
+}
+
+// Len returns the number of queued transmissions.
+func (tq *TransmitQueue) Len() int {
+	tq.mu.Lock()
+	defer tq.mu.Unlock()
+	return tq.pq.Len()
+}
+
+// Close unblocks all calls to BlockingPop. Queued transmissions are
+// dropped, they are expected to be persisted.
+func (tq *TransmitQueue) Close() {
+	tq.mu.Lock()
+	defer tq.mu.Unlock()
+	tq.closed = true
+	tq.cond.Broadcast()
+}
+
+// priorityQueue implements heap.Interface, with the latest report context at
+// the root.
+type priorityQueue []*Transmission
+
+func (pq priorityQueue) Len() int { return len(pq) }
+
+func (pq priorityQueue) Less(i, j int) bool {
+	return isLater(pq[i].ReportCtx, pq[j].ReportCtx)
+}
+
+func (pq priorityQueue) Swap(i, j int) { pq[i], pq[j] = pq[j], pq[i] }
+
+func (pq *priorityQueue) Push(x interface{}) { *pq = append(*pq, x.(*Transmission)) }
+
+func (pq *priorityQueue) Pop() interface{} {
+	old := *pq
+	n := len(old)
+	t := old[n-1]
+	old[n-1] = nil
+	*pq = old[:n-1]
+	return t
+}
+
+// oldest returns the index of the oldest transmission. In a max-heap it is
+// one of the leaves.
+func (pq priorityQueue) oldest() int {
+	idx := len(pq) / 2
+	for i := idx + 1; i < len(pq); i++ {
+		if isLater(pq[idx].ReportCtx, pq[i].ReportCtx) {
+			idx = i
+		}
+	}
+	return idx
+}
+
+func isLater(a, b ocrtypes.ReportContext) bool {
+	if a.Epoch != b.Epoch {
+		return a.Epoch > b.Epoch
+	}
+	return a.Round > b.Round
+}
@@ -0,0 +1,103 @@
+package mercury
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+func newTestTransmission(epoch uint32, round uint8) *Transmission {
+	t := &Transmission{Req: &pb.TransmitRequest{Payload: []byte{byte(epoch), round}}}
+	t.ReportCtx.Epoch = epoch
+	t.ReportCtx.Round = round
+	return t
+}
+
+func Test_TransmitQueue(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+
+	t.Run("pops the latest report first", func(t *testing.T) {
+		tq := NewTransmitQueue(lggr, "0x01", sampleServerURL, 10)
+		for _, tr := range []*Transmission{newTestTransmission(1, 2), newTestTransmission(2, 1), newTestTransmission(1, 1), newTestTransmission(2, 3)} {
+			evicted, ok := tq.Push(tr)
+			require.True(t, ok)
+			require.Nil(t, evicted)
+		}
+		require.Equal(t, 4, tq.Len())
+
+		for _, expected := range []*Transmission{newTestTransmission(2, 3), newTestTransmission(2, 1), newTestTransmission(1, 2), newTestTransmission(1, 1)} {
+			assert.Equal(t, expected, tq.BlockingPop())
+		}
+		assert.Equal(t, 0, tq.Len())
+	})
+
+	t.Run("ignores duplicates", func(t *testing.T) {
+		tq := NewTransmitQueue(lggr, "0x02", sampleServerURL, 10)
+		_, ok := tq.Push(newTestTransmission(1, 1))
+		require.True(t, ok)
+		_, ok = tq.Push(newTestTransmission(1, 1))
+		require.False(t, ok)
+		assert.Equal(t, 1, tq.Len())
+
+		// a popped report can be queued again
+		tq.BlockingPop()
+		_, ok = tq.Push(newTestTransmission(1, 1))
+		require.True(t, ok)
+	})
+
+	t.Run("evicts the oldest report when full", func(t *testing.T) {
+		tq := NewTransmitQueue(lggr, "0x03", sampleServerURL, 3)
+		for _, tr := range []*Transmission{newTestTransmission(1, 2), newTestTransmission(1, 1), newTestTransmission(1, 3)} {
+			_, ok := tq.Push(tr)
+			require.True(t, ok)
+		}
+
+		evicted, ok := tq.Push(newTestTransmission(2, 1))
+		require.True(t, ok)
+		assert.Equal(t, newTestTransmission(1, 1), evicted)
+
+		// a report older than all the queued ones is evicted straight away
+		old := newTestTransmission(0, 1)
+		evicted, ok = tq.Push(old)
+		require.False(t, ok)
+		assert.Equal(t, old, evicted)
+
+		assert.Equal(t, 3, tq.Len())
+		assert.Equal(t, newTestTransmission(2, 1), tq.BlockingPop())
+		assert.Equal(t, newTestTransmission(1, 3), tq.BlockingPop())
+		assert.Equal(t, newTestTransmission(1, 2), tq.BlockingPop())
+	})
+
+	t.Run("BlockingPop waits for a report", func(t *testing.T) {
+		tq := NewTransmitQueue(lggr, "0x05", sampleServerURL, 10)
+		popped := make(chan *Transmission)
+		go func() { popped <- tq.BlockingPop() }()
+
+		select {
+		case <-popped:
+			t.Fatal("BlockingPop returned from an empty queue")
+		case <-time.After(10 * time.Millisecond):
+		}
+		tq.Push(newTestTransmission(1, 1))
+		assert.Equal(t, newTestTransmission(1, 1), <-popped)
+	})
+
+	t.Run("Close unblocks BlockingPop", func(t *testing.T) {
+		tq := NewTransmitQueue(lggr, "0x06", sampleServerURL, 10)
+		popped := make(chan *Transmission)
+		go func() { popped <- tq.BlockingPop() }()
+
+		tq.Close()
+		assert.Nil(t, <-popped)
+
+		_, ok := tq.Push(newTestTransmission(1, 1))
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,162 @@
+package mercury
+
+import (
+	"context"
+	"time"
+
+	"github.com/jpillora/backoff"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// server transmits reports to a single Mercury server. Every server has its
+// own queue and retries independently, so that an unreachable server doesn't
+// hold up transmissions to the others.
+type server struct {
+	lggr logger.Logger
+	url  string
+	c    wsrpc.Client
+	q    *TransmitQueue
+	mt   *mercuryTransmitter
+}
+
+func newServer(lggr logger.Logger, mt *mercuryTransmitter, url string, c wsrpc.Client) *server {
+	lggr = lggr.With("serverURL", url)
+	return &server{
+		lggr: lggr,
+		url:  url,
+		c:    c,
+		q:    NewTransmitQueue(lggr, mt.feedIDHex, url, maxTransmitQueueSize),
+		mt:   mt,
+	}
+}
+
+// push queues t. Transmissions evicted from the queue are marked as done for
+// this server.
+func (s *server) push(ctx context.Context, t *Transmission) {
+	if evicted, _ := s.q.Push(t); evicted != nil {
+		s.mt.done(ctx, evicted)
+	}
+}
+
+// healthy returns the combined errors of the server's client health report.
+func (s *server) healthy() (err error) {
+	for _, e := range s.c.HealthReport() {
+		err = multierr.Append(err, e)
+	}
+	return errors.Wrap(err, s.url)
+}
+
+// runloop transmits queued reports until stopCh is closed.
+func (s *server) runloop(stopCh utils.StopChan) {
+	defer s.mt.wg.Done()
+
+	ctx, cancel := stopCh.NewCtx()
+	defer cancel()
+
+	b := backoff.Backoff{
+		Min:    minTransmitRetryDelay,
+		Max:    maxTransmitRetryDelay,
+		Factor: 2,
+		Jitter: true,
+	}
+	for {
+		t := s.q.BlockingPop()
+		if t == nil {
+			// queue was closed
+			return
+		}
+
+		start := time.Now()
+		res, err := s.transmit(ctx, t)
+		latency := time.Since(start)
+		if ctx.Err() != nil {
+			// the report is still persisted and will be retried on the next start
+			return
+		}
+		if err != nil {
+			transmitConnectionErrorCount.WithLabelValues(s.mt.feedIDHex, s.url).Inc()
+			s.sendTelemetry(t, telem.MercuryTransmitStatus_CONNECTION_ERROR, nil, err, latency)
+			s.lggr.Errorw("Transmit report failed; will retry", "err", err, "reportCtx", t.ReportCtx)
+			s.push(ctx, t)
+			select {
+			case <-time.After(b.Duration()):
+				continue
+			case <-stopCh:
+				return
+			}
+		}
+		b.Reset()
+
+		switch {
+		case res.Error == "":
+			transmitSuccessCount.WithLabelValues(s.mt.feedIDHex, s.url).Inc()
+			s.sendTelemetry(t, telem.MercuryTransmitStatus_SUCCESS, res, nil, latency)
+			s.lggr.Debugw("Transmit report success", "response", res, "reportCtx", t.ReportCtx)
+		case res.Code == DuplicateReport:
+			transmitSuccessCount.WithLabelValues(s.mt.feedIDHex, s.url).Inc()
+			transmitDuplicateCount.WithLabelValues(s.mt.feedIDHex, s.url).Inc()
+			s.sendTelemetry(t, telem.MercuryTransmitStatus_DUPLICATE, res, nil, latency)
+			s.lggr.Debugw("Transmit report success; duplicate report", "response", res, "reportCtx", t.ReportCtx)
+		default:
+			// the server rejected the report, retrying won't help
+			transmitServerErrorCount.WithLabelValues(s.mt.feedIDHex, s.url).Inc()
+			s.sendTelemetry(t, telem.MercuryTransmitStatus_SERVER_ERROR, res, nil, latency)
+			s.lggr.Errorw("Transmit report failed; mercury server returned error", "response", res, "reportCtx", t.ReportCtx, "err", res.Error)
+		}
+		s.mt.done(ctx, t)
+	}
+}
+
+func (s *server) transmit(ctx context.Context, t *Transmission) (*pb.TransmitResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, transmitTimeout)
+	defer cancel()
+
+	start := time.Now()
+	res, err := s.c.Transmit(ctx, t.Req)
+	transmitDuration.WithLabelValues(s.mt.feedIDHex, s.url).Observe(time.Since(start).Seconds())
+	if err != nil {
+		return nil, errors.Wrap(err, "Transmit report to Mercury server failed")
+	}
+	if res == nil {
+		return nil, errors.New("Transmit report to Mercury server failed; expected non-nil response")
+	}
+	return res, nil
+}
+
+// sendTelemetry records the result of a transmit attempt to the server.
+func (s *server) sendTelemetry(t *Transmission, status telem.MercuryTransmitStatus, res *pb.TransmitResponse, err error, latency time.Duration) {
+	if s.mt.monitoringEndpoint == nil {
+		return
+	}
+	result := &telem.MercuryTransmitResult{
+		FeedId:       s.mt.feedIDHex,
+		ServerUrl:    s.url,
+		ConfigDigest: t.ReportCtx.ConfigDigest.Hex(),
+		Epoch:        int64(t.ReportCtx.Epoch),
+		Round:        int64(t.ReportCtx.Round),
+		Status:       status,
+		LatencyMs:    latency.Milliseconds(),
+		Timestamp:    time.Now().UnixMilli(),
+	}
+	if res != nil {
+		result.Code = res.Code
+		result.Error = res.Error
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	b, err := proto.Marshal(result)
+	if err != nil {
+		s.lggr.Warnw("telem.MercuryTransmitResult marshal error", "err", err)
+		return
+	}
+	s.mt.monitoringEndpoint.SendLog(b)
+}
@@ -5,18 +5,90 @@ import (
 	"context"
 	"crypto/ed25519"
 	"fmt"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/libocr/commontypes"
 	"github.com/smartcontractkit/libocr/offchainreporting2/chains/evmutil"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"go.uber.org/multierr"
+	"golang.org/x/exp/maps"
 
 	relaymercury "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	// maxTransmitQueueSize is the maximum number of reports per feed waiting
+	// to be transmitted, beyond which the oldest reports are evicted
+	maxTransmitQueueSize = 10_000
+	// transmitTimeout is the timeout of a single transmit attempt
+	transmitTimeout = 5 * time.Second
+	// minTransmitRetryDelay and maxTransmitRetryDelay bound the exponential
+	// backoff between attempts when the Mercury server can't be reached
+	minTransmitRetryDelay = 100 * time.Millisecond
+	maxTransmitRetryDelay = 30 * time.Second
+)
+
+// DuplicateReport is the code returned by the Mercury server for a report it
+// has already received.
+const DuplicateReport = 2
+
+var (
+	transmitQueueLoad = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mercury_transmit_queue_load",
+		Help: "Number of reports waiting to be transmitted to the Mercury server",
+	},
+		[]string{"feed_id", "server_url"},
+	)
+	transmitQueueEvictions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mercury_transmit_queue_evictions_total",
+		Help: "Number of reports evicted from a full transmit queue without being transmitted",
+	},
+		[]string{"feed_id", "server_url"},
+	)
+	transmitDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "mercury_transmit_duration_seconds",
+		Help:    "Duration of transmit requests to the Mercury server",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5},
+	},
+		[]string{"feed_id", "server_url"},
+	)
+	transmitSuccessCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mercury_transmit_success_count",
+		Help: "Number of successful transmissions (duplicates are counted as success)",
+	},
+		[]string{"feed_id", "server_url"},
+	)
+	transmitDuplicateCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mercury_transmit_duplicate_count",
+		Help: "Number of transmissions where the server told us it was a duplicate",
+	},
+		[]string{"feed_id", "server_url"},
+	)
+	transmitServerErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mercury_transmit_server_error_count",
+		Help: "Number of errored transmissions that failed due to an error returned by the mercury server",
+	},
+		[]string{"feed_id", "server_url"},
+	)
+	transmitConnectionErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mercury_transmit_connection_error_count",
+		Help: "Number of errored transmissions that failed due to problem with the connection, and will be retried",
+	},
+		[]string{"feed_id", "server_url"},
+	)
 )
 
 type Transmitter interface {
@@ -30,13 +102,32 @@ type ConfigTracker interface {
 
 var _ Transmitter = &mercuryTransmitter{}
 
+// mercuryTransmitter persists every report and queues it for transmission
+// to each of the Mercury servers of the job. Every server is drained by its
+// own background worker, retrying with exponential backoff while the server
+// can't be reached. A report is deleted from the database once every server
+// is done with it, and reports still persisted are reloaded and queued for
+// all servers on start, so they survive node restarts.
 type mercuryTransmitter struct {
-	lggr       logger.Logger
-	rpcClient  wsrpc.Client
-	cfgTracker ConfigTracker
+	utils.StartStopOnce
+	lggr               logger.Logger
+	servers            []*server
+	cfgTracker         ConfigTracker
+	orm                ORM
+	monitoringEndpoint commontypes.MonitoringEndpoint
 
+	jobID       int32
 	feedID      [32]byte
+	feedIDHex   string
 	fromAccount string
+
+	// pending holds the number of servers that are not done with each
+	// persisted report
+	pendingMu sync.Mutex
+	pending   map[ocrtypes.ReportTimestamp]int
+
+	stopCh utils.StopChan
+	wg     sync.WaitGroup
 }
 
 var PayloadTypes = getPayloadTypes()
@@ -58,22 +149,137 @@ func getPayloadTypes() abi.Arguments {
 	})
 }
 
-func NewTransmitter(lggr logger.Logger, cfgTracker ConfigTracker, rpcClient wsrpc.Client, fromAccount ed25519.PublicKey, feedID [32]byte) *mercuryTransmitter {
-	return &mercuryTransmitter{lggr.Named("MercuryTransmitter"), rpcClient, cfgTracker, feedID, fmt.Sprintf("%x", fromAccount)}
+// NewTransmitter returns a Transmitter sending reports to every server in
+// clients, which maps server URLs to their wsrpc clients. monitoringEndpoint
+// receives the result of every transmit attempt, it may be nil.
+func NewTransmitter(lggr logger.Logger, cfgTracker ConfigTracker, clients map[string]wsrpc.Client, fromAccount ed25519.PublicKey, jobID int32, feedID [32]byte, orm ORM, monitoringEndpoint commontypes.MonitoringEndpoint) *mercuryTransmitter {
+	feedIDHex := fmt.Sprintf("0x%x", feedID[:])
+	lggr = lggr.Named("MercuryTransmitter").With("feedID", feedIDHex)
+	mt := &mercuryTransmitter{
+		lggr:               lggr,
+		cfgTracker:         cfgTracker,
+		orm:                orm,
+		monitoringEndpoint: monitoringEndpoint,
+		jobID:              jobID,
+		feedID:             feedID,
+		feedIDHex:          feedIDHex,
+		fromAccount:        fmt.Sprintf("%x", fromAccount),
+		pending:            make(map[ocrtypes.ReportTimestamp]int),
+		stopCh:             make(chan struct{}),
+	}
+	urls := maps.Keys(clients)
+	sort.Strings(urls)
+	for _, url := range urls {
+		mt.servers = append(mt.servers, newServer(lggr, mt, url, clients[url]))
+	}
+	return mt
+}
+
+func (mt *mercuryTransmitter) Start(ctx context.Context) error {
+	return mt.StartOnce("MercuryTransmitter", func() (err error) {
+		var started []*server
+		// close the clients started so far if the transmitter fails to start
+		defer func() {
+			if err != nil {
+				for _, s := range started {
+					err = multierr.Append(err, s.c.Close())
+				}
+			}
+		}()
+		for _, s := range mt.servers {
+			if err = s.c.Start(ctx); err != nil {
+				return err
+			}
+			started = append(started, s)
+		}
+		transmissions, err := mt.orm.GetTransmitRequests(mt.jobID, maxTransmitQueueSize, pg.WithParentCtx(ctx))
+		if err != nil {
+			return err
+		}
+		for _, t := range transmissions {
+			mt.queue(ctx, t)
+		}
+		if err = mt.orm.PruneTransmitRequests(mt.jobID, maxTransmitQueueSize, pg.WithParentCtx(ctx)); err != nil {
+			return err
+		}
+		if len(transmissions) > 0 {
+			mt.lggr.Infow("Loaded persisted reports into the transmit queues", "count", len(transmissions))
+		}
+
+		for _, s := range mt.servers {
+			mt.wg.Add(1)
+			go s.runloop(mt.stopCh)
+		}
+		return nil
+	})
+}
+
+func (mt *mercuryTransmitter) Close() error {
+	return mt.StopOnce("MercuryTransmitter", func() (err error) {
+		close(mt.stopCh)
+		for _, s := range mt.servers {
+			s.q.Close()
+		}
+		mt.wg.Wait()
+		for _, s := range mt.servers {
+			err = multierr.Append(err, s.c.Close())
+		}
+		return err
+	})
 }
 
-func (mt *mercuryTransmitter) Start(ctx context.Context) error { return mt.rpcClient.Start(ctx) }
-func (mt *mercuryTransmitter) Close() error                    { return mt.rpcClient.Close() }
-func (mt *mercuryTransmitter) Ready() error                    { return mt.rpcClient.Ready() }
 func (mt *mercuryTransmitter) Name() string {
 	return mt.lggr.Name()
 }
 
+// HealthReport reports the health of every server separately, so that a
+// failing server doesn't mark the others unhealthy.
 func (mt *mercuryTransmitter) HealthReport() map[string]error {
-	return mt.rpcClient.HealthReport()
+	report := map[string]error{mt.Name(): mt.StartStopOnce.Healthy()}
+	for _, s := range mt.servers {
+		report[fmt.Sprintf("%s.%s", mt.Name(), s.url)] = s.healthy()
+	}
+	return report
+}
+
+// queue queues a persisted report for transmission to every server, unless
+// it is already queued.
+func (mt *mercuryTransmitter) queue(ctx context.Context, t *Transmission) {
+	mt.pendingMu.Lock()
+	if _, exists := mt.pending[t.ReportCtx.ReportTimestamp]; exists {
+		mt.pendingMu.Unlock()
+		return
+	}
+	mt.pending[t.ReportCtx.ReportTimestamp] = len(mt.servers)
+	mt.pendingMu.Unlock()
+
+	for _, s := range mt.servers {
+		s.push(ctx, t)
+	}
 }
 
-// Transmit sends the report to the on-chain smart contract's Transmit method.
+// done marks a report as done for one server, i.e. transmitted, rejected or
+// evicted. The report is deleted once all servers are done with it.
+func (mt *mercuryTransmitter) done(ctx context.Context, t *Transmission) {
+	mt.pendingMu.Lock()
+	key := t.ReportCtx.ReportTimestamp
+	mt.pending[key]--
+	remaining := mt.pending[key]
+	if remaining <= 0 {
+		delete(mt.pending, key)
+	}
+	mt.pendingMu.Unlock()
+
+	if remaining > 0 {
+		return
+	}
+	if err := mt.orm.DeleteTransmitRequest(mt.jobID, t.ReportCtx, pg.WithParentCtx(ctx)); err != nil {
+		mt.lggr.Errorw("Failed to delete transmit request", "err", err, "reportCtx", t.ReportCtx)
+	}
+}
+
+// Transmit persists the report and queues it for transmission to the Mercury
+// servers. It returns as soon as the report is persisted.
 func (mt *mercuryTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) error {
 	var rs [][32]byte
 	var ss [][32]byte
@@ -94,25 +300,17 @@ func (mt *mercuryTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.R
 		return errors.Wrap(err, "abi.Pack failed")
 	}
 
-	req := &pb.TransmitRequest{
-		Payload: payload,
+	t := &Transmission{
+		Req:       &pb.TransmitRequest{Payload: payload},
+		ReportCtx: reportCtx,
 	}
 
-	mt.lggr.Debugw("Transmitting report", "transmitRequest", req, "report", report, "reportCtx", reportCtx, "signatures", signatures)
-
-	res, err := mt.rpcClient.Transmit(ctx, req)
-	if err != nil {
-		return errors.Wrap(err, "Transmit report to Mercury server failed")
-	}
+	mt.lggr.Debugw("Queueing report for transmission", "transmitRequest", t.Req, "report", report, "reportCtx", reportCtx, "signatures", signatures)
 
-	if res.Error == "" {
-		mt.lggr.Debugw("Transmit report success", "response", res, "reportCtx", reportCtx)
-	} else {
-		err := errors.New(res.Error)
-		mt.lggr.Errorw("Transmit report failed; mercury server returned error", "response", res, "reportCtx", reportCtx, "err", err)
+	if err = mt.orm.InsertTransmitRequest(mt.jobID, t, pg.WithParentCtx(ctx)); err != nil {
 		return err
 	}
-
+	mt.queue(ctx, t)
 	return nil
 }
 
@@ -127,7 +325,7 @@ func (mt *mercuryTransmitter) LatestConfigDigestAndEpoch(ctx context.Context) (c
 	req := &pb.LatestReportRequest{
 		FeedId: mt.feedID[:],
 	}
-	resp, err := mt.rpcClient.LatestReport(ctx, req)
+	resp, err := mt.latestReport(ctx, req)
 	if err != nil {
 		mt.lggr.Errorw("LatestConfigDigestAndEpoch failed", "err", err)
 		return cd, epoch, errors.Wrap(err, "LatestConfigDigestAndEpoch failed to fetch LatestReport")
@@ -163,7 +361,7 @@ func (mt *mercuryTransmitter) FetchInitialMaxFinalizedBlockNumber(ctx context.Co
 	req := &pb.LatestReportRequest{
 		FeedId: mt.feedID[:],
 	}
-	resp, err := mt.rpcClient.LatestReport(ctx, req)
+	resp, err := mt.latestReport(ctx, req)
 	if err != nil {
 		mt.lggr.Errorw("FetchInitialMaxFinalizedBlockNumber failed", "err", err)
 		return 0, errors.Wrap(err, "FetchInitialMaxFinalizedBlockNumber failed to fetch LatestReport")
@@ -187,3 +385,17 @@ func (mt *mercuryTransmitter) FetchInitialMaxFinalizedBlockNumber(ctx context.Co
 
 	return resp.Report.CurrentBlockNumber, nil
 }
+
+// latestReport returns the response of the first server, in URL order, that
+// could be reached.
+func (mt *mercuryTransmitter) latestReport(ctx context.Context, req *pb.LatestReportRequest) (resp *pb.LatestReportResponse, merr error) {
+	for _, s := range mt.servers {
+		var err error
+		resp, err = s.c.LatestReport(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+		merr = multierr.Append(merr, errors.Wrap(err, s.url))
+	}
+	return nil, merr
+}
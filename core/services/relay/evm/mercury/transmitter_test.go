@@ -2,30 +2,48 @@ package mercury
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 type MockWSRPCClient struct {
 	transmit     func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error)
 	latestReport func(ctx context.Context, req *pb.LatestReportRequest) (resp *pb.LatestReportResponse, err error)
+	start        func(ctx context.Context) error
+	close        func() error
 }
 
-func (m MockWSRPCClient) Name() string                   { return "" }
-func (m MockWSRPCClient) Start(context.Context) error    { return nil }
-func (m MockWSRPCClient) Close() error                   { return nil }
+func (m MockWSRPCClient) Name() string { return "" }
+func (m MockWSRPCClient) Start(ctx context.Context) error {
+	if m.start != nil {
+		return m.start(ctx)
+	}
+	return nil
+}
+func (m MockWSRPCClient) Close() error {
+	if m.close != nil {
+		return m.close()
+	}
+	return nil
+}
 func (m MockWSRPCClient) HealthReport() map[string]error { return map[string]error{} }
 func (m MockWSRPCClient) Ready() error                   { return nil }
 func (m MockWSRPCClient) Transmit(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
@@ -47,39 +65,296 @@ func (m MockTracker) LatestConfigDetails(ctx context.Context) (changedInBlock ui
 
 var _ ConfigTracker = &MockTracker{}
 
+type mockORM struct {
+	mu            sync.Mutex
+	transmissions map[ocrtypes.ReportTimestamp]*Transmission
+	insertErr     error
+}
+
+func newMockORM(transmissions ...*Transmission) *mockORM {
+	o := &mockORM{transmissions: make(map[ocrtypes.ReportTimestamp]*Transmission)}
+	for _, t := range transmissions {
+		o.transmissions[t.ReportCtx.ReportTimestamp] = t
+	}
+	return o
+}
+
+func (o *mockORM) InsertTransmitRequest(jobID int32, t *Transmission, qopts ...pg.QOpt) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.insertErr != nil {
+		return o.insertErr
+	}
+	if _, ok := o.transmissions[t.ReportCtx.ReportTimestamp]; !ok {
+		o.transmissions[t.ReportCtx.ReportTimestamp] = t
+	}
+	return nil
+}
+
+func (o *mockORM) DeleteTransmitRequest(jobID int32, reportCtx ocrtypes.ReportContext, qopts ...pg.QOpt) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.transmissions, reportCtx.ReportTimestamp)
+	return nil
+}
+
+func (o *mockORM) GetTransmitRequests(jobID int32, limit int, qopts ...pg.QOpt) ([]*Transmission, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var transmissions []*Transmission
+	for _, t := range o.transmissions {
+		transmissions = append(transmissions, t)
+	}
+	return transmissions, nil
+}
+
+func (o *mockORM) PruneTransmitRequests(jobID int32, maxSize int, qopts ...pg.QOpt) error {
+	return nil
+}
+
+func (o *mockORM) count() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.transmissions)
+}
+
+var _ ORM = &mockORM{}
+
 func Test_MercuryTransmitter_Transmit(t *testing.T) {
 	t.Parallel()
 
 	lggr := logger.TestLogger(t)
 
-	t.Run("successful transmit", func(t *testing.T) {
+	t.Run("persists and queues the report", func(t *testing.T) {
+		orm := newMockORM()
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sampleServerURL: MockWSRPCClient{}}, sampleClientPubKey, sampleJobID, sampleFeedID, orm, nil)
+		err := mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs)
+		require.NoError(t, err)
+
+		require.Equal(t, 1, orm.count())
+		require.Equal(t, 1, mt.servers[0].q.Len())
+		tr := mt.servers[0].q.BlockingPop()
+		assert.Equal(t, samplePayloadHex, hexutil.Encode(tr.Req.Payload))
+		assert.Equal(t, sampleReportContext, tr.ReportCtx)
+	})
+
+	t.Run("failing to persist the report", func(t *testing.T) {
+		orm := newMockORM()
+		orm.insertErr = errors.New("foo error")
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sampleServerURL: MockWSRPCClient{}}, sampleClientPubKey, sampleJobID, sampleFeedID, orm, nil)
+		err := mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "foo error")
+		assert.Equal(t, 0, mt.servers[0].q.Len())
+	})
+}
+
+func Test_MercuryTransmitter_runloop(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+
+	t.Run("retries until the report is transmitted", func(t *testing.T) {
+		var calls atomic.Int32
 		c := MockWSRPCClient{
-			transmit: func(ctx context.Context, in *pb.TransmitRequest) (out *pb.TransmitResponse, err error) {
-				require.NotNil(t, in)
+			transmit: func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
 				assert.Equal(t, samplePayloadHex, hexutil.Encode(in.Payload))
-				out = new(pb.TransmitResponse)
-				out.Code = 42
-				out.Error = ""
-				return out, nil
+				if calls.Add(1) < 3 {
+					return nil, errors.New("connection lost")
+				}
+				return &pb.TransmitResponse{}, nil
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
-		err := mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs)
+		orm := newMockORM()
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sampleServerURL: c}, sampleClientPubKey, sampleJobID, sampleFeedID, orm, nil)
+		require.NoError(t, mt.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, mt.Close()) })
 
-		require.NoError(t, err)
+		require.NoError(t, mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs))
+
+		require.Eventually(t, func() bool { return orm.count() == 0 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+		assert.Equal(t, int32(3), calls.Load())
+		assert.Equal(t, 0, mt.servers[0].q.Len())
 	})
 
-	t.Run("failing transmit", func(t *testing.T) {
+	t.Run("drops reports rejected by the server", func(t *testing.T) {
+		var calls atomic.Int32
 		c := MockWSRPCClient{
-			transmit: func(ctx context.Context, in *pb.TransmitRequest) (out *pb.TransmitResponse, err error) {
-				return nil, errors.New("foo error")
+			transmit: func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+				calls.Add(1)
+				return &pb.TransmitResponse{Code: 1, Error: "invalid report"}, nil
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
-		err := mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs)
+		orm := newMockORM()
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sampleServerURL: c}, sampleClientPubKey, sampleJobID, sampleFeedID, orm, nil)
+		require.NoError(t, mt.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, mt.Close()) })
 
-		require.Error(t, err)
-		assert.Contains(t, err.Error(), "foo error")
+		require.NoError(t, mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs))
+
+		require.Eventually(t, func() bool { return orm.count() == 0 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("transmits persisted reports on start", func(t *testing.T) {
+		transmitted := make(chan []byte, 2)
+		c := MockWSRPCClient{
+			transmit: func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+				transmitted <- in.Payload
+				return &pb.TransmitResponse{}, nil
+			},
+		}
+		orm := newMockORM(newTestTransmission(1, 1), newTestTransmission(1, 2))
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sampleServerURL: c}, sampleClientPubKey, sampleJobID, sampleFeedID, orm, nil)
+		require.NoError(t, mt.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, mt.Close()) })
+
+		// latest report first
+		assert.Equal(t, []byte{1, 2}, <-transmitted)
+		assert.Equal(t, []byte{1, 1}, <-transmitted)
+		require.Eventually(t, func() bool { return orm.count() == 0 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	})
+
+	t.Run("keeps unsent reports persisted on close", func(t *testing.T) {
+		c := MockWSRPCClient{
+			transmit: func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+				return nil, errors.New("connection lost")
+			},
+		}
+		orm := newMockORM()
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sampleServerURL: c}, sampleClientPubKey, sampleJobID, sampleFeedID, orm, nil)
+		require.NoError(t, mt.Start(testutils.Context(t)))
+
+		require.NoError(t, mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs))
+		require.NoError(t, mt.Close())
+		assert.Equal(t, 1, orm.count())
+	})
+}
+
+type mockMonitoringEndpoint struct {
+	mu      sync.Mutex
+	results []*telem.MercuryTransmitResult
+}
+
+func (m *mockMonitoringEndpoint) SendLog(log []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := new(telem.MercuryTransmitResult)
+	if err := proto.Unmarshal(log, result); err != nil {
+		panic(err)
+	}
+	m.results = append(m.results, result)
+}
+
+func (m *mockMonitoringEndpoint) statuses(serverURL string) (statuses []telem.MercuryTransmitStatus) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, r := range m.results {
+		if r.ServerUrl == serverURL {
+			statuses = append(statuses, r.Status)
+		}
+	}
+	return
+}
+
+func Test_MercuryTransmitter_MultipleServers(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+	const secondaryURL = "mercury-secondary.example.com:443"
+
+	t.Run("transmits to every server independently", func(t *testing.T) {
+		var secondaryUp atomic.Bool
+		primary := MockWSRPCClient{
+			transmit: func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+				return &pb.TransmitResponse{}, nil
+			},
+		}
+		secondary := MockWSRPCClient{
+			transmit: func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+				if !secondaryUp.Load() {
+					return nil, errors.New("connection lost")
+				}
+				return &pb.TransmitResponse{Code: DuplicateReport, Error: "duplicate"}, nil
+			},
+		}
+		orm := newMockORM()
+		endpoint := &mockMonitoringEndpoint{}
+		clients := map[string]wsrpc.Client{sampleServerURL: primary, secondaryURL: secondary}
+		mt := NewTransmitter(lggr, nil, clients, sampleClientPubKey, sampleJobID, sampleFeedID, orm, endpoint)
+		require.Len(t, mt.servers, 2)
+		require.NoError(t, mt.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, mt.Close()) })
+
+		require.NoError(t, mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs))
+
+		// the primary transmits while the secondary keeps retrying, and the
+		// report stays persisted until the secondary is done with it
+		require.Eventually(t, func() bool {
+			return len(endpoint.statuses(sampleServerURL)) == 1 && len(endpoint.statuses(secondaryURL)) >= 2
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+		assert.Equal(t, []telem.MercuryTransmitStatus{telem.MercuryTransmitStatus_SUCCESS}, endpoint.statuses(sampleServerURL))
+		assert.Equal(t, 1, orm.count())
+
+		secondaryUp.Store(true)
+		require.Eventually(t, func() bool { return orm.count() == 0 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+		statuses := endpoint.statuses(secondaryURL)
+		assert.Equal(t, telem.MercuryTransmitStatus_CONNECTION_ERROR, statuses[0])
+		assert.Equal(t, telem.MercuryTransmitStatus_DUPLICATE, statuses[len(statuses)-1])
+
+		endpoint.mu.Lock()
+		defer endpoint.mu.Unlock()
+		result := endpoint.results[0]
+		assert.Equal(t, mt.feedIDHex, result.FeedId)
+		assert.Equal(t, sampleReportContext.ConfigDigest.Hex(), result.ConfigDigest)
+		assert.Equal(t, int64(sampleReportContext.Epoch), result.Epoch)
+		assert.Equal(t, int64(sampleReportContext.Round), result.Round)
+	})
+
+	t.Run("closes the started clients if a client fails to start", func(t *testing.T) {
+		var closed atomic.Int32
+		started := MockWSRPCClient{close: func() error {
+			closed.Add(1)
+			return nil
+		}}
+		failing := MockWSRPCClient{
+			start: func(ctx context.Context) error { return errors.New("failed to dial") },
+			close: func() error {
+				closed.Add(1)
+				return nil
+			},
+		}
+		clients := map[string]wsrpc.Client{"a.example.com:443": started, "b.example.com:443": failing}
+		mt := NewTransmitter(lggr, nil, clients, sampleClientPubKey, sampleJobID, sampleFeedID, newMockORM(), nil)
+		require.EqualError(t, mt.Start(testutils.Context(t)), "failed to dial")
+		assert.Equal(t, int32(1), closed.Load())
+	})
+
+	t.Run("reports the health of every server", func(t *testing.T) {
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sampleServerURL: MockWSRPCClient{}, secondaryURL: MockWSRPCClient{}}, sampleClientPubKey, sampleJobID, sampleFeedID, newMockORM(), nil)
+		report := mt.HealthReport()
+		assert.Contains(t, report, mt.Name()+"."+sampleServerURL)
+		assert.Contains(t, report, mt.Name()+"."+secondaryURL)
+	})
+
+	t.Run("LatestConfigDigestAndEpoch falls back to the next server", func(t *testing.T) {
+		sampleConfigDigest := utils.NewHash().Bytes()
+		failing := MockWSRPCClient{
+			latestReport: func(ctx context.Context, in *pb.LatestReportRequest) (*pb.LatestReportResponse, error) {
+				return nil, errors.New("something exploded")
+			},
+		}
+		working := MockWSRPCClient{
+			latestReport: func(ctx context.Context, in *pb.LatestReportRequest) (*pb.LatestReportResponse, error) {
+				return &pb.LatestReportResponse{Report: &pb.Report{FeedId: sampleFeedID[:], ConfigDigest: sampleConfigDigest, Epoch: 42}}, nil
+			},
+		}
+		// servers are queried in URL order
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sampleServerURL: failing, secondaryURL: working}, sampleClientPubKey, sampleJobID, sampleFeedID, newMockORM(), nil)
+		cd, epoch, err := mt.LatestConfigDigestAndEpoch(testutils.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, hexutil.Encode(sampleConfigDigest), hexutil.Encode(cd[:]))
+		assert.Equal(t, 42, int(epoch))
 	})
 }
 
@@ -104,7 +379,7 @@ func Test_MercuryTransmitter_LatestConfigDigestAndEpoch(t *testing.T) {
 				return out, nil
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sampleServerURL: c}, sampleClientPubKey, sampleJobID, sampleFeedID, newMockORM(), nil)
 		cd, epoch, err := mt.LatestConfigDigestAndEpoch(testutils.Context(t))
 		require.NoError(t, err)
 
@@ -117,7 +392,7 @@ func Test_MercuryTransmitter_LatestConfigDigestAndEpoch(t *testing.T) {
 				return nil, errors.New("something exploded")
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sampleServerURL: c}, sampleClientPubKey, sampleJobID, sampleFeedID, newMockORM(), nil)
 		_, _, err := mt.LatestConfigDigestAndEpoch(testutils.Context(t))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "something exploded")
@@ -135,7 +410,7 @@ func Test_MercuryTransmitter_LatestConfigDigestAndEpoch(t *testing.T) {
 				return out, nil
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sampleServerURL: c}, sampleClientPubKey, sampleJobID, sampleFeedID, newMockORM(), nil)
 		_, _, err := mt.LatestConfigDigestAndEpoch(testutils.Context(t))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "LatestConfigDigestAndEpoch failed; mismatched feed IDs, expected: 0x1c916b4aa7e57ca7b68ae1bf45653f56b656fd3aa335ef7fae696b663f1b8472, got: 0x01020304")
@@ -147,7 +422,7 @@ func Test_MercuryTransmitter_LatestConfigDigestAndEpoch(t *testing.T) {
 			},
 		}
 		tracker := &MockTracker{}
-		mt := NewTransmitter(lggr, tracker, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, tracker, map[string]wsrpc.Client{sampleServerURL: c}, sampleClientPubKey, sampleJobID, sampleFeedID, newMockORM(), nil)
 		_, _, err := mt.LatestConfigDigestAndEpoch(testutils.Context(t))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "LatestConfigDigestAndEpoch expected LatestReport to return non-nil response")
@@ -166,7 +441,7 @@ func Test_MercuryTransmitter_LatestConfigDigestAndEpoch(t *testing.T) {
 					return 123, ocrtypes.ConfigDigest(sampleConfigDigest), nil
 				},
 			}
-			mt := NewTransmitter(lggr, tracker, c, sampleClientPubKey, sampleFeedID)
+			mt := NewTransmitter(lggr, tracker, map[string]wsrpc.Client{sampleServerURL: c}, sampleClientPubKey, sampleJobID, sampleFeedID, newMockORM(), nil)
 			cd, epoch, err := mt.LatestConfigDigestAndEpoch(testutils.Context(t))
 			require.NoError(t, err)
 
@@ -179,7 +454,7 @@ func Test_MercuryTransmitter_LatestConfigDigestAndEpoch(t *testing.T) {
 					return changedInBlock, configDigest, errors.New("something exploded")
 				},
 			}
-			mt := NewTransmitter(lggr, tracker, c, sampleClientPubKey, sampleFeedID)
+			mt := NewTransmitter(lggr, tracker, map[string]wsrpc.Client{sampleServerURL: c}, sampleClientPubKey, sampleJobID, sampleFeedID, newMockORM(), nil)
 			_, _, err := mt.LatestConfigDigestAndEpoch(testutils.Context(t))
 			require.Error(t, err)
 			assert.Contains(t, err.Error(), "something exploded")
@@ -204,7 +479,7 @@ func Test_MercuryTransmitter_FetchInitialMaxFinalizedBlockNumber(t *testing.T) {
 				return out, nil
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sampleServerURL: c}, sampleClientPubKey, sampleJobID, sampleFeedID, newMockORM(), nil)
 		bn, err := mt.FetchInitialMaxFinalizedBlockNumber(testutils.Context(t))
 		require.NoError(t, err)
 
@@ -216,7 +491,7 @@ func Test_MercuryTransmitter_FetchInitialMaxFinalizedBlockNumber(t *testing.T) {
 				return nil, errors.New("something exploded")
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sampleServerURL: c}, sampleClientPubKey, sampleJobID, sampleFeedID, newMockORM(), nil)
 		_, err := mt.FetchInitialMaxFinalizedBlockNumber(testutils.Context(t))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "something exploded")
@@ -233,7 +508,7 @@ func Test_MercuryTransmitter_FetchInitialMaxFinalizedBlockNumber(t *testing.T) {
 				return out, nil
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sampleServerURL: c}, sampleClientPubKey, sampleJobID, sampleFeedID, newMockORM(), nil)
 		_, err := mt.FetchInitialMaxFinalizedBlockNumber(testutils.Context(t))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "FetchInitialMaxFinalizedBlockNumber failed; mismatched feed IDs, expected: 0x1c916b4aa7e57ca7b68ae1bf45653f56b656fd3aa335ef7fae696b663f1b8472, got: 0x")
@@ -6,6 +6,7 @@ import (
 
 	"gopkg.in/guregu/null.v2"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -15,7 +16,8 @@ type RelayConfig struct {
 	EffectiveTransmitterID null.String `json:"effectiveTransmitterID"`
 
 	// Contract-specific
-	SendingKeys pq.StringArray `json:"sendingKeys"`
+	SendingKeys         pq.StringArray        `json:"sendingKeys"`
+	TransactionPriority txmgrtypes.TxPriority `json:"transactionPriority"`
 
 	// Mercury-specific
 	FeedID *common.Hash `json:"feedID"`
@@ -13,4 +13,5 @@ const (
 	OCR2Median        TelemetryType = "ocr2-median"
 	OCR2Mercury       TelemetryType = "ocr2-mercury"
 	OCR2VRF           TelemetryType = "ocr2-vrf"
+	MercuryTransmit   TelemetryType = "mercury-transmit"
 )
@@ -8,6 +8,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	telemPb "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // NewTestTelemetryIngressClient calls NewTelemetryIngressClient and injects telemClient.
@@ -19,7 +20,16 @@ func NewTestTelemetryIngressClient(t *testing.T, url *url.URL, serverPubKeyHex s
 
 // NewTestTelemetryIngressBatchClient calls NewTelemetryIngressBatchClient and injects telemClient.
 func NewTestTelemetryIngressBatchClient(t *testing.T, url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, telemClient telemPb.TelemClient, sendInterval time.Duration, uniconn bool) TelemetryIngressBatchClient {
-	tc := NewTelemetryIngressBatchClient(url, serverPubKeyHex, ks, logging, logger.TestLogger(t), 100, 50, sendInterval, time.Second, uniconn)
+	tc := NewTelemetryIngressBatchClient(url, serverPubKeyHex, ks, logging, logger.TestLogger(t), 100, 50, sendInterval, time.Second, uniconn, TelemetrySpoolConfig{})
+	tc.(*telemetryIngressBatchClient).close = func() error { return nil }
+	tc.(*telemetryIngressBatchClient).telemClient = telemClient
+	return tc
+}
+
+// NewTestTelemetryIngressBatchClientWithSpool is like NewTestTelemetryIngressBatchClient, but spools telemetry to dir.
+func NewTestTelemetryIngressBatchClientWithSpool(t *testing.T, url *url.URL, serverPubKeyHex string, ks keystore.CSA, telemClient telemPb.TelemClient, sendInterval time.Duration, dir string) TelemetryIngressBatchClient {
+	spoolCfg := TelemetrySpoolConfig{Enabled: true, Dir: dir, MaxSize: utils.MB, SegmentSize: utils.KB}
+	tc := NewTelemetryIngressBatchClient(url, serverPubKeyHex, ks, false, logger.TestLogger(t), 100, 50, sendInterval, time.Second, false, spoolCfg)
 	tc.(*telemetryIngressBatchClient).close = func() error { return nil }
 	tc.(*telemetryIngressBatchClient).telemClient = telemClient
 	return tc
@@ -0,0 +1,298 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: telem_mercury_transmit.proto
+
+package telem
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MercuryTransmitStatus int32
+
+const (
+	MercuryTransmitStatus_SUCCESS          MercuryTransmitStatus = 0
+	MercuryTransmitStatus_DUPLICATE        MercuryTransmitStatus = 1
+	MercuryTransmitStatus_SERVER_ERROR     MercuryTransmitStatus = 2
+	MercuryTransmitStatus_CONNECTION_ERROR MercuryTransmitStatus = 3
+)
+
+// Enum value maps for MercuryTransmitStatus.
+var (
+	MercuryTransmitStatus_name = map[int32]string{
+		0: "SUCCESS",
+		1: "DUPLICATE",
+		2: "SERVER_ERROR",
+		3: "CONNECTION_ERROR",
+	}
+	MercuryTransmitStatus_value = map[string]int32{
+		"SUCCESS":          0,
+		"DUPLICATE":        1,
+		"SERVER_ERROR":     2,
+		"CONNECTION_ERROR": 3,
+	}
+)
+
+func (x MercuryTransmitStatus) Enum() *MercuryTransmitStatus {
+	p := new(MercuryTransmitStatus)
+	*p = x
+	return p
+}
+
+func (x MercuryTransmitStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MercuryTransmitStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_telem_mercury_transmit_proto_enumTypes[0].Descriptor()
+}
+
+func (MercuryTransmitStatus) Type() protoreflect.EnumType {
+	return &file_telem_mercury_transmit_proto_enumTypes[0]
+}
+
+func (x MercuryTransmitStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MercuryTransmitStatus.Descriptor instead.
+func (MercuryTransmitStatus) EnumDescriptor() ([]byte, []int) {
+	return file_telem_mercury_transmit_proto_rawDescGZIP(), []int{0}
+}
+
+type MercuryTransmitResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FeedId       string                `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+	ServerUrl    string                `protobuf:"bytes,2,opt,name=server_url,json=serverUrl,proto3" json:"server_url,omitempty"`
+	ConfigDigest string                `protobuf:"bytes,3,opt,name=config_digest,json=configDigest,proto3" json:"config_digest,omitempty"`
+	Epoch        int64                 `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Round        int64                 `protobuf:"varint,5,opt,name=round,proto3" json:"round,omitempty"`
+	Status       MercuryTransmitStatus `protobuf:"varint,6,opt,name=status,proto3,enum=telem.MercuryTransmitStatus" json:"status,omitempty"`
+	Code         int32                 `protobuf:"varint,7,opt,name=code,proto3" json:"code,omitempty"`
+	Error        string                `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	LatencyMs    int64                 `protobuf:"varint,9,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	Timestamp    int64                 `protobuf:"varint,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *MercuryTransmitResult) Reset() {
+	*x = MercuryTransmitResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telem_mercury_transmit_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MercuryTransmitResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MercuryTransmitResult) ProtoMessage() {}
+
+func (x *MercuryTransmitResult) ProtoReflect() protoreflect.Message {
+	mi := &file_telem_mercury_transmit_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MercuryTransmitResult.ProtoReflect.Descriptor instead.
+func (*MercuryTransmitResult) Descriptor() ([]byte, []int) {
+	return file_telem_mercury_transmit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MercuryTransmitResult) GetFeedId() string {
+	if x != nil {
+		return x.FeedId
+	}
+	return ""
+}
+
+func (x *MercuryTransmitResult) GetServerUrl() string {
+	if x != nil {
+		return x.ServerUrl
+	}
+	return ""
+}
+
+func (x *MercuryTransmitResult) GetConfigDigest() string {
+	if x != nil {
+		return x.ConfigDigest
+	}
+	return ""
+}
+
+func (x *MercuryTransmitResult) GetEpoch() int64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *MercuryTransmitResult) GetRound() int64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *MercuryTransmitResult) GetStatus() MercuryTransmitStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MercuryTransmitStatus_SUCCESS
+}
+
+func (x *MercuryTransmitResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *MercuryTransmitResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MercuryTransmitResult) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *MercuryTransmitResult) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+var File_telem_mercury_transmit_proto protoreflect.FileDescriptor
+
+var file_telem_mercury_transmit_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x5f, 0x6d, 0x65, 0x72, 0x63, 0x75, 0x72, 0x79, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x22, 0xbd, 0x02, 0x0a, 0x15, 0x4d, 0x65, 0x72, 0x63, 0x75, 0x72,
+	0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x65, 0x65, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d,
+	0x2e, 0x4d, 0x65, 0x72, 0x63, 0x75, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2a, 0x5b, 0x0a, 0x15, 0x4d, 0x65, 0x72, 0x63, 0x75, 0x72, 0x79,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b,
+	0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x44,
+	0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45,
+	0x52, 0x56, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10,
+	0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x10, 0x03, 0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6b, 0x69,
+	0x74, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x2f, 0x76, 0x32, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x79, 0x6e,
+	0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x74, 0x65, 0x6c,
+	0x65, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_telem_mercury_transmit_proto_rawDescOnce sync.Once
+	file_telem_mercury_transmit_proto_rawDescData = file_telem_mercury_transmit_proto_rawDesc
+)
+
+func file_telem_mercury_transmit_proto_rawDescGZIP() []byte {
+	file_telem_mercury_transmit_proto_rawDescOnce.Do(func() {
+		file_telem_mercury_transmit_proto_rawDescData = protoimpl.X.CompressGZIP(file_telem_mercury_transmit_proto_rawDescData)
+	})
+	return file_telem_mercury_transmit_proto_rawDescData
+}
+
+var file_telem_mercury_transmit_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_telem_mercury_transmit_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_telem_mercury_transmit_proto_goTypes = []interface{}{
+	(MercuryTransmitStatus)(0),    // 0: telem.MercuryTransmitStatus
+	(*MercuryTransmitResult)(nil), // 1: telem.MercuryTransmitResult
+}
+var file_telem_mercury_transmit_proto_depIdxs = []int32{
+	0, // 0: telem.MercuryTransmitResult.status:type_name -> telem.MercuryTransmitStatus
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_telem_mercury_transmit_proto_init() }
+func file_telem_mercury_transmit_proto_init() {
+	if File_telem_mercury_transmit_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_telem_mercury_transmit_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MercuryTransmitResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_telem_mercury_transmit_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_telem_mercury_transmit_proto_goTypes,
+		DependencyIndexes: file_telem_mercury_transmit_proto_depIdxs,
+		EnumInfos:         file_telem_mercury_transmit_proto_enumTypes,
+		MessageInfos:      file_telem_mercury_transmit_proto_msgTypes,
+	}.Build()
+	File_telem_mercury_transmit_proto = out.File
+	file_telem_mercury_transmit_proto_rawDesc = nil
+	file_telem_mercury_transmit_proto_goTypes = nil
+	file_telem_mercury_transmit_proto_depIdxs = nil
+}
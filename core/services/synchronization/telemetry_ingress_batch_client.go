@@ -73,11 +73,16 @@ type telemetryIngressBatchClient struct {
 	workersMutex sync.Mutex
 
 	useUniConn bool
+
+	spoolCfg TelemetrySpoolConfig
+	spool    *telemetrySpool
 }
 
 // NewTelemetryIngressBatchClient returns a client backed by wsrpc that
-// can send telemetry to the telemetry ingress server
-func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, lggr logger.Logger, telemBufferSize uint, telemMaxBatchSize uint, telemSendInterval time.Duration, telemSendTimeout time.Duration, useUniconn bool) TelemetryIngressBatchClient {
+// can send telemetry to the telemetry ingress server. If the spool is enabled,
+// telemetry which cannot be buffered or sent is written to disk and replayed
+// later instead of being dropped.
+func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, lggr logger.Logger, telemBufferSize uint, telemMaxBatchSize uint, telemSendInterval time.Duration, telemSendTimeout time.Duration, useUniconn bool, spoolCfg TelemetrySpoolConfig) TelemetryIngressBatchClient {
 	return &telemetryIngressBatchClient{
 		telemBufferSize:   telemBufferSize,
 		telemMaxBatchSize: telemMaxBatchSize,
@@ -92,6 +97,7 @@ func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, ks key
 		chDone:            make(chan struct{}),
 		workers:           make(map[string]*telemetryIngressBatchWorker),
 		useUniConn:        useUniconn,
+		spoolCfg:          spoolCfg,
 	}
 }
 
@@ -142,15 +148,68 @@ func (tc *telemetryIngressBatchClient) Start(ctx context.Context) error {
 			}
 		}
 
+		if tc.spoolCfg.Enabled {
+			tc.spool, err = newTelemetrySpool(tc.spoolCfg, tc.lggr)
+			if err != nil {
+				return fmt.Errorf("could not start TelemIngressBatchClient: %w", err)
+			}
+			tc.wgDone.Add(1)
+			go tc.runReplay()
+		}
+
 		return nil
 	})
 }
 
+// runReplay periodically sends the spooled telemetry to the ingress server,
+// until the client is closed.
+func (tc *telemetryIngressBatchClient) runReplay() {
+	defer tc.wgDone.Done()
+
+	ctx, cancel := utils.StopChan(tc.chDone).NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(tc.telemSendInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if tc.spool.empty() || (tc.useUniConn && !tc.connected.Load()) {
+				continue
+			}
+			if err := tc.spool.replay(ctx, int(tc.telemMaxBatchSize), tc.sendBatch); err != nil {
+				if ctx.Err() == nil {
+					tc.lggr.Debugw("Could not replay spooled telemetry", "err", err)
+				}
+			}
+		case <-tc.chDone:
+			return
+		}
+	}
+}
+
+// sendBatch sends a batch of spooled telemetry to the ingress server.
+func (tc *telemetryIngressBatchClient) sendBatch(ctx context.Context, contractID string, telemType TelemetryType, telemetry [][]byte) error {
+	ctx, cancel := context.WithTimeout(ctx, tc.telemSendTimeout)
+	defer cancel()
+	_, err := tc.telemClient.TelemBatch(ctx, &telemPb.TelemBatchRequest{
+		ContractId:    contractID,
+		TelemetryType: string(telemType),
+		Telemetry:     telemetry,
+		SentAt:        time.Now().UnixNano(),
+	})
+	return err
+}
+
 // Close disconnects the wsrpc client from the ingress server and waits for all workers to exit
 func (tc *telemetryIngressBatchClient) Close() error {
 	return tc.StopOnce("TelemetryIngressBatchClient", func() error {
 		close(tc.chDone)
 		tc.wgDone.Wait()
+		if tc.spool != nil {
+			tc.spool.close()
+		}
 		if (tc.useUniConn && tc.connected.Load()) || !tc.useUniConn {
 			return tc.close()
 		}
@@ -180,10 +239,14 @@ func (tc *telemetryIngressBatchClient) getCSAPrivateKey() (privkey []byte, err e
 }
 
 // Send directs incoming telmetry messages to the worker responsible for pushing it to
-// the ingress server. If the worker telemetry buffer is full, messages are dropped
-// and a warning is logged.
+// the ingress server. If the worker telemetry buffer is full, messages are spooled to
+// disk if the spool is enabled, or else dropped and a warning is logged.
 func (tc *telemetryIngressBatchClient) Send(payload TelemPayload) {
 	if tc.useUniConn && !tc.connected.Load() {
+		if tc.spool != nil && tc.spool.write(payload.ContractID, payload.TelemType, payload.Telemetry) == nil {
+			return
+		}
+		telemetryDroppedCount.WithLabelValues(string(payload.TelemType)).Inc()
 		tc.lggr.Warnw("not connected to telemetry endpoint", "endpoint", tc.url.String())
 		return
 	}
@@ -194,6 +257,9 @@ func (tc *telemetryIngressBatchClient) Send(payload TelemPayload) {
 	case <-payload.Ctx.Done():
 		return
 	default:
+		if tc.spool != nil && tc.spool.write(payload.ContractID, payload.TelemType, payload.Telemetry) == nil {
+			return
+		}
 		worker.logBufferFullWithExpBackoff(payload)
 	}
 }
@@ -219,6 +285,7 @@ func (tc *telemetryIngressBatchClient) findOrCreateWorker(payload TelemPayload)
 			tc.globalLogger,
 			tc.logging,
 		)
+		worker.spool = tc.spool
 		worker.Start()
 		tc.workers[payload.ContractID] = worker
 	}
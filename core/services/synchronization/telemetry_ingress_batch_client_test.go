@@ -1,7 +1,10 @@
 package synchronization_test
 
 import (
+	"errors"
+	"fmt"
 	"net/url"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -106,3 +109,47 @@ func TestTelemetryIngressBatchClient_HappyPath(t *testing.T) {
 	// Client should shut down
 	telemIngressClient.Close()
 }
+
+func TestTelemetryIngressBatchClient_Spool(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	telemClient := mocks.NewTelemClient(t)
+	csaKeystore := new(ksmocks.CSA)
+	csaKeystore.On("GetAll").Return([]csakey.KeyV2{cltest.DefaultCSAKey}, nil)
+
+	sendInterval := time.Millisecond * 5
+	telemIngressClient := synchronization.NewTestTelemetryIngressBatchClientWithSpool(t, &url.URL{}, "33333333333", csaKeystore, telemClient, sendInterval, t.TempDir())
+	require.NoError(t, telemIngressClient.Start(testutils.Context(t)))
+
+	// The first batch fails to send and is spooled, then replayed
+	var mu sync.Mutex
+	var received []string
+	telemClient.On("TelemBatch", mock.Anything, mock.Anything).Return(nil, errors.New("connection refused")).Once()
+	telemClient.On("TelemBatch", mock.Anything, mock.Anything).Return(nil, nil).Run(func(args mock.Arguments) {
+		telemBatchReq := args.Get(1).(*telemPb.TelemBatchRequest)
+		assert.Equal(t, "0x1", telemBatchReq.ContractId)
+		assert.Equal(t, string(synchronization.OCR), telemBatchReq.TelemetryType)
+		mu.Lock()
+		defer mu.Unlock()
+		for _, telem := range telemBatchReq.Telemetry {
+			received = append(received, string(telem))
+		}
+	})
+
+	for i := 0; i < 3; i++ {
+		telemIngressClient.Send(synchronization.TelemPayload{
+			Ctx:        testutils.Context(t),
+			Telemetry:  []byte(fmt.Sprintf("Mock telem %d", i)),
+			ContractID: "0x1",
+			TelemType:  synchronization.OCR,
+		})
+	}
+
+	g.Eventually(func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string{}, received...)
+	}).Should(gomega.ConsistOf("Mock telem 0", "Mock telem 1", "Mock telem 2"))
+
+	require.NoError(t, telemIngressClient.Close())
+}
@@ -29,6 +29,7 @@ type telemetryIngressBatchWorker struct {
 	logging           bool
 	lggr              logger.Logger
 	dropMessageCount  atomic.Uint32
+	spool             *telemetrySpool
 }
 
 // NewTelemetryIngressBatchWorker returns a worker for a given contractID that can send
@@ -84,18 +85,41 @@ func (tw *telemetryIngressBatchWorker) Start() {
 
 				if err != nil {
 					tw.lggr.Warnf("Could not send telemetry: %v", err)
+					tw.spoolBatch(telemBatchReq.Telemetry)
 					continue
 				}
 				if tw.logging {
 					tw.lggr.Debugw("Successfully sent telemetry to ingress server", "contractID", telemBatchReq.ContractId, "telemType", telemBatchReq.TelemetryType, "telemetry", telemBatchReq.Telemetry)
 				}
 			case <-tw.chDone:
+				// Keep buffered telemetry on disk for the next time the node starts
+				if tw.spool != nil {
+					for len(tw.chTelemetry) > 0 {
+						tw.spoolBatch([][]byte{(<-tw.chTelemetry).Telemetry})
+					}
+				}
 				return
 			}
 		}
 	}()
 }
 
+// spoolBatch writes telemetry which could not be sent to the spool, or drops it if
+// the spool is disabled or full.
+func (tw *telemetryIngressBatchWorker) spoolBatch(telemBatch [][]byte) {
+	for i, telem := range telemBatch {
+		if tw.spool == nil {
+			telemetryDroppedCount.WithLabelValues(string(tw.telemType)).Add(float64(len(telemBatch)))
+			return
+		}
+		if err := tw.spool.write(tw.contractID, tw.telemType, telem); err != nil {
+			tw.lggr.Warnw("Could not spool telemetry, dropping it", "err", err, "droppedCount", len(telemBatch)-i)
+			telemetryDroppedCount.WithLabelValues(string(tw.telemType)).Add(float64(len(telemBatch) - i))
+			return
+		}
+	}
+}
+
 // logBufferFullWithExpBackoff logs messages at
 // 1
 // 2
@@ -109,6 +133,7 @@ func (tw *telemetryIngressBatchWorker) Start() {
 // 300
 // etc...
 func (tw *telemetryIngressBatchWorker) logBufferFullWithExpBackoff(payload TelemPayload) {
+	telemetryDroppedCount.WithLabelValues(string(tw.telemType)).Inc()
 	count := tw.dropMessageCount.Add(1)
 	if count > 0 && (count%100 == 0 || count&(count-1) == 0) {
 		tw.lggr.Warnw("telemetry ingress client buffer full, dropping message", "telemetry", payload.Telemetry, "droppedCount", count)
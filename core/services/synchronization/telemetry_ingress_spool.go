@@ -0,0 +1,375 @@
+package synchronization
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var (
+	telemetrySpooledCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "telemetry_ingress_spooled_count",
+		Help: "Number of telemetry messages written to the on-disk spool",
+	},
+		[]string{"telem_type"},
+	)
+	telemetryReplayedCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "telemetry_ingress_replayed_count",
+		Help: "Number of spooled telemetry messages sent to the ingress server",
+	},
+		[]string{"telem_type"},
+	)
+	telemetryDroppedCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "telemetry_ingress_dropped_count",
+		Help: "Number of telemetry messages dropped by the batch client",
+	},
+		[]string{"telem_type"},
+	)
+	telemetrySpoolSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "telemetry_ingress_spool_size_bytes",
+		Help: "Disk space used by the telemetry spool",
+	})
+)
+
+const (
+	spoolSegmentExt        = ".seg"
+	spoolRecordHeaderBytes = 8
+)
+
+var errSpoolFull = errors.New("telemetry spool is full")
+
+// TelemetrySpoolConfig configures the on-disk spool of the batch client.
+type TelemetrySpoolConfig struct {
+	Enabled bool
+	// Dir is the directory holding the spool segments.
+	Dir string
+	// MaxSize is the maximum disk space used by all segments.
+	MaxSize utils.FileSize
+	// SegmentSize is the size at which a new segment is started.
+	SegmentSize utils.FileSize
+}
+
+// spoolRecord is a single telemetry message stored in the spool.
+type spoolRecord struct {
+	contractID string
+	telemType  TelemetryType
+	telemetry  []byte
+	// end is the offset in the segment right after the record.
+	end int64
+}
+
+type spoolSegment struct {
+	seq  uint64
+	size int64
+}
+
+// telemetrySpool is a segmented append-only log of telemetry messages on disk.
+//
+// Messages are appended to the newest segment until it reaches the segment
+// size, and read back in order from the oldest segment. A segment is deleted
+// once all its messages have been replayed. The spool survives restarts of the
+// node, but messages which were replayed from a segment which was not yet
+// deleted may be replayed again.
+type telemetrySpool struct {
+	dir         string
+	maxSize     int64
+	segmentSize int64
+	lggr        logger.Logger
+
+	mu sync.Mutex
+	// segments are ordered from oldest to newest. The newest segment is
+	// written to if active is set.
+	segments   []spoolSegment
+	active     *os.File
+	size       int64
+	nextSeq    uint64
+	readOffset int64 // offset of the next record to replay in the oldest segment
+}
+
+func newTelemetrySpool(cfg TelemetrySpoolConfig, lggr logger.Logger) (*telemetrySpool, error) {
+	if err := utils.EnsureDirAndMaxPerms(cfg.Dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create telemetry spool directory: %w", err)
+	}
+	s := &telemetrySpool{
+		dir:         cfg.Dir,
+		maxSize:     int64(cfg.MaxSize),
+		segmentSize: int64(cfg.SegmentSize),
+		lggr:        lggr.Named("Spool"),
+	}
+
+	entries, err := os.ReadDir(cfg.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read telemetry spool directory: %w", err)
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, spoolSegmentExt) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, spoolSegmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat telemetry spool segment: %w", err)
+		}
+		s.segments = append(s.segments, spoolSegment{seq: seq, size: info.Size()})
+		s.size += info.Size()
+	}
+	sort.Slice(s.segments, func(i, j int) bool { return s.segments[i].seq < s.segments[j].seq })
+	if n := len(s.segments); n > 0 {
+		s.nextSeq = s.segments[n-1].seq + 1
+		s.lggr.Infow("Found spooled telemetry", "segments", n, "bytes", s.size)
+	}
+	telemetrySpoolSize.Set(float64(s.size))
+
+	return s, nil
+}
+
+func (s *telemetrySpool) segmentPath(seq uint64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%020d%s", seq, spoolSegmentExt))
+}
+
+// write appends a message to the spool, or returns errSpoolFull if it does not
+// fit within the maximum size.
+func (s *telemetrySpool) write(contractID string, telemType TelemetryType, telemetry []byte) error {
+	rec := encodeSpoolRecord(contractID, telemType, telemetry)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.size+int64(len(rec)) > s.maxSize {
+		return errSpoolFull
+	}
+	if s.active == nil || s.segments[len(s.segments)-1].size > 0 && s.segments[len(s.segments)-1].size+int64(len(rec)) > s.segmentSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.active.Write(rec)
+	last := &s.segments[len(s.segments)-1]
+	last.size += int64(n)
+	s.size += int64(n)
+	telemetrySpoolSize.Set(float64(s.size))
+	if err != nil {
+		// A partially written record is skipped on replay, so start a new segment.
+		s.seal()
+		return fmt.Errorf("failed to write telemetry spool segment: %w", err)
+	}
+	telemetrySpooledCount.WithLabelValues(string(telemType)).Inc()
+	return nil
+}
+
+// rotate seals the active segment and starts a new one. Must be called with
+// the lock held.
+func (s *telemetrySpool) rotate() error {
+	s.seal()
+	f, err := os.OpenFile(s.segmentPath(s.nextSeq), os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create telemetry spool segment: %w", err)
+	}
+	s.active = f
+	s.segments = append(s.segments, spoolSegment{seq: s.nextSeq})
+	s.nextSeq++
+	return nil
+}
+
+// seal stops writing to the active segment. Must be called with the lock held.
+func (s *telemetrySpool) seal() {
+	if s.active == nil {
+		return
+	}
+	if err := s.active.Close(); err != nil {
+		s.lggr.Warnw("Failed to close telemetry spool segment", "err", err)
+	}
+	s.active = nil
+}
+
+// empty returns true if there is nothing left to replay.
+func (s *telemetrySpool) empty() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.segments) == 0 || (len(s.segments) == 1 && s.segments[0].size <= s.readOffset)
+}
+
+// oldest returns the oldest segment and the offset to replay it from, sealing
+// it first if it is still being written to.
+func (s *telemetrySpool) oldest() (seg spoolSegment, offset int64, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.segments) == 0 {
+		return seg, 0, false
+	}
+	if len(s.segments) == 1 && s.active != nil {
+		s.seal()
+	}
+	return s.segments[0], s.readOffset, true
+}
+
+func (s *telemetrySpool) advance(offset int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.readOffset = offset
+}
+
+// removeOldest deletes the oldest segment after it has been replayed.
+func (s *telemetrySpool) removeOldest() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	seg := s.segments[0]
+	if err := os.Remove(s.segmentPath(seg.seq)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove telemetry spool segment: %w", err)
+	}
+	s.segments = s.segments[1:]
+	s.size -= seg.size
+	s.readOffset = 0
+	telemetrySpoolSize.Set(float64(s.size))
+	return nil
+}
+
+// replay sends the spooled messages in the order they were written, in batches
+// of consecutive messages for the same contract and telemetry type of up to
+// maxBatchSize messages. It stops at the first error returned by send, and
+// resumes after the last batch which was sent on the next call.
+//
+// replay must not be called concurrently.
+func (s *telemetrySpool) replay(ctx context.Context, maxBatchSize int, send func(ctx context.Context, contractID string, telemType TelemetryType, telemetry [][]byte) error) error {
+	for {
+		seg, offset, ok := s.oldest()
+		if !ok {
+			return nil
+		}
+
+		records, err := s.readSegment(seg.seq, offset)
+		if err != nil {
+			return err
+		}
+
+		for len(records) > 0 {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			n := 1
+			for n < len(records) && n < maxBatchSize &&
+				records[n].contractID == records[0].contractID && records[n].telemType == records[0].telemType {
+				n++
+			}
+			batch := make([][]byte, n)
+			for i := range batch {
+				batch[i] = records[i].telemetry
+			}
+			if err := send(ctx, records[0].contractID, records[0].telemType, batch); err != nil {
+				return err
+			}
+			telemetryReplayedCount.WithLabelValues(string(records[0].telemType)).Add(float64(n))
+			s.advance(records[n-1].end)
+			records = records[n:]
+		}
+
+		if err := s.removeOldest(); err != nil {
+			return err
+		}
+	}
+}
+
+// readSegment reads the records of a sealed segment from offset. A truncated
+// or corrupt record ends the segment.
+func (s *telemetrySpool) readSegment(seq uint64, offset int64) ([]spoolRecord, error) {
+	b, err := os.ReadFile(s.segmentPath(seq))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read telemetry spool segment: %w", err)
+	}
+
+	var records []spoolRecord
+	for pos := offset; pos < int64(len(b)); {
+		rec, n, err := decodeSpoolRecord(b[pos:])
+		if err != nil {
+			s.lggr.Warnw("Skipping the rest of a corrupt telemetry spool segment", "segment", seq, "offset", pos, "err", err)
+			break
+		}
+		pos += n
+		rec.end = pos
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// close stops writing to the spool. Spooled messages are kept on disk.
+func (s *telemetrySpool) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seal()
+}
+
+// encodeSpoolRecord encodes a message as a length and CRC32 checksum followed
+// by the length prefixed contract ID and telemetry type and the telemetry.
+func encodeSpoolRecord(contractID string, telemType TelemetryType, telemetry []byte) []byte {
+	body := make([]byte, 0, 2*binary.MaxVarintLen64+len(contractID)+len(telemType)+len(telemetry))
+	body = binary.AppendUvarint(body, uint64(len(contractID)))
+	body = append(body, contractID...)
+	body = binary.AppendUvarint(body, uint64(len(telemType)))
+	body = append(body, telemType...)
+	body = append(body, telemetry...)
+
+	rec := make([]byte, spoolRecordHeaderBytes, spoolRecordHeaderBytes+len(body))
+	binary.BigEndian.PutUint32(rec[0:4], uint32(len(body)))
+	binary.BigEndian.PutUint32(rec[4:8], crc32.ChecksumIEEE(body))
+	return append(rec, body...)
+}
+
+// decodeSpoolRecord decodes the record at the start of b and returns it with
+// its encoded length.
+func decodeSpoolRecord(b []byte) (rec spoolRecord, n int64, err error) {
+	if len(b) < spoolRecordHeaderBytes {
+		return rec, 0, errors.New("truncated record header")
+	}
+	l := int64(binary.BigEndian.Uint32(b[0:4]))
+	if int64(len(b)-spoolRecordHeaderBytes) < l {
+		return rec, 0, errors.New("truncated record")
+	}
+	body := b[spoolRecordHeaderBytes : spoolRecordHeaderBytes+l]
+	if crc32.ChecksumIEEE(body) != binary.BigEndian.Uint32(b[4:8]) {
+		return rec, 0, errors.New("checksum mismatch")
+	}
+
+	contractID, body, err := readSpoolField(body)
+	if err != nil {
+		return rec, 0, err
+	}
+	telemType, body, err := readSpoolField(body)
+	if err != nil {
+		return rec, 0, err
+	}
+	rec = spoolRecord{
+		contractID: string(contractID),
+		telemType:  TelemetryType(telemType),
+		telemetry:  body,
+	}
+	return rec, spoolRecordHeaderBytes + l, nil
+}
+
+func readSpoolField(b []byte) (field []byte, rest []byte, err error) {
+	l, n := binary.Uvarint(b)
+	if n <= 0 || uint64(len(b)-n) < l {
+		return nil, nil, errors.New("invalid record field")
+	}
+	return b[n : n+int(l)], b[n+int(l):], nil
+}
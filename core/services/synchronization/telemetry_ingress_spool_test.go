@@ -0,0 +1,177 @@
+package synchronization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+type sentBatch struct {
+	contractID string
+	telemType  TelemetryType
+	telemetry  []string
+}
+
+func recordBatches(sent *[]sentBatch) func(context.Context, string, TelemetryType, [][]byte) error {
+	return func(_ context.Context, contractID string, telemType TelemetryType, telemetry [][]byte) error {
+		b := sentBatch{contractID: contractID, telemType: telemType}
+		for _, telem := range telemetry {
+			b.telemetry = append(b.telemetry, string(telem))
+		}
+		*sent = append(*sent, b)
+		return nil
+	}
+}
+
+func newTestSpool(t *testing.T, dir string, maxSize, segmentSize utils.FileSize) *telemetrySpool {
+	s, err := newTelemetrySpool(TelemetrySpoolConfig{Enabled: true, Dir: dir, MaxSize: maxSize, SegmentSize: segmentSize}, logger.TestLogger(t))
+	require.NoError(t, err)
+	t.Cleanup(s.close)
+	return s
+}
+
+func TestTelemetrySpool_WriteReplay(t *testing.T) {
+	dir := t.TempDir()
+	s := newTestSpool(t, dir, utils.MB, 128)
+	assert.True(t, s.empty())
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, s.write("0x1", OCR, []byte(fmt.Sprintf("telem %d", i))))
+	}
+	require.NoError(t, s.write("0x2", OCR2Median, []byte("telem 10")))
+	require.NoError(t, s.write("0x1", OCR, []byte("telem 11")))
+	assert.False(t, s.empty())
+
+	segments, err := filepath.Glob(filepath.Join(dir, "*"+spoolSegmentExt))
+	require.NoError(t, err)
+	assert.Greater(t, len(segments), 1, "messages should be written to several segments")
+
+	var sent []sentBatch
+	require.NoError(t, s.replay(testutils.Context(t), 4, recordBatches(&sent)))
+
+	var replayed []string
+	for _, b := range sent {
+		assert.LessOrEqual(t, len(b.telemetry), 4)
+		replayed = append(replayed, b.telemetry...)
+	}
+	require.Len(t, replayed, 12)
+	for i, telem := range replayed {
+		assert.Equal(t, fmt.Sprintf("telem %d", i), telem)
+	}
+	assert.Equal(t, sentBatch{contractID: "0x2", telemType: OCR2Median, telemetry: []string{"telem 10"}}, sent[len(sent)-2])
+
+	assert.True(t, s.empty())
+	segments, err = filepath.Glob(filepath.Join(dir, "*"+spoolSegmentExt))
+	require.NoError(t, err)
+	assert.Empty(t, segments)
+}
+
+func TestTelemetrySpool_ReplayResumesAfterError(t *testing.T) {
+	s := newTestSpool(t, t.TempDir(), utils.MB, utils.KB)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, s.write("0x1", OCR, []byte(fmt.Sprintf("telem %d", i))))
+	}
+
+	var sent []sentBatch
+	record := recordBatches(&sent)
+	calls := 0
+	err := s.replay(testutils.Context(t), 2, func(ctx context.Context, contractID string, telemType TelemetryType, telemetry [][]byte) error {
+		calls++
+		if calls == 2 {
+			return errors.New("connection refused")
+		}
+		return record(ctx, contractID, telemType, telemetry)
+	})
+	require.EqualError(t, err, "connection refused")
+	require.Len(t, sent, 1)
+	assert.False(t, s.empty())
+
+	// Messages written in the meantime are replayed after the older ones
+	require.NoError(t, s.write("0x1", OCR, []byte("telem 5")))
+
+	require.NoError(t, s.replay(testutils.Context(t), 2, record))
+	var replayed []string
+	for _, b := range sent {
+		replayed = append(replayed, b.telemetry...)
+	}
+	assert.Equal(t, []string{"telem 0", "telem 1", "telem 2", "telem 3", "telem 4", "telem 5"}, replayed)
+}
+
+func TestTelemetrySpool_MaxSize(t *testing.T) {
+	rec := encodeSpoolRecord("0x1", OCR, []byte("telem"))
+	s := newTestSpool(t, t.TempDir(), utils.FileSize(3*len(rec)), utils.FileSize(len(rec)))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, s.write("0x1", OCR, []byte("telem")))
+	}
+	assert.ErrorIs(t, s.write("0x1", OCR, []byte("telem")), errSpoolFull)
+
+	// Replaying frees up space
+	var sent []sentBatch
+	require.NoError(t, s.replay(testutils.Context(t), 50, recordBatches(&sent)))
+	require.NoError(t, s.write("0x1", OCR, []byte("telem")))
+}
+
+func TestTelemetrySpool_Reopen(t *testing.T) {
+	dir := t.TempDir()
+	s := newTestSpool(t, dir, utils.MB, 64)
+	for i := 0; i < 4; i++ {
+		require.NoError(t, s.write("0x1", OCR, []byte(fmt.Sprintf("telem %d", i))))
+	}
+	s.close()
+
+	// Simulate a crash in the middle of writing a record
+	segments, err := filepath.Glob(filepath.Join(dir, "*"+spoolSegmentExt))
+	require.NoError(t, err)
+	require.NotEmpty(t, segments)
+	last := segments[len(segments)-1]
+	rec := encodeSpoolRecord("0x1", OCR, []byte("torn"))
+	f, err := os.OpenFile(last, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.Write(rec[:len(rec)-2])
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	s = newTestSpool(t, dir, utils.MB, 64)
+	assert.False(t, s.empty())
+	require.NoError(t, s.write("0x1", OCR, []byte("telem 4")))
+
+	var sent []sentBatch
+	require.NoError(t, s.replay(testutils.Context(t), 50, recordBatches(&sent)))
+	var replayed []string
+	for _, b := range sent {
+		replayed = append(replayed, b.telemetry...)
+	}
+	assert.Equal(t, []string{"telem 0", "telem 1", "telem 2", "telem 3", "telem 4"}, replayed)
+}
+
+func Test_decodeSpoolRecord(t *testing.T) {
+	rec := encodeSpoolRecord("0xa", OCR2Mercury, []byte("telemetry"))
+
+	decoded, n, err := decodeSpoolRecord(rec)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(rec)), n)
+	assert.Equal(t, "0xa", decoded.contractID)
+	assert.Equal(t, OCR2Mercury, decoded.telemType)
+	assert.Equal(t, []byte("telemetry"), decoded.telemetry)
+
+	_, _, err = decodeSpoolRecord(rec[:spoolRecordHeaderBytes-1])
+	assert.EqualError(t, err, "truncated record header")
+	_, _, err = decodeSpoolRecord(rec[:len(rec)-1])
+	assert.EqualError(t, err, "truncated record")
+
+	corrupt := append([]byte{}, rec...)
+	corrupt[len(corrupt)-1] ^= 0xff
+	_, _, err = decodeSpoolRecord(corrupt)
+	assert.EqualError(t, err, "checksum mismatch")
+}
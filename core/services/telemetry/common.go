@@ -7,5 +7,5 @@ import (
 )
 
 type MonitoringEndpointGenerator interface {
-	GenMonitoringEndpoint(contractID string, telemType synchronization.TelemetryType) ocrtypes.MonitoringEndpoint
+	GenMonitoringEndpoint(contractID string, telemType synchronization.TelemetryType, jobID int32, chainID string) ocrtypes.MonitoringEndpoint
 }
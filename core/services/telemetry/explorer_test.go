@@ -14,7 +14,7 @@ import (
 func TestExplorerAgent(t *testing.T) {
 	explorerClient := mocks.NewExplorerClient(t)
 	explorerAgent := telemetry.NewExplorerAgent(explorerClient)
-	monitoringEndpoint := explorerAgent.GenMonitoringEndpoint("0xa", synchronization.OCR)
+	monitoringEndpoint := explorerAgent.GenMonitoringEndpoint("0xa", synchronization.OCR, 1, "1337")
 
 	// Handle the Send call and store the logs
 	var sentLog []byte
@@ -21,7 +21,7 @@ func NewIngressAgentBatchWrapper(telemetryIngressBatchClient synchronization.Tel
 }
 
 // GenMonitoringEndpoint returns a new ingress batch agent instantiated with the batch client and a contractID
-func (t *IngressAgentBatchWrapper) GenMonitoringEndpoint(contractID string, telemType synchronization.TelemetryType) ocrtypes.MonitoringEndpoint {
+func (t *IngressAgentBatchWrapper) GenMonitoringEndpoint(contractID string, telemType synchronization.TelemetryType, jobID int32, chainID string) ocrtypes.MonitoringEndpoint {
 	return NewIngressAgentBatch(t.telemetryIngressBatchClient, contractID, telemType)
 }
 
@@ -16,6 +16,6 @@ func (t *NoopAgent) SendLog(log []byte) {
 }
 
 // GenMonitoringEndpoint creates a monitoring endpoint for telemetry
-func (t *NoopAgent) GenMonitoringEndpoint(contractID string, telemType synchronization.TelemetryType) ocrtypes.MonitoringEndpoint {
+func (t *NoopAgent) GenMonitoringEndpoint(contractID string, telemType synchronization.TelemetryType, jobID int32, chainID string) ocrtypes.MonitoringEndpoint {
 	return t
 }
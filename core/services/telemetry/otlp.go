@@ -0,0 +1,286 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	ocrtypes "github.com/smartcontractkit/libocr/commontypes"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
+	"github.com/smartcontractkit/chainlink/v2/core/static"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// OTLP log record attributes which tag every telemetry message.
+const (
+	OTLPAttrContractID    = "contract_id"
+	OTLPAttrTelemetryType = "telemetry_type"
+	OTLPAttrJobID         = "job_id"
+	OTLPAttrChainID       = "chain_id"
+)
+
+// OTLPConfig configures the OTLP exporter.
+type OTLPConfig struct {
+	// Endpoint is a host:port for the grpc protocol, or the URL of the logs
+	// endpoint for the http protocol.
+	Endpoint     string
+	Protocol     string
+	Insecure     bool
+	BufferSize   uint
+	MaxBatchSize uint
+	SendInterval time.Duration
+	SendTimeout  time.Duration
+}
+
+// otlpLogsClient sends export requests to an OTLP collector.
+type otlpLogsClient interface {
+	export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error
+	close() error
+}
+
+var _ MonitoringEndpointGenerator = &OTLPExporter{}
+var _ services.ServiceCtx = &OTLPExporter{}
+
+// OTLPExporter exports telemetry as OTLP log records to a collector. It is both
+// a service which batches and sends the records, and a
+// MonitoringEndpointGenerator for the endpoints which produce them.
+type OTLPExporter struct {
+	utils.StartStopOnce
+	cfg  OTLPConfig
+	lggr logger.Logger
+
+	client    otlpLogsClient
+	resource  *resourcepb.Resource
+	chRecords chan *logspb.LogRecord
+	chStop    utils.StopChan
+	wg        sync.WaitGroup
+
+	dropMessageCount atomic.Uint32
+}
+
+// NewOTLPExporter returns an exporter which sends telemetry to the collector at cfg.Endpoint
+func NewOTLPExporter(cfg OTLPConfig, lggr logger.Logger) (*OTLPExporter, error) {
+	var client otlpLogsClient
+	switch cfg.Protocol {
+	case "grpc":
+		creds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+		if cfg.Insecure {
+			creds = insecure.NewCredentials()
+		}
+		// Dial does not block, the connection is established on the first export
+		conn, err := grpc.Dial(cfg.Endpoint, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return nil, fmt.Errorf("failed to dial OTLP collector: %w", err)
+		}
+		client = &otlpGRPCClient{conn: conn, client: collogspb.NewLogsServiceClient(conn)}
+	case "http":
+		client = &otlpHTTPClient{url: cfg.Endpoint, client: &http.Client{}}
+	default:
+		return nil, fmt.Errorf("unsupported OTLP protocol %q", cfg.Protocol)
+	}
+	return newOTLPExporter(cfg, client, lggr), nil
+}
+
+func newOTLPExporter(cfg OTLPConfig, client otlpLogsClient, lggr logger.Logger) *OTLPExporter {
+	return &OTLPExporter{
+		cfg:       cfg,
+		lggr:      lggr.Named("OTLPExporter"),
+		client:    client,
+		chRecords: make(chan *logspb.LogRecord, cfg.BufferSize),
+		chStop:    make(chan struct{}),
+		resource: &resourcepb.Resource{Attributes: []*commonpb.KeyValue{
+			stringAttr("service.name", "chainlink"),
+			stringAttr("service.version", static.Version),
+		}},
+	}
+}
+
+// Start exports batches of buffered telemetry on an interval
+func (e *OTLPExporter) Start(context.Context) error {
+	return e.StartOnce("OTLPExporter", func() error {
+		e.wg.Add(1)
+		go e.run()
+		return nil
+	})
+}
+
+// Close stops exporting telemetry and closes the connection to the collector
+func (e *OTLPExporter) Close() error {
+	return e.StopOnce("OTLPExporter", func() error {
+		close(e.chStop)
+		e.wg.Wait()
+		return e.client.close()
+	})
+}
+
+func (e *OTLPExporter) Name() string {
+	return e.lggr.Name()
+}
+
+func (e *OTLPExporter) HealthReport() map[string]error {
+	return map[string]error{e.Name(): e.StartStopOnce.Healthy()}
+}
+
+func (e *OTLPExporter) run() {
+	defer e.wg.Done()
+	ticker := time.NewTicker(e.cfg.SendInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			for len(e.chRecords) > 0 {
+				n, err := e.exportBatch()
+				if err != nil {
+					e.lggr.Warnw("Could not export telemetry", "err", err)
+					break
+				} else if n == 0 {
+					break
+				}
+			}
+		case <-e.chStop:
+			return
+		}
+	}
+}
+
+// exportBatch reads up to MaxBatchSize records off the buffer and exports them,
+// returning the number of records exported. Nothing is sent if no records
+// were read.
+func (e *OTLPExporter) exportBatch() (int, error) {
+	var records []*logspb.LogRecord
+	for len(e.chRecords) > 0 && len(records) < int(e.cfg.MaxBatchSize) {
+		records = append(records, <-e.chRecords)
+	}
+	if len(records) == 0 {
+		return 0, nil
+	}
+
+	req := &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: e.resource,
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope:      &commonpb.InstrumentationScope{Name: "chainlink/telemetry", Version: static.Version},
+				LogRecords: records,
+			}},
+		}},
+	}
+	ctx, cancel := e.chStop.CtxCancel(context.WithTimeout(context.Background(), e.cfg.SendTimeout))
+	defer cancel()
+	return len(records), e.client.export(ctx, req)
+}
+
+// send buffers a telemetry message. If the buffer is full, the message is
+// dropped and a warning is logged.
+func (e *OTLPExporter) send(record *logspb.LogRecord) {
+	select {
+	case e.chRecords <- record:
+		e.dropMessageCount.Store(0)
+	default:
+		count := e.dropMessageCount.Add(1)
+		if count > 0 && (count%100 == 0 || count&(count-1) == 0) {
+			e.lggr.Warnw("OTLP exporter buffer full, dropping message", "droppedCount", count)
+		}
+	}
+}
+
+// GenMonitoringEndpoint returns an endpoint which exports telemetry tagged with
+// the contract, telemetry type, job and chain.
+func (e *OTLPExporter) GenMonitoringEndpoint(contractID string, telemType synchronization.TelemetryType, jobID int32, chainID string) ocrtypes.MonitoringEndpoint {
+	return &OTLPAgent{
+		exporter: e,
+		attributes: []*commonpb.KeyValue{
+			stringAttr(OTLPAttrContractID, contractID),
+			stringAttr(OTLPAttrTelemetryType, string(telemType)),
+			{Key: OTLPAttrJobID, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(jobID)}}},
+			stringAttr(OTLPAttrChainID, chainID),
+		},
+	}
+}
+
+// OTLPAgent sends the telemetry of a single contract to the OTLP exporter
+type OTLPAgent struct {
+	exporter   *OTLPExporter
+	attributes []*commonpb.KeyValue
+}
+
+// SendLog exports a telemetry message as an OTLP log record
+func (a *OTLPAgent) SendLog(telemetry []byte) {
+	now := uint64(time.Now().UnixNano())
+	a.exporter.send(&logspb.LogRecord{
+		TimeUnixNano:         now,
+		ObservedTimeUnixNano: now,
+		SeverityNumber:       logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+		Body:                 &commonpb.AnyValue{Value: &commonpb.AnyValue_BytesValue{BytesValue: telemetry}},
+		Attributes:           a.attributes,
+	})
+}
+
+func stringAttr(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}
+}
+
+type otlpGRPCClient struct {
+	conn   *grpc.ClientConn
+	client collogspb.LogsServiceClient
+}
+
+func (c *otlpGRPCClient) export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error {
+	_, err := c.client.Export(ctx, req)
+	return err
+}
+
+func (c *otlpGRPCClient) close() error {
+	return c.conn.Close()
+}
+
+type otlpHTTPClient struct {
+	url    string
+	client *http.Client
+}
+
+func (c *otlpHTTPClient) export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error {
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal export request: %w", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/x-protobuf")
+
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// Drain the body so that the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("OTLP collector returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (c *otlpHTTPClient) close() error {
+	c.client.CloseIdleConnections()
+	return nil
+}
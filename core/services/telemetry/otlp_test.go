@@ -0,0 +1,152 @@
+package telemetry_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
+	"github.com/smartcontractkit/chainlink/v2/core/services/telemetry"
+)
+
+// otlpReceiver collects the log records exported to it
+type otlpReceiver struct {
+	collogspb.UnimplementedLogsServiceServer
+
+	mu      sync.Mutex
+	records []*logspb.LogRecord
+}
+
+func (r *otlpReceiver) Export(_ context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, rl := range req.ResourceLogs {
+		for _, sl := range rl.ScopeLogs {
+			r.records = append(r.records, sl.LogRecords...)
+		}
+	}
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+func (r *otlpReceiver) Records() []*logspb.LogRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*logspb.LogRecord{}, r.records...)
+}
+
+func newGRPCReceiver(t *testing.T) (*otlpReceiver, string) {
+	receiver := &otlpReceiver{}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	collogspb.RegisterLogsServiceServer(srv, receiver)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+	return receiver, lis.Addr().String()
+}
+
+func newHTTPReceiver(t *testing.T) (*otlpReceiver, string) {
+	receiver := &otlpReceiver{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/logs", r.URL.Path)
+		assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var req collogspb.ExportLogsServiceRequest
+		require.NoError(t, proto.Unmarshal(body, &req))
+		_, err = receiver.Export(r.Context(), &req)
+		require.NoError(t, err)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+	return receiver, srv.URL + "/v1/logs"
+}
+
+func TestOTLPExporter(t *testing.T) {
+	for _, tc := range []struct {
+		protocol    string
+		newReceiver func(t *testing.T) (*otlpReceiver, string)
+	}{
+		{"grpc", newGRPCReceiver},
+		{"http", newHTTPReceiver},
+	} {
+		tc := tc
+		t.Run(tc.protocol, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			receiver, endpoint := tc.newReceiver(t)
+
+			exporter, err := telemetry.NewOTLPExporter(telemetry.OTLPConfig{
+				Endpoint:     endpoint,
+				Protocol:     tc.protocol,
+				Insecure:     true,
+				BufferSize:   100,
+				MaxBatchSize: 2,
+				SendInterval: 10 * time.Millisecond,
+				SendTimeout:  time.Second,
+			}, logger.TestLogger(t))
+			require.NoError(t, err)
+			require.NoError(t, exporter.Start(testutils.Context(t)))
+			t.Cleanup(func() { assert.NoError(t, exporter.Close()) })
+
+			ocrEndpoint := exporter.GenMonitoringEndpoint("0xa", synchronization.OCR, 42, "1337")
+			mercuryEndpoint := exporter.GenMonitoringEndpoint("0xb", synchronization.OCR2Mercury, 43, "5")
+			ocrEndpoint.SendLog([]byte("ocr 1"))
+			ocrEndpoint.SendLog([]byte("ocr 2"))
+			mercuryEndpoint.SendLog([]byte("mercury 1"))
+
+			g.Eventually(func() int { return len(receiver.Records()) }).Should(gomega.Equal(3))
+
+			for _, record := range receiver.Records() {
+				attrs := map[string]interface{}{}
+				for _, kv := range record.Attributes {
+					switch v := kv.Value.Value.(type) {
+					case *commonpb.AnyValue_StringValue:
+						attrs[kv.Key] = v.StringValue
+					case *commonpb.AnyValue_IntValue:
+						attrs[kv.Key] = v.IntValue
+					}
+				}
+				switch body := string(record.Body.GetBytesValue()); body {
+				case "ocr 1", "ocr 2":
+					assert.Equal(t, map[string]interface{}{
+						telemetry.OTLPAttrContractID:    "0xa",
+						telemetry.OTLPAttrTelemetryType: string(synchronization.OCR),
+						telemetry.OTLPAttrJobID:         int64(42),
+						telemetry.OTLPAttrChainID:       "1337",
+					}, attrs)
+				case "mercury 1":
+					assert.Equal(t, map[string]interface{}{
+						telemetry.OTLPAttrContractID:    "0xb",
+						telemetry.OTLPAttrTelemetryType: string(synchronization.OCR2Mercury),
+						telemetry.OTLPAttrJobID:         int64(43),
+						telemetry.OTLPAttrChainID:       "5",
+					}, attrs)
+				default:
+					t.Errorf("unexpected record body %q", body)
+				}
+				assert.NotZero(t, record.TimeUnixNano)
+			}
+		})
+	}
+}
+
+func TestOTLPExporter_UnsupportedProtocol(t *testing.T) {
+	_, err := telemetry.NewOTLPExporter(telemetry.OTLPConfig{Endpoint: "localhost:4317", Protocol: "udp"}, logger.TestLogger(t))
+	require.EqualError(t, err, `unsupported OTLP protocol "udp"`)
+}
@@ -829,7 +829,7 @@ func (lsn *listenerV2) processRequestsPerSub(
 						SubID:         &p.req.req.SubId,
 						RequestTxHash: &p.req.req.Raw.TxHash,
 					},
-					Strategy: txmgr.NewSendEveryStrategy(),
+					Strategy: txmgr.NewPriorityStrategy(txmgr.NewSendEveryStrategy(), lsn.job.TransactionPriority),
 					Checker: txmgr.EvmTransmitCheckerSpec{
 						CheckerType:           txmgr.TransmitCheckerTypeVRFV2,
 						VRFCoordinatorAddress: &coordinatorAddress,
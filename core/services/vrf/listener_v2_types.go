@@ -163,7 +163,7 @@ func (lsn *listenerV2) processBatch(
 			ToAddress:      lsn.batchCoordinator.Address(),
 			EncodedPayload: payload,
 			FeeLimit:       totalGasLimitBumped,
-			Strategy:       txmgr.NewSendEveryStrategy(),
+			Strategy:       txmgr.NewPriorityStrategy(txmgr.NewSendEveryStrategy(), lsn.job.TransactionPriority),
 			Meta: &txmgr.EthTxMeta{
 				RequestIDs:      reqIDHashes,
 				MaxLink:         &maxLinkStr,
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE eth_txes ADD COLUMN priority SMALLINT NOT NULL DEFAULT 0;
+CREATE INDEX idx_eth_txes_unstarted_priority ON eth_txes (evm_chain_id, from_address, priority DESC, created_at, id) WHERE state = 'unstarted'::eth_txes_state;
+ALTER TABLE jobs ADD COLUMN transaction_priority SMALLINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+
+-- +goose StatementBegin
+ALTER TABLE jobs DROP COLUMN transaction_priority;
+DROP INDEX idx_eth_txes_unstarted_priority;
+ALTER TABLE eth_txes DROP COLUMN priority;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE mercury_transmit_requests (
+    job_id INTEGER NOT NULL REFERENCES jobs(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    config_digest BYTEA NOT NULL CHECK (octet_length(config_digest) = 32),
+    epoch BIGINT NOT NULL,
+    round BIGINT NOT NULL,
+    extra_hash BYTEA NOT NULL CHECK (octet_length(extra_hash) = 32),
+    payload BYTEA NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (job_id, config_digest, epoch, round)
+);
+CREATE INDEX idx_mercury_transmit_requests_job_id_epoch_round ON mercury_transmit_requests (job_id, epoch DESC, round DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+
+-- +goose StatementBegin
+DROP TABLE mercury_transmit_requests;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE feeds_manager_auto_approval_policies (
+    feeds_manager_id INTEGER PRIMARY KEY REFERENCES feeds_managers ON DELETE CASCADE,
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    job_types TEXT[] NOT NULL DEFAULT '{}',
+    contract_addresses TEXT[] NOT NULL DEFAULT '{}',
+    evm_chain_ids TEXT[] NOT NULL DEFAULT '{}',
+    max_version_bump INTEGER NOT NULL DEFAULT 0 CHECK (max_version_bump >= 0),
+    time_windows JSONB NOT NULL DEFAULT '[]',
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE feeds_manager_auto_approval_policies;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE ocr2dr_requests
+    ADD COLUMN subscription_id bigint,
+    ADD COLUMN finalized_at timestamp with time zone,
+    ADD COLUMN confirmed_at timestamp with time zone,
+    ADD COLUMN timed_out_at timestamp with time zone;
+
+CREATE INDEX idx_ocr2dr_requests_received_at ON ocr2dr_requests (received_at);
+CREATE INDEX idx_ocr2dr_requests_subscription_id ON ocr2dr_requests (subscription_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_ocr2dr_requests_subscription_id;
+DROP INDEX IF EXISTS idx_ocr2dr_requests_received_at;
+
+ALTER TABLE ocr2dr_requests
+    DROP COLUMN subscription_id,
+    DROP COLUMN finalized_at,
+    DROP COLUMN confirmed_at,
+    DROP COLUMN timed_out_at;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE evm_reorgs (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    common_ancestor_number bigint,
+    common_ancestor_hash bytea CHECK (octet_length(common_ancestor_hash) = 32),
+    old_heads jsonb NOT NULL,
+    new_heads jsonb NOT NULL,
+    depth bigint NOT NULL CHECK (depth > 0),
+    detected_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_evm_reorgs_evm_chain_id_id ON evm_reorgs (evm_chain_id, id DESC);
+
+-- +goose Down
+DROP TABLE evm_reorgs;
@@ -0,0 +1,14 @@
+-- +goose Up
+
+CREATE TABLE evm_log_poller_subscriptions(
+    name TEXT NOT NULL CHECK (length(name) > 0),
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) DEFERRABLE INITIALLY IMMEDIATE,
+    block_number BIGINT NOT NULL,
+    log_index BIGINT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (evm_chain_id, name)
+);
+
+-- +goose Down
+
+DROP TABLE evm_log_poller_subscriptions;
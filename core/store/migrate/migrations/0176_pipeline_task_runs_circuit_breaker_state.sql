@@ -0,0 +1,7 @@
+-- +goose Up
+
+ALTER TABLE pipeline_task_runs ADD COLUMN circuit_breaker_state TEXT;
+
+-- +goose Down
+
+ALTER TABLE pipeline_task_runs DROP COLUMN circuit_breaker_state;
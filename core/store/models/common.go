@@ -366,6 +366,15 @@ type SendEtherRequest struct {
 	AllowHigherAmounts bool           `json:"allowHigherAmounts"`
 }
 
+// ReplaceEthTxRequest represents a request to rebroadcast an unconfirmed
+// transaction at a higher fee. Either GasPrice (legacy transactions) or
+// GasTipCap and GasFeeCap (EIP-1559 transactions) must be set.
+type ReplaceEthTxRequest struct {
+	GasPrice  *assets.Wei `json:"gasPrice"`
+	GasTipCap *assets.Wei `json:"gasTipCap"`
+	GasFeeCap *assets.Wei `json:"gasFeeCap"`
+}
+
 // AddressCollection is an array of common.Address
 // serializable to and from a database.
 type AddressCollection []common.Address
@@ -218,6 +218,7 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/transactions", true, true, true},
 	{"GET", "/v2/transactions/MOCK", true, true, true},
 	{"POST", "/v2/replay_from_block/MOCK", false, true, true},
+	{"GET", "/v2/reorgs", true, true, true},
 	{"GET", "/v2/keys/csa", true, true, true},
 	{"POST", "/v2/keys/csa", false, false, true},
 	{"POST", "/v2/keys/csa/import", false, false, false},
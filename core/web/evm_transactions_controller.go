@@ -2,9 +2,16 @@ package web
 
 import (
 	"database/sql"
+	"math/big"
 	"net/http"
+	"strconv"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -47,3 +54,126 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Cancel replaces an unconfirmed transaction with a zero-value self-send at
+// the same nonce, or errors out a transaction that was never broadcast. A
+// transaction that was never broadcast has no attempt hash, so it is given by
+// its ID instead.
+// Example:
+//
+//	"<application>/transactions/evm/:TxHash/cancel"
+//	"<application>/transactions/evm/:ID/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	etxID, hash, chain, ok := tc.findTxAndChain(c)
+	if !ok {
+		return
+	}
+
+	if err := chain.TxManager().CancelTransaction(etxID); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("failed to cancel transaction: %v", err))
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionCancelled, map[string]interface{}{
+		"ethTxID": etxID,
+		"txHash":  hash,
+	})
+
+	tc.renderTx(c, etxID)
+}
+
+// Replace rebroadcasts an unconfirmed transaction with the fee given in the
+// request body. The transaction is given by an attempt hash or its ID.
+// Example:
+//
+//	"<application>/transactions/evm/:TxHash/replace"
+//	"<application>/transactions/evm/:ID/replace"
+func (tc *TransactionsController) Replace(c *gin.Context) {
+	var rr models.ReplaceEthTxRequest
+	if err := c.ShouldBindJSON(&rr); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	var fee gas.EvmFee
+	switch {
+	case rr.GasPrice != nil && rr.GasTipCap == nil && rr.GasFeeCap == nil:
+		fee.Legacy = rr.GasPrice
+	case rr.GasPrice == nil && rr.GasTipCap != nil && rr.GasFeeCap != nil:
+		fee.DynamicTipCap = rr.GasTipCap
+		fee.DynamicFeeCap = rr.GasFeeCap
+	default:
+		jsonAPIError(c, http.StatusBadRequest, errors.New("either gasPrice, or both gasTipCap and gasFeeCap must be set"))
+		return
+	}
+
+	etxID, hash, chain, ok := tc.findTxAndChain(c)
+	if !ok {
+		return
+	}
+
+	if err := chain.TxManager().ReplaceTransaction(etxID, fee); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("failed to replace transaction: %v", err))
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionReplaced, map[string]interface{}{
+		"ethTxID": etxID,
+		"txHash":  hash,
+		"fee":     fee.String(),
+	})
+
+	tc.renderTx(c, etxID)
+}
+
+// findTxAndChain finds the transaction given by the TxHash param, which is
+// either the hash of one of its attempts or its ID, and returns its ID along
+// with the attempt hash it was found by, if any.
+func (tc *TransactionsController) findTxAndChain(c *gin.Context) (etxID int64, hash string, chain evm.Chain, ok bool) {
+	var chainID *big.Int
+	param := c.Param("TxHash")
+	if id, err := strconv.ParseInt(param, 10, 64); err == nil {
+		var etx txmgr.EvmTx
+		etx, err = tc.App.TxmStorageService().FindEthTxWithAttempts(id)
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+			return
+		}
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		etxID, chainID = etx.ID, etx.ChainID
+	} else {
+		attempt, err := tc.App.TxmStorageService().FindEthTxAttempt(common.HexToHash(param))
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+			return
+		}
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		etxID, hash, chainID = attempt.TxID, attempt.Hash.String(), attempt.Tx.ChainID
+	}
+
+	chain, err := tc.App.GetChains().EVM.Get(chainID)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	return etxID, hash, chain, true
+}
+
+func (tc *TransactionsController) renderTx(c *gin.Context, etxID int64) {
+	etx, err := tc.App.TxmStorageService().FindEthTxWithAttempts(etxID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(etx.TxAttempts) == 0 {
+		jsonAPIResponse(c, presenters.NewEthTxResource(etx), "transaction")
+		return
+	}
+	etx.TxAttempts[0].Tx = etx
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(etx.TxAttempts[0]), "transaction")
+}
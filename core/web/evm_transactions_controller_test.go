@@ -1,6 +1,7 @@
 package web_test
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"testing"
@@ -8,8 +9,10 @@ import (
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
@@ -125,3 +128,59 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Cancel_NotFound(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	resp, cleanup := client.Post("/v2/transactions/evm/"+utils.NewHash().String()+"/cancel", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Post("/v2/transactions/evm/12345/cancel", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestTransactionsController_Cancel_Unstarted(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	borm := app.TxmStorageService()
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+	tx := cltest.MustInsertUnstartedEthTx(t, borm, from)
+
+	// a transaction that was never broadcast is found by its ID
+	resp, cleanup := client.Post(fmt.Sprintf("/v2/transactions/evm/%d/cancel", tx.ID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	etx, err := borm.FindEthTxWithAttempts(tx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgr.EthTxFatalError, etx.State)
+}
+
+func TestTransactionsController_Replace_InvalidFee(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	borm := app.TxmStorageService()
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+	tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 1, from)
+	attempt := tx.TxAttempts[0]
+
+	body := bytes.NewBufferString(`{"gasPrice": "30 gwei", "gasTipCap": "1 gwei"}`)
+	resp, cleanup := client.Post("/v2/transactions/evm/"+attempt.Hash.String()+"/replace", body)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+}
@@ -0,0 +1,83 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// FunctionsRequestsController lists the requests received by Functions jobs.
+type FunctionsRequestsController struct {
+	App chainlink.Application
+}
+
+// Index lists Functions requests, most recently received first. The requests
+// can be filtered by the comma separated `state` names, `subscriptionID`,
+// `contractAddress` and the `receivedAfter` and `receivedBefore` RFC3339
+// timestamps.
+// Example:
+// "GET <application>/functions/requests?state=InProgress,ResultReady&subscriptionID=1"
+func (fc *FunctionsRequestsController) Index(c *gin.Context, size, page, offset int) {
+	filter, err := parseFunctionsRequestFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	reqs, count, err := fc.App.FunctionsRequestsORM().FindRequests(filter, offset, size)
+	paginatedResponse(c, "functions requests", size, page, presenters.NewFunctionsRequestResources(reqs), count, err)
+}
+
+func parseFunctionsRequestFilter(c *gin.Context) (filter functions.RequestFilter, err error) {
+	if s := c.Query("state"); s != "" {
+		for _, name := range strings.Split(s, ",") {
+			state, perr := functions.ParseRequestState(strings.TrimSpace(name))
+			if perr != nil {
+				return filter, perr
+			}
+			filter.States = append(filter.States, state)
+		}
+	}
+	if s := c.Query("subscriptionID"); s != "" {
+		id, perr := strconv.ParseUint(s, 10, 64)
+		if perr != nil {
+			return filter, errors.Errorf("invalid subscriptionID %q", s)
+		}
+		filter.SubscriptionID = &id
+	}
+	if s := c.Query("contractAddress"); s != "" {
+		if !common.IsHexAddress(s) {
+			return filter, errors.Errorf("invalid contractAddress %q", s)
+		}
+		addr := common.HexToAddress(s)
+		filter.ContractAddress = &addr
+	}
+	if filter.ReceivedAfter, err = parseTimeQuery(c, "receivedAfter"); err != nil {
+		return filter, err
+	}
+	if filter.ReceivedBefore, err = parseTimeQuery(c, "receivedBefore"); err != nil {
+		return filter, err
+	}
+	return filter, nil
+}
+
+func parseTimeQuery(c *gin.Context, key string) (*time.Time, error) {
+	s := c.Query(key)
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, errors.Errorf("invalid %s %q: must be an RFC3339 timestamp", key, s)
+	}
+	return &t, nil
+}
@@ -0,0 +1,66 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestFunctionsRequestsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	contract := testutils.NewAddress()
+	orm := functions.NewORM(app.GetSqlxDB(), app.GetLogger(), app.GetConfig(), contract)
+	now := time.Now().Round(time.Second)
+	txHash := common.Hash(testutils.Random32Byte())
+	var ids []functions.RequestID
+	for i, subscriptionID := range []uint64{1, 2, 2} {
+		id := functions.RequestID(testutils.Random32Byte())
+		require.NoError(t, orm.CreateRequest(id, subscriptionID, now.Add(time.Duration(i)*time.Minute), &txHash))
+		ids = append(ids, id)
+	}
+	require.NoError(t, orm.SetResult(ids[1], 0, []byte{0x1}, now))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	resp, cleanup := client.Get("/v2/functions/requests?subscriptionID=2&size=10")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body := cltest.ParseResponseBody(t, resp)
+	count, err := cltest.ParseJSONAPIResponseMetaCount(body)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	var reqs []presenters.FunctionsRequestResource
+	require.NoError(t, jsonapi.Unmarshal(body, &reqs))
+	require.Len(t, reqs, 2)
+	assert.Equal(t, "0x"+ids[2].String(), reqs[0].ID)
+
+	resp, cleanup = client.Get("/v2/functions/requests?state=ResultReady")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body = cltest.ParseResponseBody(t, resp)
+	require.NoError(t, jsonapi.Unmarshal(body, &reqs))
+	require.Len(t, reqs, 1)
+	assert.Equal(t, "ResultReady", reqs[0].State)
+	assert.Equal(t, contract, reqs[0].ContractAddress)
+	require.NotNil(t, reqs[0].ResultReadyAt)
+
+	for _, query := range []string{"state=Unknown", "subscriptionID=abc", "contractAddress=0x1", "receivedAfter=yesterday"} {
+		resp, cleanup = client.Get("/v2/functions/requests?" + query)
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode, query)
+	}
+}
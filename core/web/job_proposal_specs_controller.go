@@ -0,0 +1,65 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// JobProposalSpecsController inspects the specs of job proposals received
+// from the feeds manager.
+type JobProposalSpecsController struct {
+	App chainlink.Application
+}
+
+// Diff compares a job proposal spec to the approved spec of its job proposal.
+// Example:
+// "GET <application>/job_proposal_specs/:ID/diff"
+func (jc *JobProposalSpecsController) Diff(c *gin.Context) {
+	id, err := stringutils.ToInt64(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	diff, err := jc.App.GetFeedsService().DiffSpec(c.Request.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job proposal spec not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobProposalSpecDiffResource(*diff), "job_proposal_spec_diff")
+}
+
+// DryRun validates a job proposal spec for approval, without approving it.
+// Example:
+// "GET <application>/job_proposal_specs/:ID/dry_run"
+func (jc *JobProposalSpecsController) DryRun(c *gin.Context) {
+	id, err := stringutils.ToInt64(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	res, err := jc.App.GetFeedsService().DryRunApproveSpec(c.Request.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job proposal spec not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobProposalSpecDryRunResource(*res), "job_proposal_spec_dry_run")
+}
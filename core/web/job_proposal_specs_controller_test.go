@@ -0,0 +1,38 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	configtest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+)
+
+func TestJobProposalSpecsController_NotFound(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.Feature.FeedsManager = ptr(true)
+	})
+	app := cltest.NewApplicationWithConfig(t, cfg)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	resp, cleanup := client.Get("/v2/job_proposal_specs/1/diff")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, cleanup = client.Get("/v2/job_proposal_specs/1/dry_run")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, cleanup = client.Get("/v2/job_proposal_specs/abc/diff")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+}
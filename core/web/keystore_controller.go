@@ -0,0 +1,59 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+)
+
+// RotateKeystorePasswordRequest defines the request to re-encrypt the keystore
+// with a new password.
+type RotateKeystorePasswordRequest struct {
+	OldPassword string `json:"oldPassword"`
+	NewPassword string `json:"newPassword"`
+}
+
+// KeystoreController manages the keystore
+type KeystoreController struct {
+	App chainlink.Application
+}
+
+// RotatePassword re-encrypts every key in the keystore with a new password.
+// Example:
+// "PATCH <application>/keystore/password"
+func (kc *KeystoreController) RotatePassword(c *gin.Context) {
+	var request RotateKeystorePasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := utils.VerifyPasswordComplexity(request.NewPassword); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	auditData := map[string]interface{}{}
+	if user, ok := webauth.GetAuthenticatedUser(c); ok {
+		auditData["user"] = user.Email
+	}
+
+	err := kc.App.GetKeyStore().RotatePassword(request.OldPassword, request.NewPassword)
+	if errors.Is(err, keystore.ErrPasswordMismatch) {
+		kc.App.GetAuditLogger().Audit(audit.KeystorePasswordRotationAttemptFailedMismatch, auditData)
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	kc.App.GetAuditLogger().Audit(audit.KeystorePasswordRotated, auditData)
+	jsonAPIResponseWithStatus(c, nil, "keystore", http.StatusNoContent)
+}
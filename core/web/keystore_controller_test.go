@@ -0,0 +1,82 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestKeystoreController_RotatePassword(t *testing.T) {
+	t.Parallel()
+
+	const newPassword = "n3wP4SsW0rD1!@#_"
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	_, err := app.KeyStore.CSA().Create()
+	require.NoError(t, err)
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	testCases := []struct {
+		name           string
+		reqBody        string
+		wantStatusCode int
+		wantErrCount   int
+		wantErrMessage string
+	}{
+		{
+			name:           "Invalid request",
+			reqBody:        "",
+			wantStatusCode: http.StatusUnprocessableEntity,
+			wantErrCount:   1,
+		},
+		{
+			name:           "Insufficient length of new password",
+			reqBody:        fmt.Sprintf(`{"newPassword": "%v", "oldPassword": "%v"}`, "foo", cltest.Password),
+			wantStatusCode: http.StatusUnprocessableEntity,
+			wantErrCount:   1,
+			wantErrMessage: fmt.Sprintf("%s	%s\n", utils.ErrMsgHeader, "password is less than 16 characters long"),
+		},
+		{
+			name:           "Incorrect old password",
+			reqBody:        fmt.Sprintf(`{"newPassword": "%v", "oldPassword": "%v"}`, newPassword, "wrong password"),
+			wantStatusCode: http.StatusConflict,
+			wantErrCount:   1,
+			wantErrMessage: "old keystore password does not match",
+		},
+		{
+			name:           "Success",
+			reqBody:        fmt.Sprintf(`{"newPassword": "%v", "oldPassword": "%v"}`, newPassword, cltest.Password),
+			wantStatusCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tc := range testCases {
+		resp, cleanup := client.Patch("/v2/keystore/password", bytes.NewBufferString(tc.reqBody))
+		t.Cleanup(cleanup)
+
+		require.Equal(t, tc.wantStatusCode, resp.StatusCode, tc.name)
+		if tc.wantErrCount > 0 {
+			errors := cltest.ParseJSONAPIErrors(t, resp.Body)
+			assert.Len(t, errors.Errors, tc.wantErrCount, tc.name)
+			if tc.wantErrMessage != "" {
+				assert.Equal(t, tc.wantErrMessage, errors.Errors[0].Detail, tc.name)
+			}
+		}
+	}
+
+	// The keystore is now encrypted with the new password
+	require.NoError(t, app.KeyStore.RotatePassword(newPassword, cltest.Password))
+	keys, err := app.KeyStore.CSA().GetAll()
+	require.NoError(t, err)
+	assert.Len(t, keys, 1)
+}
@@ -0,0 +1,66 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+)
+
+// FunctionsRequestResource represents a Functions request JSONAPI resource.
+type FunctionsRequestResource struct {
+	JAID
+	ContractAddress common.Address `json:"contractAddress"`
+	SubscriptionID  *uint64        `json:"subscriptionID"`
+	State           string         `json:"state"`
+	RunID           *int64         `json:"runID"`
+	RequestTxHash   *common.Hash   `json:"requestTxHash"`
+	ErrorType       *string        `json:"errorType"`
+	Result          hexutil.Bytes  `json:"result"`
+	Error           hexutil.Bytes  `json:"error"`
+	ReceivedAt      time.Time      `json:"receivedAt"`
+	ResultReadyAt   *time.Time     `json:"resultReadyAt"`
+	FinalizedAt     *time.Time     `json:"finalizedAt"`
+	ConfirmedAt     *time.Time     `json:"confirmedAt"`
+	TimedOutAt      *time.Time     `json:"timedOutAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (FunctionsRequestResource) GetName() string {
+	return "functions_requests"
+}
+
+// NewFunctionsRequestResource constructs a new FunctionsRequestResource.
+func NewFunctionsRequestResource(req functions.Request) FunctionsRequestResource {
+	r := FunctionsRequestResource{
+		JAID:            NewJAID(hexutil.Encode(req.RequestID[:])),
+		ContractAddress: req.ContractAddress,
+		SubscriptionID:  req.SubscriptionID,
+		State:           req.State.String(),
+		RunID:           req.RunID,
+		RequestTxHash:   req.RequestTxHash,
+		Result:          req.Result,
+		Error:           req.Error,
+		ReceivedAt:      req.ReceivedAt,
+		ResultReadyAt:   req.ResultReadyAt,
+		FinalizedAt:     req.FinalizedAt,
+		ConfirmedAt:     req.ConfirmedAt,
+		TimedOutAt:      req.TimedOutAt,
+	}
+	if req.ErrorType != nil {
+		errType := req.ErrorType.String()
+		r.ErrorType = &errType
+	}
+	return r
+}
+
+// NewFunctionsRequestResources constructs a slice of FunctionsRequestResource.
+func NewFunctionsRequestResources(reqs []functions.Request) []FunctionsRequestResource {
+	rs := []FunctionsRequestResource{}
+	for _, req := range reqs {
+		rs = append(rs, NewFunctionsRequestResource(req))
+	}
+	return rs
+}
@@ -0,0 +1,81 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+)
+
+// JobProposalSpecChange is a change made to a single key of a job proposal
+// spec.
+type JobProposalSpecChange struct {
+	Path     string               `json:"path"`
+	Type     feeds.SpecChangeType `json:"type"`
+	OldValue *string              `json:"oldValue"`
+	NewValue *string              `json:"newValue"`
+}
+
+// JobProposalSpecDiffResource represents the difference between a job
+// proposal spec and the approved spec of the job proposal.
+type JobProposalSpecDiffResource struct {
+	JAID
+	JobProposalID  int64                   `json:"jobProposalID"`
+	Version        int32                   `json:"version"`
+	ApprovedSpecID *int64                  `json:"approvedSpecID"`
+	Changes        []JobProposalSpecChange `json:"changes"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobProposalSpecDiffResource) GetName() string {
+	return "job_proposal_spec_diff"
+}
+
+// NewJobProposalSpecDiffResource constructs a new JobProposalSpecDiffResource.
+func NewJobProposalSpecDiffResource(diff feeds.SpecDiff) *JobProposalSpecDiffResource {
+	r := &JobProposalSpecDiffResource{
+		JAID:          NewJAIDInt64(diff.Spec.ID),
+		JobProposalID: diff.Spec.JobProposalID,
+		Version:       diff.Spec.Version,
+		Changes:       []JobProposalSpecChange{},
+	}
+	if diff.ApprovedSpec != nil {
+		id := diff.ApprovedSpec.ID
+		r.ApprovedSpecID = &id
+	}
+	for _, c := range diff.Changes {
+		r.Changes = append(r.Changes, JobProposalSpecChange{
+			Path:     c.Path,
+			Type:     c.Type,
+			OldValue: c.OldValue.Ptr(),
+			NewValue: c.NewValue.Ptr(),
+		})
+	}
+	return r
+}
+
+// JobProposalSpecDryRunResource represents the result of a dry run approval
+// of a job proposal spec.
+type JobProposalSpecDryRunResource struct {
+	JAID
+	JobProposalID int64    `json:"jobProposalID"`
+	Version       int32    `json:"version"`
+	Valid         bool     `json:"valid"`
+	Errors        []string `json:"errors"`
+	Warnings      []string `json:"warnings"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobProposalSpecDryRunResource) GetName() string {
+	return "job_proposal_spec_dry_run"
+}
+
+// NewJobProposalSpecDryRunResource constructs a new
+// JobProposalSpecDryRunResource.
+func NewJobProposalSpecDryRunResource(res feeds.SpecDryRun) *JobProposalSpecDryRunResource {
+	return &JobProposalSpecDryRunResource{
+		JAID:          NewJAIDInt64(res.Spec.ID),
+		JobProposalID: res.Spec.JobProposalID,
+		Version:       res.Spec.Version,
+		Valid:         res.Valid(),
+		Errors:        res.Errors,
+		Warnings:      res.Warnings,
+	}
+}
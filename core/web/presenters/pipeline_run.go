@@ -60,12 +60,13 @@ func NewPipelineRunResource(pr pipeline.Run, lggr logger.Logger) PipelineRunReso
 
 // Corresponds with models.d.ts PipelineTaskRun
 type PipelineTaskRunResource struct {
-	Type       pipeline.TaskType `json:"type"`
-	CreatedAt  time.Time         `json:"createdAt"`
-	FinishedAt null.Time         `json:"finishedAt"`
-	Output     *string           `json:"output"`
-	Error      *string           `json:"error"`
-	DotID      string            `json:"dotId"`
+	Type                pipeline.TaskType `json:"type"`
+	CreatedAt           time.Time         `json:"createdAt"`
+	FinishedAt          null.Time         `json:"finishedAt"`
+	Output              *string           `json:"output"`
+	Error               *string           `json:"error"`
+	DotID               string            `json:"dotId"`
+	CircuitBreakerState *string           `json:"circuitBreakerState"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -85,12 +86,13 @@ func NewPipelineTaskRunResource(tr pipeline.TaskRun) PipelineTaskRunResource {
 		errString = &tr.Error.String
 	}
 	return PipelineTaskRunResource{
-		Type:       tr.Type,
-		CreatedAt:  tr.CreatedAt,
-		FinishedAt: tr.FinishedAt,
-		Output:     output,
-		Error:      errString,
-		DotID:      tr.GetDotID(),
+		Type:                tr.Type,
+		CreatedAt:           tr.CreatedAt,
+		FinishedAt:          tr.FinishedAt,
+		Output:              output,
+		Error:               errString,
+		DotID:               tr.GetDotID(),
+		CircuitBreakerState: tr.CircuitBreakerState.Ptr(),
 	}
 }
 
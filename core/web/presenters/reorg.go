@@ -0,0 +1,51 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// ReorgResource represents a reorg JSONAPI resource.
+type ReorgResource struct {
+	JAID
+	EVMChainID           utils.Big          `json:"evmChainID"`
+	CommonAncestorNumber *int64             `json:"commonAncestorNumber"`
+	CommonAncestorHash   *common.Hash       `json:"commonAncestorHash"`
+	OldHeads             httypes.ReorgHeads `json:"oldHeads"`
+	NewHeads             httypes.ReorgHeads `json:"newHeads"`
+	Depth                int64              `json:"depth"`
+	DetectedAt           time.Time          `json:"detectedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (ReorgResource) GetName() string {
+	return "reorgs"
+}
+
+// NewReorgResource constructs a new ReorgResource.
+func NewReorgResource(r httypes.Reorg) ReorgResource {
+	return ReorgResource{
+		JAID:                 NewJAID(strconv.FormatInt(r.ID, 10)),
+		EVMChainID:           r.EVMChainID,
+		CommonAncestorNumber: r.CommonAncestorNumber,
+		CommonAncestorHash:   r.CommonAncestorHash,
+		OldHeads:             r.OldHeads,
+		NewHeads:             r.NewHeads,
+		Depth:                r.Depth,
+		DetectedAt:           r.DetectedAt,
+	}
+}
+
+// NewReorgResources constructs a slice of ReorgResource.
+func NewReorgResources(reorgs []httypes.Reorg) []ReorgResource {
+	rs := []ReorgResource{}
+	for _, r := range reorgs {
+		rs = append(rs, NewReorgResource(r))
+	}
+	return rs
+}
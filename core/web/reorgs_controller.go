@@ -0,0 +1,41 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// ReorgsController lists the reorgs detected on EVM chains.
+type ReorgsController struct {
+	App chainlink.Application
+}
+
+// Index lists the reorg history of a chain, latest first.
+// Example:
+//
+//	"GET <application>/reorgs?evmChainID=1"
+func (rc *ReorgsController) Index(c *gin.Context) {
+	chain, err := getChain(rc.App.GetChains().EVM, c.Query("evmChainID"))
+	switch err {
+	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case nil:
+		break
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	reorgs, err := chain.ReorgDetector().LatestReorgs(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewReorgResources(reorgs), "reorgs")
+}
@@ -0,0 +1,58 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestReorgsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	chain, err := app.GetChains().EVM.Default()
+	require.NoError(t, err)
+	orm := headtracker.NewORM(app.GetSqlxDB(), app.GetLogger(), app.GetConfig(), *chain.ID())
+	for depth := int64(1); depth <= 2; depth++ {
+		require.NoError(t, orm.InsertReorg(testutils.Context(t), &httypes.Reorg{
+			OldHeads:   httypes.ReorgHeads{{Number: 10, Hash: utils.NewHash()}},
+			NewHeads:   httypes.ReorgHeads{{Number: 11, Hash: utils.NewHash()}, {Number: 10, Hash: utils.NewHash()}},
+			Depth:      depth,
+			DetectedAt: time.Now(),
+		}))
+	}
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	resp, cleanup := client.Get("/v2/reorgs")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var reorgs []presenters.ReorgResource
+	require.NoError(t, jsonapi.Unmarshal(cltest.ParseResponseBody(t, resp), &reorgs))
+	require.Len(t, reorgs, 2)
+	assert.Equal(t, int64(2), reorgs[0].Depth)
+	assert.Equal(t, chain.ID().String(), reorgs[0].EVMChainID.String())
+	assert.Nil(t, reorgs[0].CommonAncestorNumber)
+	assert.Len(t, reorgs[0].NewHeads, 2)
+
+	resp, cleanup = client.Get("/v2/reorgs?evmChainID=" + chain.ID().String())
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, cleanup = client.Get("/v2/reorgs?evmChainID=abc")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+}
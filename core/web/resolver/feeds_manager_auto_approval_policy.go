@@ -0,0 +1,167 @@
+package resolver
+
+import (
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+)
+
+// FeedsManagerAutoApprovalTimeWindowResolver resolves a time window of an
+// auto-approval policy.
+type FeedsManagerAutoApprovalTimeWindowResolver struct {
+	w feeds.TimeWindow
+}
+
+// Days resolves the days the window applies to.
+func (r *FeedsManagerAutoApprovalTimeWindowResolver) Days() []string {
+	if r.w.Days == nil {
+		return []string{}
+	}
+	return r.w.Days
+}
+
+// Start resolves the start of the window.
+func (r *FeedsManagerAutoApprovalTimeWindowResolver) Start() string {
+	return r.w.Start
+}
+
+// End resolves the end of the window.
+func (r *FeedsManagerAutoApprovalTimeWindowResolver) End() string {
+	return r.w.End
+}
+
+// FeedsManagerAutoApprovalPolicyResolver resolves the auto-approval policy of
+// a feeds manager.
+type FeedsManagerAutoApprovalPolicyResolver struct {
+	policy feeds.AutoApprovalPolicy
+}
+
+func NewFeedsManagerAutoApprovalPolicy(policy feeds.AutoApprovalPolicy) *FeedsManagerAutoApprovalPolicyResolver {
+	return &FeedsManagerAutoApprovalPolicyResolver{policy: policy}
+}
+
+// Enabled resolves whether the policy is enabled.
+func (r *FeedsManagerAutoApprovalPolicyResolver) Enabled() bool {
+	return r.policy.Enabled
+}
+
+// JobTypes resolves the job types which may be approved.
+func (r *FeedsManagerAutoApprovalPolicyResolver) JobTypes() []string {
+	return nonNilStrings(r.policy.JobTypes)
+}
+
+// ContractAddresses resolves the contract addresses which may be approved.
+func (r *FeedsManagerAutoApprovalPolicyResolver) ContractAddresses() []string {
+	return nonNilStrings(r.policy.ContractAddresses)
+}
+
+// EVMChainIDs resolves the chain IDs which may be approved.
+func (r *FeedsManagerAutoApprovalPolicyResolver) EVMChainIDs() []string {
+	return nonNilStrings(r.policy.EVMChainIDs)
+}
+
+// MaxVersionBump resolves the maximum version bump which may be approved.
+func (r *FeedsManagerAutoApprovalPolicyResolver) MaxVersionBump() int32 {
+	return r.policy.MaxVersionBump
+}
+
+// TimeWindows resolves the windows of time during which specs may be approved.
+func (r *FeedsManagerAutoApprovalPolicyResolver) TimeWindows() []*FeedsManagerAutoApprovalTimeWindowResolver {
+	resolvers := []*FeedsManagerAutoApprovalTimeWindowResolver{}
+	for _, w := range r.policy.TimeWindows {
+		resolvers = append(resolvers, &FeedsManagerAutoApprovalTimeWindowResolver{w: w})
+	}
+	return resolvers
+}
+
+// CreatedAt resolves the policy's created at field.
+func (r *FeedsManagerAutoApprovalPolicyResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.policy.CreatedAt}
+}
+
+// UpdatedAt resolves the policy's updated at field.
+func (r *FeedsManagerAutoApprovalPolicyResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: r.policy.UpdatedAt}
+}
+
+func nonNilStrings(ss []string) []string {
+	if ss == nil {
+		return []string{}
+	}
+	return ss
+}
+
+// -- FeedsManagerAutoApprovalPolicy Query --
+
+// FeedsManagerAutoApprovalPolicyPayloadResolver resolves the auto-approval
+// policy payload.
+type FeedsManagerAutoApprovalPolicyPayloadResolver struct {
+	policy *feeds.AutoApprovalPolicy
+	NotFoundErrorUnionType
+}
+
+func NewFeedsManagerAutoApprovalPolicyPayload(policy *feeds.AutoApprovalPolicy, err error) *FeedsManagerAutoApprovalPolicyPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "auto-approval policy not found", isExpectedErrorFn: nil}
+
+	return &FeedsManagerAutoApprovalPolicyPayloadResolver{policy: policy, NotFoundErrorUnionType: e}
+}
+
+// ToFeedsManagerAutoApprovalPolicy implements the FeedsManagerAutoApprovalPolicy
+// union type of the payload
+func (r *FeedsManagerAutoApprovalPolicyPayloadResolver) ToFeedsManagerAutoApprovalPolicy() (*FeedsManagerAutoApprovalPolicyResolver, bool) {
+	if r.policy != nil {
+		return NewFeedsManagerAutoApprovalPolicy(*r.policy), true
+	}
+
+	return nil, false
+}
+
+// -- UpdateFeedsManagerAutoApprovalPolicy Mutation --
+
+// UpdateFeedsManagerAutoApprovalPolicyPayloadResolver resolves the response
+// to updating an auto-approval policy.
+type UpdateFeedsManagerAutoApprovalPolicyPayloadResolver struct {
+	policy    *feeds.AutoApprovalPolicy
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewUpdateFeedsManagerAutoApprovalPolicyPayload(policy *feeds.AutoApprovalPolicy, err error, inputErrs map[string]string) *UpdateFeedsManagerAutoApprovalPolicyPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "feeds manager not found", isExpectedErrorFn: nil}
+
+	return &UpdateFeedsManagerAutoApprovalPolicyPayloadResolver{
+		policy:                 policy,
+		inputErrs:              inputErrs,
+		NotFoundErrorUnionType: e,
+	}
+}
+
+func (r *UpdateFeedsManagerAutoApprovalPolicyPayloadResolver) ToUpdateFeedsManagerAutoApprovalPolicySuccess() (*UpdateFeedsManagerAutoApprovalPolicySuccessResolver, bool) {
+	if r.policy != nil {
+		return &UpdateFeedsManagerAutoApprovalPolicySuccessResolver{policy: *r.policy}, true
+	}
+
+	return nil, false
+}
+
+func (r *UpdateFeedsManagerAutoApprovalPolicyPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		var errs []*InputErrorResolver
+
+		for path, message := range r.inputErrs {
+			errs = append(errs, NewInputError(path, message))
+		}
+
+		return NewInputErrors(errs), true
+	}
+
+	return nil, false
+}
+
+type UpdateFeedsManagerAutoApprovalPolicySuccessResolver struct {
+	policy feeds.AutoApprovalPolicy
+}
+
+func (r *UpdateFeedsManagerAutoApprovalPolicySuccessResolver) Policy() *FeedsManagerAutoApprovalPolicyResolver {
+	return NewFeedsManagerAutoApprovalPolicy(r.policy)
+}
@@ -0,0 +1,223 @@
+package resolver
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+)
+
+func Test_FeedsManagerAutoApprovalPolicy(t *testing.T) {
+	var (
+		mgrID = int64(1)
+		query = `
+			query GetFeedsManagerAutoApprovalPolicy {
+				feedsManagerAutoApprovalPolicy(id: 1) {
+					... on FeedsManagerAutoApprovalPolicy {
+						enabled
+						jobTypes
+						contractAddresses
+						evmChainIDs
+						maxVersionBump
+						timeWindows {
+							days
+							start
+							end
+						}
+						createdAt
+						updatedAt
+					}
+					... on NotFoundError {
+						message
+						code
+					}
+				}
+			}`
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "feedsManagerAutoApprovalPolicy"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("GetAutoApprovalPolicy", mgrID).Return(&feeds.AutoApprovalPolicy{
+					FeedsManagerID: mgrID,
+					Enabled:        true,
+					JobTypes:       pq.StringArray{"offchainreporting2"},
+					EVMChainIDs:    pq.StringArray{"1"},
+					MaxVersionBump: 1,
+					TimeWindows: feeds.TimeWindows{
+						{Days: []string{"Monday"}, Start: "09:00", End: "17:00"},
+					},
+					CreatedAt: f.Timestamp(),
+					UpdatedAt: f.Timestamp(),
+				}, nil)
+			},
+			query: query,
+			result: `
+			{
+				"feedsManagerAutoApprovalPolicy": {
+					"enabled": true,
+					"jobTypes": ["offchainreporting2"],
+					"contractAddresses": [],
+					"evmChainIDs": ["1"],
+					"maxVersionBump": 1,
+					"timeWindows": [{
+						"days": ["Monday"],
+						"start": "09:00",
+						"end": "17:00"
+					}],
+					"createdAt": "2021-01-01T00:00:00Z",
+					"updatedAt": "2021-01-01T00:00:00Z"
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("GetAutoApprovalPolicy", mgrID).Return(nil, sql.ErrNoRows)
+			},
+			query: query,
+			result: `
+			{
+				"feedsManagerAutoApprovalPolicy": {
+					"message": "auto-approval policy not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func Test_UpdateFeedsManagerAutoApprovalPolicy(t *testing.T) {
+	var (
+		mgrID    = int64(1)
+		mutation = `
+			mutation UpdateFeedsManagerAutoApprovalPolicy($id: ID!, $input: UpdateFeedsManagerAutoApprovalPolicyInput!) {
+				updateFeedsManagerAutoApprovalPolicy(id: $id, input: $input) {
+					... on UpdateFeedsManagerAutoApprovalPolicySuccess {
+						policy {
+							enabled
+							jobTypes
+							maxVersionBump
+						}
+					}
+					... on NotFoundError {
+						message
+						code
+					}
+					... on InputErrors {
+						errors {
+							path
+							message
+							code
+						}
+					}
+				}
+			}`
+		input = map[string]interface{}{
+			"enabled":           true,
+			"jobTypes":          []interface{}{"offchainreporting2"},
+			"contractAddresses": []interface{}{},
+			"evmChainIDs":       []interface{}{"1"},
+			"maxVersionBump":    1,
+			"timeWindows": []interface{}{
+				map[string]interface{}{"days": []interface{}{"Monday"}, "start": "09:00", "end": "17:00"},
+			},
+		}
+		variables = map[string]interface{}{
+			"id":    "1",
+			"input": input,
+		}
+		policy = feeds.AutoApprovalPolicy{
+			FeedsManagerID:    mgrID,
+			Enabled:           true,
+			JobTypes:          pq.StringArray{"offchainreporting2"},
+			ContractAddresses: pq.StringArray{},
+			EVMChainIDs:       pq.StringArray{"1"},
+			MaxVersionBump:    1,
+			TimeWindows: feeds.TimeWindows{
+				{Days: []string{"Monday"}, Start: "09:00", End: "17:00"},
+			},
+		}
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "updateFeedsManagerAutoApprovalPolicy"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("UpdateAutoApprovalPolicy", mock.Anything, policy).Return(nil)
+				f.Mocks.feedsSvc.On("GetAutoApprovalPolicy", mgrID).Return(&policy, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"updateFeedsManagerAutoApprovalPolicy": {
+					"policy": {
+						"enabled": true,
+						"jobTypes": ["offchainreporting2"],
+						"maxVersionBump": 1
+					}
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("UpdateAutoApprovalPolicy", mock.Anything, policy).Return(sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"updateFeedsManagerAutoApprovalPolicy": {
+					"message": "feeds manager not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+		{
+			name:          "input errors",
+			authenticated: true,
+			query:         mutation,
+			variables: map[string]interface{}{
+				"id": "1",
+				"input": map[string]interface{}{
+					"enabled":           true,
+					"jobTypes":          []interface{}{"webhook"},
+					"contractAddresses": []interface{}{},
+					"evmChainIDs":       []interface{}{},
+					"maxVersionBump":    0,
+					"timeWindows":       []interface{}{},
+				},
+			},
+			result: `
+			{
+				"updateFeedsManagerAutoApprovalPolicy": {
+					"errors": [{
+						"path": "input",
+						"message": "invalid job type \"webhook\"",
+						"code": "INVALID_INPUT"
+					}]
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
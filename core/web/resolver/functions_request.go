@@ -0,0 +1,178 @@
+package resolver
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+)
+
+type FunctionsRequestState string
+
+const (
+	FunctionsRequestStateInProgress  FunctionsRequestState = "IN_PROGRESS"
+	FunctionsRequestStateResultReady FunctionsRequestState = "RESULT_READY"
+	FunctionsRequestStateTimedOut    FunctionsRequestState = "TIMED_OUT"
+	FunctionsRequestStateFinalized   FunctionsRequestState = "FINALIZED"
+	FunctionsRequestStateConfirmed   FunctionsRequestState = "CONFIRMED"
+)
+
+var functionsRequestStates = map[functions.RequestState]FunctionsRequestState{
+	functions.IN_PROGRESS:  FunctionsRequestStateInProgress,
+	functions.RESULT_READY: FunctionsRequestStateResultReady,
+	functions.TIMED_OUT:    FunctionsRequestStateTimedOut,
+	functions.FINALIZED:    FunctionsRequestStateFinalized,
+	functions.CONFIRMED:    FunctionsRequestStateConfirmed,
+}
+
+// ToRequestState converts the GQL state to a functions.RequestState.
+func (s FunctionsRequestState) ToRequestState() (functions.RequestState, bool) {
+	for state, gqlState := range functionsRequestStates {
+		if gqlState == s {
+			return state, true
+		}
+	}
+	return 0, false
+}
+
+// FunctionsRequestResolver resolves a Functions request.
+type FunctionsRequestResolver struct {
+	req functions.Request
+}
+
+func NewFunctionsRequest(req functions.Request) *FunctionsRequestResolver {
+	return &FunctionsRequestResolver{req: req}
+}
+
+func NewFunctionsRequests(reqs []functions.Request) []*FunctionsRequestResolver {
+	resolvers := []*FunctionsRequestResolver{}
+	for _, req := range reqs {
+		resolvers = append(resolvers, NewFunctionsRequest(req))
+	}
+	return resolvers
+}
+
+// ID resolves the request ID as a hex string.
+func (r *FunctionsRequestResolver) ID() graphql.ID {
+	return graphql.ID(hexutil.Encode(r.req.RequestID[:]))
+}
+
+// ContractAddress resolves the address of the oracle contract which received the request.
+func (r *FunctionsRequestResolver) ContractAddress() string {
+	return r.req.ContractAddress.Hex()
+}
+
+// SubscriptionID resolves the ID of the subscription which pays for the request.
+func (r *FunctionsRequestResolver) SubscriptionID() *string {
+	if r.req.SubscriptionID == nil {
+		return nil
+	}
+	id := strconv.FormatUint(*r.req.SubscriptionID, 10)
+	return &id
+}
+
+// State resolves the state of the request.
+func (r *FunctionsRequestResolver) State() FunctionsRequestState {
+	return functionsRequestStates[r.req.State]
+}
+
+// RunID resolves the ID of the pipeline run which computed the result.
+func (r *FunctionsRequestResolver) RunID() *graphql.ID {
+	if r.req.RunID == nil {
+		return nil
+	}
+	id := int64GQLID(*r.req.RunID)
+	return &id
+}
+
+// RequestTxHash resolves the hash of the transaction which made the request.
+func (r *FunctionsRequestResolver) RequestTxHash() *string {
+	if r.req.RequestTxHash == nil {
+		return nil
+	}
+	hash := r.req.RequestTxHash.Hex()
+	return &hash
+}
+
+// ErrorType resolves the type of the computation error.
+func (r *FunctionsRequestResolver) ErrorType() *string {
+	if r.req.ErrorType == nil {
+		return nil
+	}
+	errType := r.req.ErrorType.String()
+	return &errType
+}
+
+// Result resolves the computation result as a hex string.
+func (r *FunctionsRequestResolver) Result() *string {
+	return hexOrNil(r.req.Result)
+}
+
+// Error resolves the computation error as a hex string.
+func (r *FunctionsRequestResolver) Error() *string {
+	return hexOrNil(r.req.Error)
+}
+
+// ReceivedAt resolves the time the request was received.
+func (r *FunctionsRequestResolver) ReceivedAt() graphql.Time {
+	return graphql.Time{Time: r.req.ReceivedAt}
+}
+
+// ResultReadyAt resolves the time the computation finished.
+func (r *FunctionsRequestResolver) ResultReadyAt() *graphql.Time {
+	return timeOrNil(r.req.ResultReadyAt)
+}
+
+// FinalizedAt resolves the time the request was finalized in an OCR report.
+func (r *FunctionsRequestResolver) FinalizedAt() *graphql.Time {
+	return timeOrNil(r.req.FinalizedAt)
+}
+
+// ConfirmedAt resolves the time the response was confirmed on chain.
+func (r *FunctionsRequestResolver) ConfirmedAt() *graphql.Time {
+	return timeOrNil(r.req.ConfirmedAt)
+}
+
+// TimedOutAt resolves the time the request timed out.
+func (r *FunctionsRequestResolver) TimedOutAt() *graphql.Time {
+	return timeOrNil(r.req.TimedOutAt)
+}
+
+func hexOrNil(b []byte) *string {
+	if b == nil {
+		return nil
+	}
+	s := hexutil.Encode(b)
+	return &s
+}
+
+func timeOrNil(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *t}
+}
+
+// -- FunctionsRequests Query --
+
+type FunctionsRequestsPayloadResolver struct {
+	reqs  []functions.Request
+	total int32
+}
+
+func NewFunctionsRequestsPayload(reqs []functions.Request, total int32) *FunctionsRequestsPayloadResolver {
+	return &FunctionsRequestsPayloadResolver{reqs: reqs, total: total}
+}
+
+// Results returns the Functions requests.
+func (r *FunctionsRequestsPayloadResolver) Results() []*FunctionsRequestResolver {
+	return NewFunctionsRequests(r.reqs)
+}
+
+// Metadata returns the pagination metadata.
+func (r *FunctionsRequestsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
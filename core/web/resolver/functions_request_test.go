@@ -0,0 +1,148 @@
+package resolver
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+)
+
+func TestResolver_FunctionsRequests(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetFunctionsRequests($filter: FunctionsRequestsFilter) {
+			functionsRequests(filter: $filter) {
+				results {
+					id
+					contractAddress
+					subscriptionID
+					state
+					runID
+					requestTxHash
+					errorType
+					result
+					error
+					receivedAt
+					resultReadyAt
+					finalizedAt
+					confirmedAt
+					timedOutAt
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+	contract := common.HexToAddress("0x5431F5F973781809D18643b87B44921b11355d81")
+	txHash := common.HexToHash("0x1")
+	subscriptionID := uint64(7)
+	receivedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	readyAt := receivedAt.Add(time.Second)
+	confirmedAt := receivedAt.Add(time.Minute)
+	receivedAfter := time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)
+	variables := map[string]interface{}{
+		"filter": map[string]interface{}{
+			"states":          []interface{}{"CONFIRMED", "TIMED_OUT"},
+			"subscriptionID":  "7",
+			"contractAddress": contract.Hex(),
+			"receivedAfter":   receivedAfter.Format(time.RFC3339),
+		},
+	}
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "functionsRequests"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.functionsRequestsORM.On("FindRequests", functions.RequestFilter{
+					ContractAddress: &contract,
+					States:          []functions.RequestState{functions.CONFIRMED, functions.TIMED_OUT},
+					SubscriptionID:  &subscriptionID,
+					ReceivedAfter:   &receivedAfter,
+				}, PageDefaultOffset, PageDefaultLimit).Return([]functions.Request{
+					{
+						RequestID:       functions.RequestID{0x1},
+						ContractAddress: contract,
+						SubscriptionID:  &subscriptionID,
+						RequestTxHash:   &txHash,
+						State:           functions.CONFIRMED,
+						Result:          []byte{0x12, 0x34},
+						ReceivedAt:      receivedAt,
+						ResultReadyAt:   &readyAt,
+						ConfirmedAt:     &confirmedAt,
+					},
+				}, 1, nil)
+				f.App.On("FunctionsRequestsORM").Return(f.Mocks.functionsRequestsORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"functionsRequests": {
+						"results": [{
+							"id": "0x0100000000000000000000000000000000000000000000000000000000000000",
+							"contractAddress": "0x5431F5F973781809D18643b87B44921b11355d81",
+							"subscriptionID": "7",
+							"state": "CONFIRMED",
+							"runID": null,
+							"requestTxHash": "0x0000000000000000000000000000000000000000000000000000000000000001",
+							"errorType": null,
+							"result": "0x1234",
+							"error": null,
+							"receivedAt": "2021-01-01T00:00:00Z",
+							"resultReadyAt": "2021-01-01T00:00:01Z",
+							"finalizedAt": null,
+							"confirmedAt": "2021-01-01T00:01:00Z",
+							"timedOutAt": null
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
+		{
+			name:          "invalid subscription ID",
+			authenticated: true,
+			query:         query,
+			variables: map[string]interface{}{
+				"filter": map[string]interface{}{"subscriptionID": "abc"},
+			},
+			result: `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Message:       `invalid subscription ID "abc"`,
+					Path:          []interface{}{"functionsRequests"},
+					ResolverError: errors.New(`invalid subscription ID "abc"`),
+				},
+			},
+		},
+		{
+			name:          "generic error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.functionsRequestsORM.On("FindRequests", functions.RequestFilter{}, PageDefaultOffset, PageDefaultLimit).Return(nil, 0, gError)
+				f.App.On("FunctionsRequestsORM").Return(f.Mocks.functionsRequestsORM)
+			},
+			query:  query,
+			result: `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"functionsRequests"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -96,6 +96,153 @@ func (r *JobProposalSpecResolver) UpdatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.UpdatedAt}
 }
 
+// SpecChangeType defines the enum values for GQL
+type SpecChangeType string
+
+const (
+	// revive:disable
+	SpecChangeTypeAdded    SpecChangeType = "ADDED"
+	SpecChangeTypeRemoved  SpecChangeType = "REMOVED"
+	SpecChangeTypeModified SpecChangeType = "MODIFIED"
+	// revive:enable
+)
+
+// ToSpecChangeType converts the feeds spec change type into the enum value.
+func ToSpecChangeType(t feeds.SpecChangeType) SpecChangeType {
+	switch t {
+	case feeds.SpecChangeTypeAdded:
+		return SpecChangeTypeAdded
+	case feeds.SpecChangeTypeRemoved:
+		return SpecChangeTypeRemoved
+	default:
+		return SpecChangeTypeModified
+	}
+}
+
+// JobProposalSpecChangeResolver resolves a change to a key of a job proposal
+// spec.
+type JobProposalSpecChangeResolver struct {
+	change feeds.SpecChange
+}
+
+// Path resolves to the dotted path of the changed key
+func (r *JobProposalSpecChangeResolver) Path() string {
+	return r.change.Path
+}
+
+// Type resolves to the type of change
+func (r *JobProposalSpecChangeResolver) Type() SpecChangeType {
+	return ToSpecChangeType(r.change.Type)
+}
+
+// OldValue resolves to the TOML encoded value in the approved spec
+func (r *JobProposalSpecChangeResolver) OldValue() *string {
+	return r.change.OldValue.Ptr()
+}
+
+// NewValue resolves to the TOML encoded value in the proposed spec
+func (r *JobProposalSpecChangeResolver) NewValue() *string {
+	return r.change.NewValue.Ptr()
+}
+
+// JobProposalSpecDiffResolver resolves the difference between a job proposal
+// spec and the approved spec.
+type JobProposalSpecDiffResolver struct {
+	diff *feeds.SpecDiff
+}
+
+// Spec resolves to the proposed spec
+func (r *JobProposalSpecDiffResolver) Spec() *JobProposalSpecResolver {
+	return NewJobProposalSpec(r.diff.Spec)
+}
+
+// ApprovedSpec resolves to the approved spec the proposed spec is compared to
+func (r *JobProposalSpecDiffResolver) ApprovedSpec() *JobProposalSpecResolver {
+	if r.diff.ApprovedSpec == nil {
+		return nil
+	}
+	return NewJobProposalSpec(r.diff.ApprovedSpec)
+}
+
+// Changes resolves to the changes made by the proposed spec
+func (r *JobProposalSpecDiffResolver) Changes() []*JobProposalSpecChangeResolver {
+	resolvers := []*JobProposalSpecChangeResolver{}
+	for _, c := range r.diff.Changes {
+		resolvers = append(resolvers, &JobProposalSpecChangeResolver{change: c})
+	}
+	return resolvers
+}
+
+// JobProposalSpecDiffPayloadResolver resolves the spec diff payload.
+type JobProposalSpecDiffPayloadResolver struct {
+	diff *feeds.SpecDiff
+	NotFoundErrorUnionType
+}
+
+// NewJobProposalSpecDiffPayload generates the spec diff payload resolver.
+func NewJobProposalSpecDiffPayload(diff *feeds.SpecDiff, err error) *JobProposalSpecDiffPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: notFoundErrorMessage}
+
+	return &JobProposalSpecDiffPayloadResolver{diff: diff, NotFoundErrorUnionType: e}
+}
+
+// ToJobProposalSpecDiff resolves to the spec diff resolver
+func (r *JobProposalSpecDiffPayloadResolver) ToJobProposalSpecDiff() (*JobProposalSpecDiffResolver, bool) {
+	if r.diff != nil {
+		return &JobProposalSpecDiffResolver{diff: r.diff}, true
+	}
+
+	return nil, false
+}
+
+// JobProposalSpecDryRunResolver resolves the result of a dry run approval of
+// a job proposal spec.
+type JobProposalSpecDryRunResolver struct {
+	res *feeds.SpecDryRun
+}
+
+// Spec resolves to the spec
+func (r *JobProposalSpecDryRunResolver) Spec() *JobProposalSpecResolver {
+	return NewJobProposalSpec(r.res.Spec)
+}
+
+// Valid resolves to whether approving the spec is expected to succeed
+func (r *JobProposalSpecDryRunResolver) Valid() bool {
+	return r.res.Valid()
+}
+
+// Errors resolves to the reasons approving the spec would fail
+func (r *JobProposalSpecDryRunResolver) Errors() []string {
+	return r.res.Errors
+}
+
+// Warnings resolves to the side effects of approving the spec
+func (r *JobProposalSpecDryRunResolver) Warnings() []string {
+	return r.res.Warnings
+}
+
+// JobProposalSpecDryRunPayloadResolver resolves the spec dry run payload.
+type JobProposalSpecDryRunPayloadResolver struct {
+	res *feeds.SpecDryRun
+	NotFoundErrorUnionType
+}
+
+// NewJobProposalSpecDryRunPayload generates the spec dry run payload resolver.
+func NewJobProposalSpecDryRunPayload(res *feeds.SpecDryRun, err error) *JobProposalSpecDryRunPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: notFoundErrorMessage}
+
+	return &JobProposalSpecDryRunPayloadResolver{res: res, NotFoundErrorUnionType: e}
+}
+
+// ToJobProposalSpecDryRun resolves to the spec dry run resolver
+func (r *JobProposalSpecDryRunPayloadResolver) ToJobProposalSpecDryRun() (*JobProposalSpecDryRunResolver, bool) {
+	if r.res != nil {
+		return &JobProposalSpecDryRunResolver{res: r.res}, true
+	}
+
+	return nil, false
+}
+
 // -- ApproveJobProposal Mutation --
 
 // ApproveJobProposalSpecPayloadResolver resolves the spec payload.
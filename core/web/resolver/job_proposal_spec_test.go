@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 )
@@ -465,3 +466,173 @@ func TestResolver_GetJobProposal_Spec(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_JobProposalSpecDiff(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query JobProposalSpecDiff($id: ID!) {
+			jobProposalSpecDiff(id: $id) {
+				... on JobProposalSpecDiff {
+					spec {
+						id
+					}
+					approvedSpec {
+						id
+					}
+					changes {
+						path
+						type
+						oldValue
+						newValue
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	specID := int64(2)
+	variables := map[string]interface{}{
+		"id": "2",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "jobProposalSpecDiff"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("DiffSpec", mock.Anything, specID).Return(&feeds.SpecDiff{
+					Spec:         &feeds.JobProposalSpec{ID: specID},
+					ApprovedSpec: &feeds.JobProposalSpec{ID: 1},
+					Changes: []feeds.SpecChange{
+						{Path: "name", Type: feeds.SpecChangeTypeModified, OldValue: null.StringFrom("'a'"), NewValue: null.StringFrom("'b'")},
+						{Path: "gasLimit", Type: feeds.SpecChangeTypeAdded, NewValue: null.StringFrom("1000")},
+					},
+				}, nil)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+			{
+				"jobProposalSpecDiff": {
+					"spec": {
+						"id": "2"
+					},
+					"approvedSpec": {
+						"id": "1"
+					},
+					"changes": [{
+						"path": "name",
+						"type": "MODIFIED",
+						"oldValue": "'a'",
+						"newValue": "'b'"
+					}, {
+						"path": "gasLimit",
+						"type": "ADDED",
+						"oldValue": null,
+						"newValue": "1000"
+					}]
+				}
+			}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("DiffSpec", mock.Anything, specID).Return(nil, sql.ErrNoRows)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+			{
+				"jobProposalSpecDiff": {
+					"message": "spec not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_JobProposalSpecDryRun(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query JobProposalSpecDryRun($id: ID!) {
+			jobProposalSpecDryRun(id: $id) {
+				... on JobProposalSpecDryRun {
+					spec {
+						id
+					}
+					valid
+					errors
+					warnings
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	specID := int64(1)
+	variables := map[string]interface{}{
+		"id": "1",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "jobProposalSpecDryRun"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("DryRunApproveSpec", mock.Anything, specID).Return(&feeds.SpecDryRun{
+					Spec:     &feeds.JobProposalSpec{ID: specID},
+					Errors:   []string{"bridge check failed: bridge 'foo' not found"},
+					Warnings: []string{},
+				}, nil)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+			{
+				"jobProposalSpecDryRun": {
+					"spec": {
+						"id": "1"
+					},
+					"valid": false,
+					"errors": ["bridge check failed: bridge 'foo' not found"],
+					"warnings": []
+				}
+			}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("DryRunApproveSpec", mock.Anything, specID).Return(nil, sql.ErrNoRows)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+			{
+				"jobProposalSpecDryRun": {
+					"message": "spec not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
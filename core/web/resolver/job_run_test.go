@@ -101,6 +101,10 @@ func TestResolver_JobRun(t *testing.T) {
 					}
 					outputs
 					status
+					taskRuns {
+						dotID
+						circuitBreakerState
+					}
 				}
 				... on NotFoundError {
 					code
@@ -140,6 +144,10 @@ func TestResolver_JobRun(t *testing.T) {
 					Inputs:         inputs,
 					Outputs:        outputs,
 					State:          pipeline.RunStatusErrored,
+					PipelineTaskRuns: []pipeline.TaskRun{
+						{DotID: "ds", CircuitBreakerState: null.StringFrom("open")},
+						{DotID: "ds_parse"},
+					},
 				}, nil)
 				f.Mocks.jobORM.On("FindJobsByPipelineSpecIDs", []int32{5}).Return([]job.Job{
 					{
@@ -171,7 +179,11 @@ func TestResolver_JobRun(t *testing.T) {
 							"name": "second-one"
 						},
 						"outputs": ["{\"baz\":\"bar\"}"],
-						"status": "ERRORED"
+						"status": "ERRORED",
+						"taskRuns": [
+							{"dotID": "ds", "circuitBreakerState": "open"},
+							{"dotID": "ds_parse", "circuitBreakerState": null}
+						]
 					}
 				}`,
 		},
@@ -550,6 +550,76 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	return NewUpdateFeedsManagerPayload(mgr, nil, nil), nil
 }
 
+type updateFeedsManagerAutoApprovalPolicyInput struct {
+	Enabled           bool
+	JobTypes          []string
+	ContractAddresses []string
+	EvmChainIDs       []string
+	MaxVersionBump    int32
+	TimeWindows       []struct {
+		Days  []string
+		Start string
+		End   string
+	}
+}
+
+func (r *Resolver) UpdateFeedsManagerAutoApprovalPolicy(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input *updateFeedsManagerAutoApprovalPolicyInput
+}) (*UpdateFeedsManagerAutoApprovalPolicyPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	policy := feeds.AutoApprovalPolicy{
+		FeedsManagerID:    id,
+		Enabled:           args.Input.Enabled,
+		JobTypes:          args.Input.JobTypes,
+		ContractAddresses: args.Input.ContractAddresses,
+		EVMChainIDs:       args.Input.EvmChainIDs,
+		MaxVersionBump:    args.Input.MaxVersionBump,
+		TimeWindows:       feeds.TimeWindows{},
+	}
+	for _, w := range args.Input.TimeWindows {
+		policy.TimeWindows = append(policy.TimeWindows, feeds.TimeWindow{
+			Days:  w.Days,
+			Start: w.Start,
+			End:   w.End,
+		})
+	}
+
+	if err = policy.Validate(); err != nil {
+		return NewUpdateFeedsManagerAutoApprovalPolicyPayload(nil, nil, map[string]string{
+			"input": err.Error(),
+		}), nil
+	}
+
+	feedsService := r.App.GetFeedsService()
+
+	if err = feedsService.UpdateAutoApprovalPolicy(ctx, policy); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUpdateFeedsManagerAutoApprovalPolicyPayload(nil, err, nil), nil
+		}
+
+		return nil, err
+	}
+
+	updated, err := feedsService.GetAutoApprovalPolicy(id)
+	if err != nil {
+		return nil, err
+	}
+
+	policyj, _ := json.Marshal(updated)
+	r.App.GetAuditLogger().Audit(audit.FeedsManAutoApprovalPolicyUpdated, map[string]interface{}{"policyj": policyj})
+
+	return NewUpdateFeedsManagerAutoApprovalPolicyPayload(updated, nil, nil), nil
+}
+
 func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundlePayloadResolver, error) {
 	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
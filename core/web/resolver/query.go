@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"sort"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/graph-gophers/graphql-go"
@@ -15,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
@@ -126,6 +128,30 @@ func (r *Resolver) FeedsManager(ctx context.Context, args struct{ ID graphql.ID
 	return NewFeedsManagerPayload(mgr, nil), nil
 }
 
+// FeedsManagerAutoApprovalPolicy retrieves the auto-approval policy of a
+// feeds manager.
+func (r *Resolver) FeedsManagerAutoApprovalPolicy(ctx context.Context, args struct{ ID graphql.ID }) (*FeedsManagerAutoApprovalPolicyPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := r.App.GetFeedsService().GetAutoApprovalPolicy(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewFeedsManagerAutoApprovalPolicyPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewFeedsManagerAutoApprovalPolicyPayload(policy, nil), nil
+}
+
 func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
@@ -139,6 +165,66 @@ func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadReso
 	return NewFeedsManagersPayload(mgrs), nil
 }
 
+// FunctionsRequests retrieves a page of Functions requests matching the filter.
+func (r *Resolver) FunctionsRequests(ctx context.Context, args struct {
+	Filter *struct {
+		States          *[]FunctionsRequestState
+		SubscriptionID  *string
+		ContractAddress *string
+		ReceivedAfter   *graphql.Time
+		ReceivedBefore  *graphql.Time
+	}
+	Offset *int32
+	Limit  *int32
+}) (*FunctionsRequestsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var filter functions.RequestFilter
+	if f := args.Filter; f != nil {
+		if f.States != nil {
+			for _, s := range *f.States {
+				state, ok := s.ToRequestState()
+				if !ok {
+					return nil, fmt.Errorf("invalid state %q", s)
+				}
+				filter.States = append(filter.States, state)
+			}
+		}
+		if f.SubscriptionID != nil {
+			id, err := strconv.ParseUint(*f.SubscriptionID, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid subscription ID %q", *f.SubscriptionID)
+			}
+			filter.SubscriptionID = &id
+		}
+		if f.ContractAddress != nil {
+			if !common.IsHexAddress(*f.ContractAddress) {
+				return nil, fmt.Errorf("invalid contract address %q", *f.ContractAddress)
+			}
+			addr := common.HexToAddress(*f.ContractAddress)
+			filter.ContractAddress = &addr
+		}
+		if f.ReceivedAfter != nil {
+			filter.ReceivedAfter = &f.ReceivedAfter.Time
+		}
+		if f.ReceivedBefore != nil {
+			filter.ReceivedBefore = &f.ReceivedBefore.Time
+		}
+	}
+
+	offset := pageOffset(args.Offset)
+	limit := pageLimit(args.Limit)
+
+	reqs, count, err := r.App.FunctionsRequestsORM().FindRequests(filter, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFunctionsRequestsPayload(reqs, int32(count)), nil
+}
+
 // Job retrieves a job by id.
 func (r *Resolver) Job(ctx context.Context, args struct{ ID graphql.ID }) (*JobPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
@@ -315,6 +401,58 @@ func (r *Resolver) JobProposal(ctx context.Context, args struct {
 	return NewJobProposalPayload(jp, err), nil
 }
 
+// JobProposalSpecDiff compares a job proposal spec to the approved spec of
+// the job proposal.
+func (r *Resolver) JobProposalSpecDiff(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*JobProposalSpecDiffPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := r.App.GetFeedsService().DiffSpec(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewJobProposalSpecDiffPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewJobProposalSpecDiffPayload(diff, nil), nil
+}
+
+// JobProposalSpecDryRun validates a job proposal spec for approval, without
+// approving it.
+func (r *Resolver) JobProposalSpecDryRun(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*JobProposalSpecDryRunPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.App.GetFeedsService().DryRunApproveSpec(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewJobProposalSpecDryRunPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewJobProposalSpecDryRunPayload(res, nil), nil
+}
+
 // Nodes retrieves a paginated list of nodes.
 func (r *Resolver) Nodes(ctx context.Context, args struct {
 	Offset *int32
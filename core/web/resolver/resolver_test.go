@@ -21,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	chainlinkMocks "github.com/smartcontractkit/chainlink/v2/core/services/chainlink/mocks"
 	feedsMocks "github.com/smartcontractkit/chainlink/v2/core/services/feeds/mocks"
+	functionsMocks "github.com/smartcontractkit/chainlink/v2/core/services/functions/mocks"
 	jobORMMocks "github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
 	keystoreMocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
 	pipelineMocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
@@ -33,29 +34,30 @@ import (
 )
 
 type mocks struct {
-	bridgeORM   *bridgeORMMocks.ORM
-	evmORM      *evmtest.TestConfigs
-	jobORM      *jobORMMocks.ORM
-	sessionsORM *sessionsMocks.ORM
-	pipelineORM *pipelineMocks.ORM
-	feedsSvc    *feedsMocks.Service
-	cfg         *chainlinkMocks.GeneralConfig
-	scfg        *evmConfigMocks.ChainScopedConfig
-	ocr         *keystoreMocks.OCR
-	ocr2        *keystoreMocks.OCR2
-	csa         *keystoreMocks.CSA
-	keystore    *keystoreMocks.Master
-	ethKs       *keystoreMocks.Eth
-	p2p         *keystoreMocks.P2P
-	vrf         *keystoreMocks.VRF
-	solana      *keystoreMocks.Solana
-	chain       *evmORMMocks.Chain
-	chainSet    *evmORMMocks.ChainSet
-	ethClient   *evmClientMocks.Client
-	eIMgr       *webhookmocks.ExternalInitiatorManager
-	balM        *evmORMMocks.BalanceMonitor
-	txmStore    *evmtxmgrmocks.MockEvmTxStore
-	auditLogger *audit.AuditLoggerService
+	bridgeORM            *bridgeORMMocks.ORM
+	evmORM               *evmtest.TestConfigs
+	jobORM               *jobORMMocks.ORM
+	sessionsORM          *sessionsMocks.ORM
+	pipelineORM          *pipelineMocks.ORM
+	feedsSvc             *feedsMocks.Service
+	functionsRequestsORM *functionsMocks.RequestsORM
+	cfg                  *chainlinkMocks.GeneralConfig
+	scfg                 *evmConfigMocks.ChainScopedConfig
+	ocr                  *keystoreMocks.OCR
+	ocr2                 *keystoreMocks.OCR2
+	csa                  *keystoreMocks.CSA
+	keystore             *keystoreMocks.Master
+	ethKs                *keystoreMocks.Eth
+	p2p                  *keystoreMocks.P2P
+	vrf                  *keystoreMocks.VRF
+	solana               *keystoreMocks.Solana
+	chain                *evmORMMocks.Chain
+	chainSet             *evmORMMocks.ChainSet
+	ethClient            *evmClientMocks.Client
+	eIMgr                *webhookmocks.ExternalInitiatorManager
+	balM                 *evmORMMocks.BalanceMonitor
+	txmStore             *evmtxmgrmocks.MockEvmTxStore
+	auditLogger          *audit.AuditLoggerService
 }
 
 // gqlTestFramework is a framework wrapper containing the objects needed to run
@@ -91,29 +93,30 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 	// Setup mocks
 	// Note - If you add a new mock make sure you assert it's expectation below.
 	m := &mocks{
-		bridgeORM:   bridgeORMMocks.NewORM(t),
-		evmORM:      evmtest.NewTestConfigs(),
-		jobORM:      jobORMMocks.NewORM(t),
-		feedsSvc:    feedsMocks.NewService(t),
-		sessionsORM: sessionsMocks.NewORM(t),
-		pipelineORM: pipelineMocks.NewORM(t),
-		cfg:         chainlinkMocks.NewGeneralConfig(t),
-		scfg:        evmConfigMocks.NewChainScopedConfig(t),
-		ocr:         keystoreMocks.NewOCR(t),
-		ocr2:        keystoreMocks.NewOCR2(t),
-		csa:         keystoreMocks.NewCSA(t),
-		keystore:    keystoreMocks.NewMaster(t),
-		ethKs:       keystoreMocks.NewEth(t),
-		p2p:         keystoreMocks.NewP2P(t),
-		vrf:         keystoreMocks.NewVRF(t),
-		solana:      keystoreMocks.NewSolana(t),
-		chain:       evmORMMocks.NewChain(t),
-		chainSet:    evmORMMocks.NewChainSet(t),
-		ethClient:   evmClientMocks.NewClient(t),
-		eIMgr:       webhookmocks.NewExternalInitiatorManager(t),
-		balM:        evmORMMocks.NewBalanceMonitor(t),
-		txmStore:    evmtxmgrmocks.NewMockEvmTxStore(t),
-		auditLogger: &audit.AuditLoggerService{},
+		bridgeORM:            bridgeORMMocks.NewORM(t),
+		evmORM:               evmtest.NewTestConfigs(),
+		jobORM:               jobORMMocks.NewORM(t),
+		feedsSvc:             feedsMocks.NewService(t),
+		functionsRequestsORM: functionsMocks.NewRequestsORM(t),
+		sessionsORM:          sessionsMocks.NewORM(t),
+		pipelineORM:          pipelineMocks.NewORM(t),
+		cfg:                  chainlinkMocks.NewGeneralConfig(t),
+		scfg:                 evmConfigMocks.NewChainScopedConfig(t),
+		ocr:                  keystoreMocks.NewOCR(t),
+		ocr2:                 keystoreMocks.NewOCR2(t),
+		csa:                  keystoreMocks.NewCSA(t),
+		keystore:             keystoreMocks.NewMaster(t),
+		ethKs:                keystoreMocks.NewEth(t),
+		p2p:                  keystoreMocks.NewP2P(t),
+		vrf:                  keystoreMocks.NewVRF(t),
+		solana:               keystoreMocks.NewSolana(t),
+		chain:                evmORMMocks.NewChain(t),
+		chainSet:             evmORMMocks.NewChainSet(t),
+		ethClient:            evmClientMocks.NewClient(t),
+		eIMgr:                webhookmocks.NewExternalInitiatorManager(t),
+		balM:                 evmORMMocks.NewBalanceMonitor(t),
+		txmStore:             evmtxmgrmocks.NewMockEvmTxStore(t),
+		auditLogger:          &audit.AuditLoggerService{},
 	}
 
 	app.Mock.On("GetAuditLogger", mock.Anything, mock.Anything).Return(audit.NoopLogger).Maybe()
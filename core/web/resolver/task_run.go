@@ -56,6 +56,10 @@ func (r *TaskRunResolver) FinishedAt() *graphql.Time {
 	return &graphql.Time{Time: r.tr.FinishedAt.ValueOrZero()}
 }
 
+func (r *TaskRunResolver) CircuitBreakerState() *string {
+	return r.tr.CircuitBreakerState.Ptr()
+}
+
 func (r *TaskRunResolver) DotID() string {
 	return r.tr.GetDotID()
 }
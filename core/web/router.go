@@ -276,12 +276,20 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
+		authv2.POST("/transactions/evm/:TxHash/cancel", auth.RequiresAdminRole(txs.Cancel))
+		authv2.POST("/transactions/evm/:TxHash/replace", auth.RequiresAdminRole(txs.Replace))
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
 		rc := ReplayController{app}
 		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
 
+		roc := ReorgsController{app}
+		authv2.GET("/reorgs", roc.Index)
+
+		ksc := KeystoreController{app}
+		authv2.PATCH("/keystore/password", auth.RequiresAdminRole(ksc.RotatePassword))
+
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
 		authv2.POST("/keys/csa", auth.RequiresEditRole(csakc.Create))
@@ -403,6 +411,13 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			chains.GET(chain.path+"/:ID/nodes", paginatedRequest(chain.nc.Index))
 		}
 
+		jpsc := JobProposalSpecsController{app}
+		authv2.GET("/job_proposal_specs/:ID/diff", jpsc.Diff)
+		authv2.GET("/job_proposal_specs/:ID/dry_run", jpsc.DryRun)
+
+		frc := FunctionsRequestsController{app}
+		authv2.GET("/functions/requests", paginatedRequest(frc.Index))
+
 		efc := EVMForwardersController{app}
 		authv2.GET("/nodes/evm/forwarders", paginatedRequest(efc.Index))
 		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresEditRole(efc.Track))